@@ -0,0 +1,214 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * aggregate.go: Pipeline aggregation stages
+ */
+
+package dispatcher
+
+import (
+	"github.com/jwriteclub/weblog/predicate"
+	"github.com/sirupsen/logrus"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Snapshot is the state of an aggregating pipeline at a point in time
+type Snapshot struct {
+	Stage string `json:"stage"`
+	By string `json:"by,omitempty"`
+	// Total is the number of entries counted, or for rate, the number of
+	// entries in the last complete interval
+	Total uint64 `json:"total"`
+	// Rate is Total per second, for rate only
+	Rate float64 `json:"rate,omitempty"`
+	// Interval is the rate interval in seconds, for rate only
+	Interval float64 `json:"interval,omitempty"`
+	Groups []Group `json:"groups,omitempty"`
+	// Other is the number of entries counted, for count and rate, whose keys
+	// came after there were already maxGroups groups
+	Other uint64 `json:"other,omitempty"`
+	Time time.Time `json:"time"`
+}
+
+// Group is the aggregate for a single value of the key being grouped by
+type Group struct {
+	Key string `json:"key"`
+	Count uint64 `json:"count"`
+	Rate float64 `json:"rate,omitempty"`
+}
+
+// The most groups counted by an aggregating stage, so that grouping by a key
+// with many values, like a request id, can't use more and more memory. Count
+// and rate count the entries with other keys together, and top keeps
+// topFactor groups for each it reports, up to maxGroups, evicting the
+// smallest for a new key.
+const maxGroups = 1000
+const topFactor = 10
+
+type aggregator struct {
+	stage predicate.Stage
+	m *sync.Mutex
+	total uint64
+	counts *groupCounts
+	// For rate, the counts for each interval, keyed by its start
+	buckets map[int64]*groupCounts
+}
+
+// groupCounts counts the entries for each key
+type groupCounts struct {
+	groups map[string]uint64
+	other uint64
+}
+
+func newGroupCounts() *groupCounts {
+	return &groupCounts{groups: make(map[string]uint64)}
+}
+
+// add counts an entry for key, or in other if there are already maxGroups
+// groups
+func (c *groupCounts) add(key string) {
+	if _, ok := c.groups[key]; ok || len(c.groups) < maxGroups {
+		c.groups[key] += 1
+		return
+	}
+	c.other += 1
+}
+
+// replace counts an entry for key, and if there are already n groups, evicts
+// the smallest to make room for it. The new group starts from the count of
+// the one evicted, as its entries may have been among them, so counts are
+// never too low, and a key which is common overall stays in. This is the
+// Space-Saving algorithm.
+func (c *groupCounts) replace(key string, n int) {
+	if _, ok := c.groups[key]; ok || len(c.groups) < n {
+		c.groups[key] += 1
+		return
+	}
+	smallest, min, found := "", uint64(0), false
+	for k, count := range c.groups {
+		if !found || count < min || (count == min && k < smallest) {
+			smallest, min, found = k, count, true
+		}
+	}
+	delete(c.groups, smallest)
+	c.groups[key] = min + 1
+}
+
+func newAggregator(stage predicate.Stage) *aggregator {
+	return &aggregator{
+		stage: stage,
+		m: &sync.Mutex{},
+		counts: newGroupCounts(),
+		buckets: make(map[int64]*groupCounts),
+	}
+}
+
+// topGroups is the number of groups kept by top
+func (a *aggregator) topGroups() int {
+	n := a.stage.N * topFactor
+	if n < 100 {
+		n = 100
+	}
+	if n > maxGroups {
+		n = maxGroups
+	}
+	return n
+}
+
+func (a *aggregator) add(e *logrus.Entry) {
+	defer a.m.Unlock()
+	a.m.Lock()
+	key := ""
+	if a.stage.By != nil {
+		key = a.stage.By.Of(e)
+	}
+	switch a.stage.Kind {
+	case predicate.StageTop:
+		a.total += 1
+		a.counts.replace(key, a.topGroups())
+		return
+	case predicate.StageCount:
+		a.total += 1
+		a.counts.add(key)
+		return
+	}
+	start := e.Time.Truncate(a.stage.Interval).UnixNano()
+	b, ok := a.buckets[start]
+	if !ok {
+		b = newGroupCounts()
+		a.buckets[start] = b
+		// Only the current and previous intervals are ever reported
+		for s := range a.buckets {
+			if s < start - 2 * int64(a.stage.Interval) {
+				delete(a.buckets, s)
+			}
+		}
+	}
+	b.add(key)
+}
+
+func (a *aggregator) snapshot(now time.Time) *Snapshot {
+	defer a.m.Unlock()
+	a.m.Lock()
+	snap := &Snapshot{Stage: a.stage.Kind.String(), Time: now}
+	if a.stage.By != nil {
+		snap.By = a.stage.By.String()
+	}
+	counts := a.counts
+	if a.stage.Kind == predicate.StageRate {
+		snap.Interval = a.stage.Interval.Seconds()
+		last := now.Truncate(a.stage.Interval).Add(-a.stage.Interval).UnixNano()
+		counts = a.buckets[last]
+		if counts == nil {
+			counts = newGroupCounts()
+		}
+		for _, c := range counts.groups {
+			snap.Total += c
+		}
+		snap.Total += counts.other
+		snap.Rate = float64(snap.Total) / a.stage.Interval.Seconds()
+	} else {
+		snap.Total = a.total
+	}
+	if a.stage.By == nil {
+		return snap
+	}
+
+	snap.Other = counts.other
+	snap.Groups = make([]Group, 0, len(counts.groups))
+	for k, c := range counts.groups {
+		g := Group{Key: k, Count: c}
+		if a.stage.Kind == predicate.StageRate {
+			g.Rate = float64(c) / a.stage.Interval.Seconds()
+		}
+		snap.Groups = append(snap.Groups, g)
+	}
+	sort.Slice(snap.Groups, func(i, j int) bool {
+		if snap.Groups[i].Count != snap.Groups[j].Count {
+			return snap.Groups[i].Count > snap.Groups[j].Count
+		}
+		return snap.Groups[i].Key < snap.Groups[j].Key
+	})
+	if a.stage.Kind == predicate.StageTop && len(snap.Groups) > a.stage.N {
+		snap.Groups = snap.Groups[:a.stage.N]
+	}
+	return snap
+}
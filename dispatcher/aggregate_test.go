@@ -0,0 +1,79 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * aggregate_test.go: Test of pipeline aggregation stages
+ */
+
+package dispatcher
+
+import (
+	"testing"
+	"fmt"
+	"time"
+	"github.com/jwriteclub/weblog/predicate"
+	"github.com/sirupsen/logrus"
+)
+
+func aggregatorFor(t *testing.T, expression string) *aggregator {
+	q, err := predicate.CompileQuery(predicate.SyntaxSelect, expression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newAggregator(*q.Aggregate())
+}
+
+// Grouping by a key with a new value for every entry keeps a bounded number
+// of groups
+func TestAggregator_Bounded(t *testing.T) {
+	now := time.Now()
+	for _, expression := range []string{"| count by field(id)", "| top 5 field(id)", "| rate 1h by field(id)"} {
+		a := aggregatorFor(t, expression)
+		for i := 0; i < 3 * maxGroups; i += 1 {
+			// A common value, among many which are only seen once
+			id := fmt.Sprint(i)
+			if i % 2 == 0 {
+				id = "common"
+			}
+			a.add(&logrus.Entry{Time: now.Add(-time.Hour), Data: logrus.Fields{"id": id}})
+		}
+		groups := len(a.counts.groups)
+		for _, b := range a.buckets {
+			groups += len(b.groups)
+		}
+		if groups > maxGroups {
+			fmt.Printf("%s kept %d groups\n", expression, groups)
+			t.Fail()
+		}
+		snap := a.snapshot(now)
+		if snap.Total != 3 * maxGroups || len(snap.Groups) == 0 || snap.Groups[0].Key != "common" {
+			fmt.Printf("%s: got %#v\n", expression, snap)
+			t.Fail()
+			continue
+		}
+		var other uint64
+		if snap.Stage != "top" {
+			// The groups, and the entries counted apart from them, add up
+			other = snap.Total - snap.Groups[0].Count - uint64(maxGroups - 1)
+		}
+		if snap.Other != other {
+			fmt.Printf("%s: expected %d other but got %d\n", expression, other, snap.Other)
+			t.Fail()
+		}
+	}
+}
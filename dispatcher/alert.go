@@ -0,0 +1,309 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * alert.go: Alerts when a query matches too often
+ */
+
+package dispatcher
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jwriteclub/weblog/predicate"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// AlertRule fires when its query matches more than Threshold entries within
+// Window, and resolves once it no longer does
+type AlertRule struct {
+	// Identifies the rule, and its alerts
+	Name string
+	// The query, parsed with Syntax (the select syntax if empty). It may not
+	// have pipe stages.
+	Syntax predicate.Syntax
+	Expression string
+	Threshold int
+	Window time.Duration
+	// Once resolved, the rule doesn't fire again until Cooldown has passed,
+	// so a flapping condition doesn't notify over and over
+	Cooldown time.Duration
+}
+
+// AlertState is whether an alert is firing or resolved
+type AlertState string
+const (
+	AlertFiring AlertState = "firing"
+	AlertResolved AlertState = "resolved"
+)
+
+// Alert is the state of a rule when it fires or resolves
+type Alert struct {
+	Rule string `json:"rule"`
+	Expression string `json:"expression"`
+	// The name of the dispatcher, if it has one
+	Stream string `json:"stream,omitempty"`
+	State AlertState `json:"state"`
+	Threshold int `json:"threshold"`
+	Window time.Duration `json:"-"`
+	// The matches since the rule fired, including those which fired it
+	Count uint64 `json:"count"`
+	// When the rule fired, and when it last changed state
+	Since time.Time `json:"since"`
+	Time time.Time `json:"time"`
+}
+
+// MarshalJSON encodes the alert, with the window in seconds
+func (a Alert) MarshalJSON() ([]byte, error) {
+	type alert Alert
+	return json.Marshal(struct {
+		alert
+		Window float64 `json:"window"`
+	}{alert(a), a.Window.Seconds()})
+}
+
+// Notifier is told when an alert fires or resolves
+type Notifier interface {
+	Notify(a Alert) error
+}
+
+// NotifierFunc is a function used as a Notifier
+type NotifierFunc func(a Alert) error
+
+func (f NotifierFunc) Notify(a Alert) error {
+	return f(a)
+}
+
+// How often rules are checked, at most, for resolving
+const alertInterval = time.Second
+
+// alerter watches the entries matching a rule, with its own selector, from
+// its own goroutine. Notifiers are called from that goroutine, one at a
+// time, and entries which arrive meanwhile are counted from the gap, if the
+// selector's queue overflows.
+type alerter struct {
+	rule AlertRule
+	d *Dispatcher
+	s *Selector
+	notifiers []Notifier
+	// The times of the latest matches, at most Threshold + 1, as the rule is
+	// exceeded only when the oldest of those is within the window
+	times []time.Time
+	// The current alert, guarded by the dispatcher's am, while it's firing
+	alert Alert
+	firing bool
+	// When the cool down after resolving ends
+	quiet time.Time
+}
+
+// AddAlert starts checking a rule, calling the notifiers each time it fires
+// and resolves. Entries in the history are counted too, if they are recent
+// enough. It returns ErrClosed once the dispatcher is closed.
+func (r *Dispatcher) AddAlert(rule AlertRule, notifiers ...Notifier) error {
+	select {
+	case <-r.done:
+		return ErrClosed
+	default:
+		break
+	}
+	if rule.Name == "" {
+		return errors.New("alert rules must be named")
+	}
+	if rule.Window <= 0 || rule.Threshold < 0 {
+		return fmt.Errorf("alert rule %s needs a window, and a threshold of at least zero", rule.Name)
+	}
+	q, err := predicate.CompileQuery(rule.Syntax, rule.Expression)
+	if err != nil {
+		return err
+	}
+	if len(q.Stages) > 0 {
+		return fmt.Errorf("alert rule %s may not have pipe stages", rule.Name)
+	}
+	s, err := prepareSelector(rule.Syntax, rule.Expression, r, false, 0)
+	if err != nil {
+		return err
+	}
+	a := &alerter{rule: rule, d: r, s: s, notifiers: notifiers}
+	r.am.Lock()
+	if _, ok := r.alerts[rule.Name]; ok {
+		r.am.Unlock()
+		return fmt.Errorf("there is already an alert rule named %s", rule.Name)
+	}
+	r.alerts[rule.Name] = a
+	r.am.Unlock()
+	s.start()
+	go a.run()
+	return nil
+}
+
+// RemoveAlert stops checking the named rule. If it's firing, it's forgotten
+// without being resolved.
+func (r *Dispatcher) RemoveAlert(name string) {
+	r.am.Lock()
+	a, ok := r.alerts[name]
+	delete(r.alerts, name)
+	r.am.Unlock()
+	if ok {
+		a.s.Stop()
+		r.alertsChanged()
+	}
+}
+
+// Alerts returns the alerts currently firing, by rule name
+func (r *Dispatcher) Alerts() []Alert {
+	defer r.am.Unlock()
+	r.am.Lock()
+	ret := make([]Alert, 0)
+	for _, a := range r.alerts {
+		if a.firing {
+			ret = append(ret, a.alert)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Rule < ret[j].Rule
+	})
+	return ret
+}
+
+// AlertVersion changes each time an alert fires, resolves or is removed, so
+// that a viewer can tell when to fetch Alerts again
+func (r *Dispatcher) AlertVersion() uint64 {
+	return atomic.LoadUint64(&r.alertVersion)
+}
+
+// AlertChanged returns a channel which is closed the next time AlertVersion
+// changes, so that a viewer can wait for it rather than polling. Call it again
+// after each change for the next one.
+func (r *Dispatcher) AlertChanged() <-chan struct{} {
+	defer r.am.Unlock()
+	r.am.Lock()
+	return r.alertChanged
+}
+
+// alertsChanged moves AlertVersion on, and wakes anyone waiting for it
+func (r *Dispatcher) alertsChanged() {
+	defer r.am.Unlock()
+	r.am.Lock()
+	atomic.AddUint64(&r.alertVersion, 1)
+	close(r.alertChanged)
+	r.alertChanged = make(chan struct{})
+}
+
+func (a *alerter) run() {
+	interval := a.rule.Window / 10
+	if interval > alertInterval {
+		interval = alertInterval
+	}
+	if interval < 10 * time.Millisecond {
+		interval = 10 * time.Millisecond
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	c := a.s.C()
+	for {
+		select {
+		case e, ok := <-c:
+			if !ok {
+				return
+			}
+			if e.Gap != nil {
+				a.add(e.Gap.To, e.Gap.Dropped)
+			} else {
+				a.add(e.Time, 1)
+			}
+			a.check(time.Now())
+		case now := <-t.C:
+			a.check(now)
+		}
+	}
+}
+
+// add records n matches at time t
+func (a *alerter) add(t time.Time, n uint64) {
+	if a.firing {
+		a.d.am.Lock()
+		a.alert.Count += n
+		a.d.am.Unlock()
+	}
+	keep := uint64(a.rule.Threshold + 1)
+	if n > keep {
+		n = keep
+	}
+	for i := uint64(0); i < n; i += 1 {
+		a.times = append(a.times, t)
+	}
+	if over := len(a.times) - int(keep); over > 0 {
+		a.times = append(a.times[:0], a.times[over:]...)
+	}
+}
+
+// exceeded reports whether the rule's threshold is exceeded at now
+func (a *alerter) exceeded(now time.Time) bool {
+	if len(a.times) <= a.rule.Threshold {
+		return false
+	}
+	for _, t := range a.times {
+		if now.Sub(t) > a.rule.Window {
+			return false
+		}
+	}
+	return true
+}
+
+// check fires or resolves the rule
+func (a *alerter) check(now time.Time) {
+	exceeded := a.exceeded(now)
+	var alert Alert
+	switch {
+	case !a.firing && exceeded && !now.Before(a.quiet):
+		alert = Alert{
+			Rule: a.rule.Name,
+			Expression: a.rule.Expression,
+			Stream: a.d.name,
+			State: AlertFiring,
+			Threshold: a.rule.Threshold,
+			Window: a.rule.Window,
+			Count: uint64(len(a.times)),
+			Since: now,
+			Time: now,
+		}
+		a.d.am.Lock()
+		a.firing = true
+		a.alert = alert
+		a.d.am.Unlock()
+	case a.firing && !exceeded:
+		a.d.am.Lock()
+		a.firing = false
+		alert = a.alert
+		a.d.am.Unlock()
+		alert.State = AlertResolved
+		alert.Time = now
+		a.quiet = now.Add(a.rule.Cooldown)
+	default:
+		return
+	}
+	a.d.alertsChanged()
+	for _, n := range a.notifiers {
+		if err := n.Notify(alert); err != nil {
+			fmt.Printf("dispatcher: error notifying alert %s: %s\n", alert.Rule, err.Error())
+		}
+	}
+}
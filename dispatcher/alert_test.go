@@ -0,0 +1,230 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * alert_test.go: Test of alert rules and notifiers
+ */
+
+package dispatcher
+
+import (
+	"testing"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+	"github.com/jwriteclub/weblog/predicate"
+	"github.com/sirupsen/logrus"
+)
+
+// waitAlert waits for the next alert from c
+func waitAlert(t *testing.T, c chan Alert) Alert {
+	select {
+	case a := <-c:
+		return a
+	case <-time.After(5 * time.Second):
+		t.Fatal("No alert")
+	}
+	return Alert{}
+}
+
+func TestDispatcher_AddAlert(t *testing.T) {
+	d := NewDispatcher()
+	defer d.Stop()
+	var tests = []struct {
+		rule AlertRule
+		valid bool
+	}{
+		{AlertRule{Name: "errors", Expression: "level >= error", Threshold: 5, Window: time.Minute}, true},
+		{AlertRule{Name: "errors", Expression: "level >= error", Threshold: 5, Window: time.Minute}, false},
+		{AlertRule{Expression: "level >= error", Threshold: 5, Window: time.Minute}, false},
+		{AlertRule{Name: "nowindow", Expression: "level >= error", Threshold: 5}, false},
+		{AlertRule{Name: "stages", Expression: "level >= error | count", Threshold: 5, Window: time.Minute}, false},
+		{AlertRule{Name: "syntax", Syntax: predicate.SyntaxJSON, Expression: "{", Threshold: 5, Window: time.Minute}, false},
+	}
+	for _, test := range tests {
+		err := d.AddAlert(test.rule)
+		if (err == nil) != test.valid {
+			fmt.Printf("Adding %#v: %v\n", test.rule, err)
+			t.Fail()
+		}
+	}
+	d.Stop()
+	err := d.AddAlert(AlertRule{Name: "late", Expression: "level >= error", Threshold: 5, Window: time.Minute})
+	if err != ErrClosed {
+		fmt.Printf("Adding to a closed dispatcher: %v\n", err)
+		t.Fail()
+	}
+	if st := d.Stats(); len(st.Selectors) != 0 {
+		fmt.Printf("Selectors %#v\n", st.Selectors)
+		t.Fail()
+	}
+}
+
+func TestDispatcher_Alert(t *testing.T) {
+	d := NewDispatcher(Name("payments"))
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	alerts := make(chan Alert, 10)
+	rule := AlertRule{Name: "errors", Expression: "level >= error && Prefix(payments)", Threshold: 2, Window: 300 * time.Millisecond, Cooldown: time.Hour}
+	err := d.AddAlert(rule, NotifierFunc(func(a Alert) error {
+		alerts <- a
+		return nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i += 1 {
+		log.WithField("prefix", "payments").Error("declined")
+		log.WithField("prefix", "other").Error("declined")
+		log.WithField("prefix", "payments").Info("declined")
+	}
+	time.Sleep(100 * time.Millisecond)
+	if len(d.Alerts()) > 0 {
+		fmt.Printf("Fired at the threshold\n")
+		t.Fail()
+	}
+	log.WithField("prefix", "payments").Error("declined")
+	a := waitAlert(t, alerts)
+	if a.State != AlertFiring || a.Rule != "errors" || a.Stream != "payments" || a.Count != 3 {
+		fmt.Printf("Got %#v\n", a)
+		t.Fail()
+	}
+	if active := d.Alerts(); len(active) != 1 || active[0].Rule != "errors" {
+		fmt.Printf("Active %#v\n", active)
+		t.Fail()
+	}
+	log.WithField("prefix", "payments").Error("declined")
+
+	a = waitAlert(t, alerts)
+	if a.State != AlertResolved || a.Count != 4 || !a.Time.After(a.Since) {
+		fmt.Printf("Got %#v\n", a)
+		t.Fail()
+	}
+	if active := d.Alerts(); len(active) != 0 {
+		fmt.Printf("Active %#v\n", active)
+		t.Fail()
+	}
+
+	// Cooling down
+	for i := 0; i < 5; i += 1 {
+		log.WithField("prefix", "payments").Error("declined")
+	}
+	select {
+	case a := <-alerts:
+		fmt.Printf("Fired while cooling down: %#v\n", a)
+		t.Fail()
+	case <-time.After(200 * time.Millisecond):
+		break
+	}
+
+	version := d.AlertVersion()
+	changed := d.AlertChanged()
+	d.RemoveAlert("errors")
+	if d.AlertVersion() == version {
+		fmt.Printf("Removing didn't change the version\n")
+		t.Fail()
+	}
+	select {
+	case <-changed:
+		break
+	default:
+		fmt.Printf("Removing didn't close the channel\n")
+		t.Fail()
+	}
+	if d.AlertChanged() == changed {
+		fmt.Printf("Channel not replaced\n")
+		t.Fail()
+	}
+}
+
+func TestWebhook(t *testing.T) {
+	received := make(chan map[string]interface{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		dat := make(map[string]interface{})
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" || r.Header.Get("Authorization") != "Bearer x" || json.Unmarshal(body, &dat) != nil {
+			fmt.Printf("Bad request %s %#v %s\n", r.Method, r.Header, body)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- dat
+		if dat["rule"] == "fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	hook := NewWebhook(server.URL)
+	hook.Header.Set("Authorization", "Bearer x")
+	now := time.Now()
+	err := hook.Notify(Alert{Rule: "errors", Expression: "level >= error", State: AlertFiring, Threshold: 5, Window: time.Minute, Count: 6, Since: now, Time: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dat := <-received
+	if dat["rule"] != "errors" || dat["state"] != "firing" || dat["window"] != 60.0 || dat["count"] != 6.0 {
+		fmt.Printf("Got %#v\n", dat)
+		t.Fail()
+	}
+	if err := hook.Notify(Alert{Rule: "fail"}); err == nil {
+		fmt.Printf("No error for a failed request\n")
+		t.Fail()
+	}
+	<-received
+
+	// Fired by a dispatcher
+	d := NewDispatcher()
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	if err := d.AddAlert(AlertRule{Name: "any", Expression: "level >= error", Window: time.Minute}, hook); err != nil {
+		t.Fatal(err)
+	}
+	log.Error("failed")
+	select {
+	case dat := <-received:
+		if dat["rule"] != "any" || dat["state"] != "firing" {
+			fmt.Printf("Got %#v\n", dat)
+			t.Fail()
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("No webhook")
+	}
+
+	// A literal has no client, nor header
+	literal := &Webhook{URL: server.URL}
+	if err := literal.Notify(Alert{Rule: "literal"}); err == nil {
+		fmt.Printf("No error for an unauthorized request\n")
+		t.Fail()
+	}
+	literal.Header = http.Header{"Authorization": []string{"Bearer x"}}
+	if err := literal.Notify(Alert{Rule: "literal"}); err != nil {
+		t.Fatal(err)
+	}
+	if dat := <-received; dat["rule"] != "literal" {
+		fmt.Printf("Got %#v\n", dat)
+		t.Fail()
+	}
+}
@@ -0,0 +1,103 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * dedupe.go: Folding of repeated entries
+ */
+
+package dispatcher
+
+import (
+	"github.com/jwriteclub/weblog/predicate"
+	"strings"
+	"time"
+)
+
+// deduper folds repeated entries for a selector's dedupe stage. Entries are
+// repeats when their message, level and the stage's keys are the same.
+// Without an interval only consecutive repeats are folded, otherwise any
+// repeat within the interval of the previous one.
+type deduper struct {
+	stage predicate.Stage
+	// The last entry, for consecutive repeats
+	last *Repeat
+	lastKey string
+	// Recent entries by key, when there's an interval
+	recent map[string]*Repeat
+	pruned time.Time
+}
+
+func newDeduper(stage predicate.Stage) *deduper {
+	return &deduper{stage: stage, recent: make(map[string]*Repeat)}
+}
+
+func (d *deduper) key(e *Entry) string {
+	parts := []string{e.Level.String(), e.Message}
+	for _, k := range d.stage.Keys {
+		parts = append(parts, k.Of(&e.Entry))
+	}
+	return strings.Join(parts, "\x00")
+}
+
+// fold returns the repeat e is folded into, or nil if it isn't a repeat, in
+// which case later entries may be folded into it
+func (d *deduper) fold(e *Entry) *Repeat {
+	key := d.key(e)
+	if d.stage.Interval <= 0 {
+		if d.last != nil && d.lastKey == key {
+			d.last.add(e)
+			r := *d.last
+			return &r
+		}
+		d.last, d.lastKey = newRepeat(e), key
+		return nil
+	}
+
+	d.prune(e.Time)
+	if r, ok := d.recent[key]; ok && e.Time.Sub(r.LastTime) <= d.stage.Interval {
+		r.add(e)
+		ret := *r
+		return &ret
+	}
+	d.recent[key] = newRepeat(e)
+	return nil
+}
+
+// prune forgets entries which can no longer be repeated, once an interval
+func (d *deduper) prune(now time.Time) {
+	if now.Sub(d.pruned) < d.stage.Interval {
+		return
+	}
+	d.pruned = now
+	for k, r := range d.recent {
+		if now.Sub(r.LastTime) > d.stage.Interval {
+			delete(d.recent, k)
+		}
+	}
+}
+
+func newRepeat(e *Entry) *Repeat {
+	return &Repeat{Of: e.Seq, Count: 1, FirstTime: e.Time, LastTime: e.Time}
+}
+
+func (r *Repeat) add(e *Entry) {
+	r.Count += 1
+	if e.Time.After(r.LastTime) {
+		r.LastTime = e.Time
+	}
+}
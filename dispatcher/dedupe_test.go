@@ -0,0 +1,167 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * dedupe_test.go: Test of folding repeated entries
+ */
+
+package dispatcher
+
+import (
+	"testing"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"github.com/jwriteclub/weblog/predicate"
+	"github.com/sirupsen/logrus"
+)
+
+func TestDeduper(t *testing.T) {
+	base := time.Now()
+	entry := func(seq uint64, s int, level logrus.Level, msg string, code int) *Entry {
+		return &Entry{Entry: logrus.Entry{Level: level, Message: msg, Time: base.Add(time.Duration(s) * time.Second), Data: logrus.Fields{"code": code}}, Seq: seq}
+	}
+	var tests = []struct {
+		query string
+		entries []*Entry
+		// The entry each one is folded into and its count, or "-" if it isn't
+		output string
+	}{
+		{"| dedupe", []*Entry{
+			entry(1, 0, logrus.InfoLevel, "retry", 1),
+			entry(2, 1, logrus.InfoLevel, "retry", 2),
+			entry(3, 2, logrus.WarnLevel, "retry", 2),
+			entry(4, 3, logrus.WarnLevel, "retry", 2),
+			entry(5, 4, logrus.InfoLevel, "retry", 2),
+		}, "-,1x2,-,3x2,-"},
+		{"| dedupe by code", []*Entry{
+			entry(1, 0, logrus.InfoLevel, "retry", 1),
+			entry(2, 1, logrus.InfoLevel, "retry", 2),
+			entry(3, 2, logrus.InfoLevel, "retry", 2),
+		}, "-,-,2x2"},
+		{"| dedupe within 10s", []*Entry{
+			entry(1, 0, logrus.InfoLevel, "retry", 1),
+			entry(2, 1, logrus.InfoLevel, "other", 1),
+			entry(3, 5, logrus.InfoLevel, "retry", 1),
+			entry(4, 6, logrus.InfoLevel, "other", 1),
+			entry(5, 20, logrus.InfoLevel, "retry", 1),
+			entry(6, 25, logrus.InfoLevel, "retry", 1),
+		}, "-,-,1x2,2x2,-,5x2"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.query, func(t *testing.T) {
+			q, err := predicate.CompileQuery(predicate.SyntaxSelect, test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := newDeduper(*q.Dedupe())
+			out := make([]string, 0)
+			for _, e := range test.entries {
+				if r := d.fold(e); r != nil {
+					out = append(out, fmt.Sprintf("%dx%d", r.Of, r.Count))
+					if !r.LastTime.Equal(e.Time) {
+						fmt.Printf("Last time %s, expected %s\n", r.LastTime, e.Time)
+						t.Fail()
+					}
+				} else {
+					out = append(out, "-")
+				}
+			}
+			if strings.Join(out, ",") != test.output {
+				fmt.Printf("Expected %s but got %s\n", test.output, strings.Join(out, ","))
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestSelector_Dedupe(t *testing.T) {
+	d := NewDispatcher()
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	s, err := NewSelector("Prefix(test) | dedupe", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	for i := 0; i < 3; i += 1 {
+		log.WithField("prefix", "test").Warn("retrying")
+	}
+	log.WithField("prefix", "test").Info("done")
+
+	first := read(s)
+	if first == nil || first.Repeat != nil || first.Message != "retrying" {
+		t.Fatalf("Got %#v", first)
+	}
+	for n := uint64(2); n <= 3; n += 1 {
+		e := read(s)
+		if e == nil || e.Repeat == nil || e.Repeat.Of != first.Seq || e.Repeat.Count != n || e.Seq != first.Seq + n - 1 {
+			t.Fatalf("Got %#v", e)
+		}
+	}
+	if e := read(s); e == nil || e.Repeat != nil || e.Message != "done" {
+		fmt.Printf("Got %#v\n", e)
+		t.Fail()
+	}
+
+	if _, err := d.Query(HistoryQuery{Expression: "| dedupe"}); err == nil {
+		fmt.Printf("Deduplicated a history query\n")
+		t.Fail()
+	}
+}
+
+// The last repeat of a burst which didn't fit in the queue is sent once
+// there's room, even though nothing else is logged
+func TestSelector_DedupeFull(t *testing.T) {
+	d := NewDispatcher(SelectorQueue(1))
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	s, err := NewSelector("Prefix(test) | dedupe", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	for i := 0; i < 5; i += 1 {
+		log.WithField("prefix", "test").Warn("retrying")
+	}
+	for i := 0; d.Stats().Ingested < 5; i += 1 {
+		if i == 100 {
+			t.Fatal("timed out")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5 * time.Second)
+	defer cancel()
+	first, err := s.Next(ctx)
+	if err != nil || first.Repeat != nil {
+		t.Fatalf("Got %#v, %v", first, err)
+	}
+	e, err := s.Next(ctx)
+	if err != nil || e.Repeat == nil || e.Repeat.Of != first.Seq || e.Repeat.Count != 5 {
+		fmt.Printf("Got %#v, %v\n", e, err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,747 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * disk.go: History stored in segment files on disk
+ */
+
+package dispatcher
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/jwriteclub/weblog/predicate"
+	"github.com/sirupsen/logrus"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DiskOptions configures a DiskHistory. Zero values select the defaults.
+type DiskOptions struct {
+	// SegmentSize is the size at which a new segment file is started. The
+	// default is 4MiB.
+	SegmentSize int64
+	// MaxBytes is the total size of the segments kept. The oldest segments
+	// are removed first, but the current segment is always kept. The default
+	// is 64MiB.
+	MaxBytes int64
+	// MaxAge is how long entries are kept. Zero, the default, keeps entries
+	// until MaxBytes is reached.
+	MaxAge time.Duration
+	// SyncInterval is how often written entries are synced to the disk. They
+	// are also synced when a segment is finished, and on Sync and Close. The
+	// default is a second.
+	SyncInterval time.Duration
+}
+
+const defaultSegmentSize = 4 << 20
+const defaultMaxBytes = 64 << 20
+const defaultSyncInterval = time.Second
+
+// The longest line read back from a segment
+const maxRecordSize = 16 << 20
+
+const segmentExt = ".ndjson"
+
+// The file holding the epoch of the entries' sequence numbers
+const epochFile = "epoch"
+
+// DiskHistory is a History kept in a directory of append-only segment files,
+// so that it survives restarting the process. Each segment holds one JSON
+// record per line, and is named after the sequence number of its first entry.
+//
+// Entries are written by a goroutine of the history's own, so that Push
+// doesn't wait for the disk. Entries pushed but not yet written are read
+// from memory.
+//
+// Entry data is stored as JSON, so it comes back as JSON types: whole numbers
+// are int64 and other numbers float64, errors and other values JSON can't
+// encode are strings. Values frozen as a json.RawMessage, such as maps and
+// structs, come back as one, so they compare as they did when logged.
+type DiskHistory struct {
+	dir string
+	opts DiskOptions
+	// The epoch of the sequence numbers, kept in the directory, so that they
+	// can be resumed from after a restart
+	epoch string
+	m *sync.RWMutex
+	segments []*segment
+	// The records pushed but not yet written, oldest first
+	pending []pendingRecord
+	closed bool
+	// The file of the last segment, open for appending, and the buffer in
+	// front of it. Only used by the writer goroutine, and by Close once it
+	// has finished.
+	cur *os.File
+	buf *bufio.Writer
+	wake chan struct{}
+	syncs chan chan error
+	stop chan struct{}
+	stopped chan struct{}
+	closeOnce *sync.Once
+	closeErr error
+}
+
+// pendingRecord is an encoded entry waiting to be written
+type pendingRecord struct {
+	seq uint64
+	time time.Time
+	line []byte
+}
+
+type segment struct {
+	path string
+	first uint64
+	last uint64
+	count int
+	size int64
+	// The time of the newest entry
+	newest time.Time
+}
+
+// record is the encoding of an entry in a segment
+type record struct {
+	Seq uint64 `json:"seq"`
+	Time time.Time `json:"time"`
+	Level string `json:"level"`
+	Message string `json:"msg"`
+	Stream string `json:"stream,omitempty"`
+	Data logrus.Fields `json:"data,omitempty"`
+	// The fields of Data which were already encoded, as a json.RawMessage,
+	// so that they're read back as one
+	Raw []string `json:"raw,omitempty"`
+}
+
+// NewDiskHistory opens, or creates, the history stored in dir. Existing
+// segments are checked, and anything after the last complete record (left
+// by a crash part way through a write) is truncated.
+func NewDiskHistory(dir string, opts DiskOptions) (*DiskHistory, error) {
+	if opts.SegmentSize <= 0 {
+		opts.SegmentSize = defaultSegmentSize
+	}
+	if opts.MaxBytes <= 0 {
+		opts.MaxBytes = defaultMaxBytes
+	}
+	if opts.SyncInterval <= 0 {
+		opts.SyncInterval = defaultSyncInterval
+	}
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+	h := &DiskHistory{
+		dir: dir,
+		opts: opts,
+		m: &sync.RWMutex{},
+		wake: make(chan struct{}, 1),
+		syncs: make(chan chan error),
+		stop: make(chan struct{}),
+		stopped: make(chan struct{}),
+		closeOnce: &sync.Once{},
+	}
+	h.epoch, err = readEpoch(filepath.Join(dir, epochFile))
+	if err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		first, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		seg := &segment{path: filepath.Join(dir, name), first: first}
+		err = seg.recover()
+		if err != nil {
+			return nil, err
+		}
+		if seg.count == 0 {
+			_ = os.Remove(seg.path)
+			continue
+		}
+		h.segments = append(h.segments, seg)
+	}
+	sort.Slice(h.segments, func(i, j int) bool {
+		return h.segments[i].first < h.segments[j].first
+	})
+	go h.write()
+	return h, nil
+}
+
+// readEpoch reads the epoch from a file, writing a new one if there isn't one
+func readEpoch(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err == nil && len(bytes.TrimSpace(data)) > 0 {
+		return string(bytes.TrimSpace(data)), nil
+	} else if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	epoch := newEpoch()
+	return epoch, ioutil.WriteFile(path, []byte(epoch + "\n"), 0644)
+}
+
+// recover reads a segment to find its extent, truncating any incomplete
+// record at the end
+func (seg *segment) recover() error {
+	f, err := os.OpenFile(seg.path, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	var good int64
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		rec := record{}
+		if json.Unmarshal(line, &rec) == nil {
+			if seg.count == 0 {
+				seg.first = rec.Seq
+			}
+			seg.last = rec.Seq
+			seg.newest = rec.Time
+			seg.count += 1
+		}
+		good += int64(len(line))
+	}
+	seg.size = good
+	return f.Truncate(good)
+}
+
+// Close writes the entries pushed, syncs them, and closes the current
+// segment file. Entries pushed afterwards are discarded.
+func (h *DiskHistory) Close() error {
+	h.closeOnce.Do(func() {
+		h.m.Lock()
+		h.closed = true
+		h.m.Unlock()
+		close(h.stop)
+		<-h.stopped
+		if h.cur == nil {
+			return
+		}
+		h.closeErr = h.cur.Sync()
+		if err := h.cur.Close(); h.closeErr == nil {
+			h.closeErr = err
+		}
+		h.cur = nil
+	})
+	return h.closeErr
+}
+
+// Sync waits for the entries pushed so far to be written, and syncs them to
+// the disk. After Close, it does nothing.
+func (h *DiskHistory) Sync() error {
+	reply := make(chan error, 1)
+	select {
+	case h.syncs <- reply:
+		return <-reply
+	case <-h.stopped:
+		return nil
+	}
+}
+
+// Push queues an entry to be written to the current segment. Write errors
+// are printed, as logging can't fail.
+func (h *DiskHistory) Push(e Entry) {
+	line, err := encodeRecord(&e)
+	if err != nil {
+		fmt.Printf("weblog: unable to encode entry %d: %s\n", e.Seq, err.Error())
+		return
+	}
+	defer h.m.Unlock()
+	h.m.Lock()
+	if h.closed {
+		return
+	}
+	h.pending = append(h.pending, pendingRecord{seq: e.Seq, time: e.Time, line: line})
+	select {
+	case h.wake <- struct{}{}:
+		break
+	default:
+		break
+	}
+}
+
+// write runs in its own goroutine, writing pending records as they're
+// pushed, and syncing them every SyncInterval
+func (h *DiskHistory) write() {
+	defer close(h.stopped)
+	ticker := time.NewTicker(h.opts.SyncInterval)
+	defer ticker.Stop()
+	dirty := false
+	for {
+		select {
+		case <-h.wake:
+			dirty = h.flush() || dirty
+		case <-ticker.C:
+			if dirty {
+				_ = h.sync()
+				dirty = false
+			}
+		case reply := <-h.syncs:
+			h.flush()
+			reply <- h.sync()
+			dirty = false
+		case <-h.stop:
+			h.flush()
+			return
+		}
+	}
+}
+
+// flush writes the pending records, starting new segments as they fill, and
+// reports whether there were any
+func (h *DiskHistory) flush() bool {
+	h.m.RLock()
+	batch := h.pending
+	h.m.RUnlock()
+	// The records written to the buffer since the last commit, and their size
+	from := 0
+	var size int64
+	for i, rec := range batch {
+		if h.cur == nil || h.segments[len(h.segments) - 1].size + size >= h.opts.SegmentSize {
+			h.commit(batch[from:i], size)
+			from, size = i, 0
+			err := h.roll(rec.seq)
+			if err != nil {
+				fmt.Printf("weblog: unable to start history segment: %s\n", err.Error())
+				h.discard(len(batch) - i)
+				return true
+			}
+		}
+		n, _ := h.buf.Write(rec.line)
+		size += int64(n)
+	}
+	h.commit(batch[from:], size)
+	return len(batch) > 0
+}
+
+// commit flushes the buffer, and adds the records in it to the current
+// segment, removing them from those pending
+func (h *DiskHistory) commit(recs []pendingRecord, size int64) {
+	if len(recs) == 0 {
+		return
+	}
+	err := h.buf.Flush()
+	defer h.m.Unlock()
+	h.m.Lock()
+	h.pending = h.pending[len(recs):]
+	seg := h.segments[len(h.segments) - 1]
+	if err != nil {
+		fmt.Printf("weblog: unable to write history: %s\n", err.Error())
+		// Don't leave a partial record for the next one to be appended to
+		_ = h.cur.Truncate(seg.size)
+		h.buf.Reset(h.cur)
+		return
+	}
+	if seg.count == 0 {
+		seg.first = recs[0].seq
+	}
+	seg.last = recs[len(recs) - 1].seq
+	seg.newest = recs[len(recs) - 1].time
+	seg.count += len(recs)
+	seg.size += size
+	h.retain()
+}
+
+// discard removes the oldest n pending records, which couldn't be written
+func (h *DiskHistory) discard(n int) {
+	defer h.m.Unlock()
+	h.m.Lock()
+	h.pending = h.pending[n:]
+}
+
+// sync syncs the current segment to the disk
+func (h *DiskHistory) sync() error {
+	if h.cur == nil {
+		return nil
+	}
+	err := h.cur.Sync()
+	if err != nil {
+		fmt.Printf("weblog: unable to sync history: %s\n", err.Error())
+	}
+	return err
+}
+
+// roll finishes the current segment, syncing it, and starts a new one whose
+// first entry will be seq
+func (h *DiskHistory) roll(seq uint64) error {
+	if h.cur != nil {
+		_ = h.sync()
+		_ = h.cur.Close()
+		h.cur = nil
+	}
+	defer h.m.Unlock()
+	h.m.Lock()
+	// Appending to the last segment, rather than starting another, when it has
+	// room after a restart
+	if l := len(h.segments); l > 0 && h.segments[l - 1].size < h.opts.SegmentSize {
+		f, err := os.OpenFile(h.segments[l - 1].path, os.O_WRONLY|os.O_APPEND, 0644)
+		if err == nil {
+			h.open(f)
+			return nil
+		}
+	}
+	path := filepath.Join(h.dir, fmt.Sprintf("%020d%s", seq, segmentExt))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	h.open(f)
+	h.segments = append(h.segments, &segment{path: path, first: seq})
+	return nil
+}
+
+// open makes f the current segment file
+func (h *DiskHistory) open(f *os.File) {
+	h.cur = f
+	if h.buf == nil {
+		h.buf = bufio.NewWriter(f)
+	} else {
+		h.buf.Reset(f)
+	}
+}
+
+// retain removes the oldest segments which are over the size or age limits.
+// h.m must be held.
+func (h *DiskHistory) retain() {
+	var total int64
+	for _, seg := range h.segments {
+		total += seg.size
+	}
+	cutoff := h.cutoff()
+	for len(h.segments) > 1 {
+		seg := h.segments[0]
+		if total <= h.opts.MaxBytes && !seg.newest.Before(cutoff) {
+			break
+		}
+		err := os.Remove(seg.path)
+		if err != nil && !os.IsNotExist(err) {
+			fmt.Printf("weblog: unable to remove history segment: %s\n", err.Error())
+			break
+		}
+		total -= seg.size
+		h.segments = h.segments[1:]
+	}
+}
+
+// cutoff returns the time before which entries are too old to be kept
+func (h *DiskHistory) cutoff() time.Time {
+	if h.opts.MaxAge <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(-h.opts.MaxAge)
+}
+
+// snapshot returns the current segments, and the records not yet written to
+// them, so they can be read without holding up the writer
+func (h *DiskHistory) snapshot() ([]segment, []pendingRecord) {
+	defer h.m.RUnlock()
+	h.m.RLock()
+	segments := make([]segment, len(h.segments))
+	for i, seg := range h.segments {
+		segments[i] = *seg
+	}
+	pending := make([]pendingRecord, len(h.pending))
+	copy(pending, h.pending)
+	return segments, pending
+}
+
+// read returns the current segments, and the entries not yet written to them
+func (h *DiskHistory) read() ([]segment, []Entry) {
+	segments, pending := h.snapshot()
+	cutoff := h.cutoff()
+	entries := make([]Entry, 0, len(pending))
+	for _, rec := range pending {
+		e, err := decodeRecord(rec.line)
+		if err == nil && !e.Time.Before(cutoff) {
+			entries = append(entries, e)
+		}
+	}
+	return segments, entries
+}
+
+// Stats counts the entries in every segment, including any older than MaxAge
+// which haven't been removed yet, and those waiting to be written
+func (h *DiskHistory) Stats() (ret HistoryStats) {
+	defer h.m.RUnlock()
+	h.m.RLock()
+	for _, seg := range h.segments {
+		ret.Entries += seg.count
+		ret.Bytes += seg.size
+	}
+	for _, rec := range h.pending {
+		ret.Entries += 1
+		ret.Bytes += int64(len(rec.line))
+	}
+	ret.MaxBytes = h.opts.MaxBytes
+	return
+}
+
+// LastSeq returns the sequence number of the newest entry pushed, even if it's
+// older than MaxAge, so that a dispatcher carries on numbering after it
+func (h *DiskHistory) LastSeq() (ret uint64) {
+	defer h.m.RUnlock()
+	h.m.RLock()
+	for _, seg := range h.segments {
+		if seg.last > ret {
+			ret = seg.last
+		}
+	}
+	for _, rec := range h.pending {
+		if rec.seq > ret {
+			ret = rec.seq
+		}
+	}
+	return
+}
+
+// Epoch returns the epoch of the sequence numbers, which is kept with the
+// segments, so that a dispatcher carries on in it after a restart
+func (h *DiskHistory) Epoch() string {
+	return h.epoch
+}
+
+func (h *DiskHistory) Last(n int, f func(e Entry)) {
+	// Read newest first, so that entries older than MaxAge aren't counted
+	entries := make([]Entry, 0)
+	h.Reverse(func(e Entry) bool {
+		if len(entries) >= n {
+			return false
+		}
+		entries = append(entries, e)
+		return true
+	})
+	for i := len(entries) - 1; i >= 0; i -= 1 {
+		f(entries[i])
+	}
+}
+
+func (h *DiskHistory) Since(seq uint64, f func(e Entry)) {
+	h.Forward(seq, func(e Entry) bool {
+		f(e)
+		return true
+	})
+}
+
+// Forward calls f for each entry with a sequence number after seq, oldest
+// first, until f returns false. Only the segments needed are read.
+func (h *DiskHistory) Forward(seq uint64, f func(e Entry) bool) {
+	segments, pending := h.read()
+	for _, seg := range segments {
+		if seg.last <= seq {
+			continue
+		}
+		stopped := false
+		h.scan(&seg, func(e Entry) bool {
+			if e.Seq > seq && !f(e) {
+				stopped = true
+			}
+			return !stopped
+		})
+		if stopped {
+			return
+		}
+	}
+	for _, e := range pending {
+		if e.Seq > seq && !f(e) {
+			return
+		}
+	}
+}
+
+// Reverse calls f for each entry, newest first, until f returns false. Only
+// the segments needed are read.
+func (h *DiskHistory) Reverse(f func(e Entry) bool) {
+	segments, pending := h.read()
+	for i := len(pending) - 1; i >= 0; i -= 1 {
+		if !f(pending[i]) {
+			return
+		}
+	}
+	for i := len(segments) - 1; i >= 0; i -= 1 {
+		entries := make([]Entry, 0, segments[i].count)
+		h.scan(&segments[i], func(e Entry) bool {
+			entries = append(entries, e)
+			return true
+		})
+		for j := len(entries) - 1; j >= 0; j -= 1 {
+			if !f(entries[j]) {
+				return
+			}
+		}
+	}
+}
+
+func (h *DiskHistory) Oldest() (ret Entry, ok bool) {
+	segments, pending := h.read()
+	for _, seg := range segments {
+		h.scan(&seg, func(e Entry) bool {
+			ret, ok = e, true
+			return false
+		})
+		if ok {
+			return
+		}
+	}
+	if len(pending) > 0 {
+		return pending[0], true
+	}
+	return
+}
+
+func (h *DiskHistory) Newest() (ret Entry, ok bool) {
+	h.Reverse(func(e Entry) bool {
+		ret, ok = e, true
+		return false
+	})
+	return
+}
+
+// scan calls f for each entry in a segment, until f returns false. Entries
+// older than MaxAge are skipped.
+func (h *DiskHistory) scan(seg *segment, f func(e Entry) bool) {
+	file, err := os.Open(seg.path)
+	if os.IsNotExist(err) {
+		// Removed by retention since the segments were copied
+		return
+	} else if err != nil {
+		fmt.Printf("weblog: unable to read history segment: %s\n", err.Error())
+		return
+	}
+	defer file.Close()
+	cutoff := h.cutoff()
+	// Only read as far as the segment is known to extend, in case a write is
+	// in progress
+	sc := bufio.NewScanner(io.LimitReader(file, seg.size))
+	sc.Buffer(make([]byte, 0, 64 * 1024), maxRecordSize)
+	for sc.Scan() {
+		e, err := decodeRecord(sc.Bytes())
+		if err != nil || e.Time.Before(cutoff) {
+			continue
+		}
+		if !f(e) {
+			return
+		}
+	}
+}
+
+func encodeRecord(e *Entry) ([]byte, error) {
+	rec := record{Seq: e.Seq, Time: e.Time, Level: e.Level.String(), Message: e.Message, Stream: e.Stream}
+	if len(e.Data) > 0 {
+		rec.Data = make(logrus.Fields, len(e.Data))
+		for k, v := range e.Data {
+			if err, ok := v.(error); ok {
+				v = err.Error()
+			} else if _, ok := v.(json.RawMessage); ok {
+				rec.Raw = append(rec.Raw, k)
+			} else if _, err := json.Marshal(v); err != nil {
+				v = fmt.Sprint(v)
+			}
+			rec.Data[k] = v
+		}
+		sort.Strings(rec.Raw)
+	}
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
+
+func decodeRecord(line []byte) (e Entry, err error) {
+	rec := struct {
+		record
+		Data map[string]json.RawMessage `json:"data,omitempty"`
+	}{}
+	err = json.Unmarshal(bytes.TrimSpace(line), &rec)
+	if err != nil {
+		return
+	}
+	level, err := logrus.ParseLevel(rec.Level)
+	if err != nil {
+		return
+	}
+	e.Seq = rec.Seq
+	e.Time = rec.Time
+	e.Level = level
+	e.Message = rec.Message
+	if rec.Stream != "" {
+		e.Stream = rec.Stream
+		e.Context = predicate.WithStream(nil, rec.Stream)
+	}
+	e.Data = make(logrus.Fields, len(rec.Data))
+	for k, v := range rec.Data {
+		if i := sort.SearchStrings(rec.Raw, k); i < len(rec.Raw) && rec.Raw[i] == k {
+			e.Data[k] = v
+			continue
+		}
+		// Numbers are decoded as they were written, so that integers compare
+		// equal to integers in a query
+		dec := json.NewDecoder(bytes.NewReader(v))
+		dec.UseNumber()
+		var val interface{}
+		if err = dec.Decode(&val); err != nil {
+			return
+		}
+		e.Data[k] = decodeNumbers(val)
+	}
+	return
+}
+
+// decodeNumbers replaces the json.Numbers in a decoded value with an int64,
+// if they're whole, or a float64
+func decodeNumbers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	case map[string]interface{}:
+		for k, x := range t {
+			t[k] = decodeNumbers(x)
+		}
+	case []interface{}:
+		for i, x := range t {
+			t[i] = decodeNumbers(x)
+		}
+	}
+	return v
+}
@@ -0,0 +1,388 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * disk_test.go: Test of the disk backed history
+ */
+
+package dispatcher
+
+import (
+	"testing"
+	"encoding/json"
+	"fmt"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"github.com/sirupsen/logrus"
+)
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "weblog-history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func seqs(h History, since uint64) string {
+	out := make([]string, 0)
+	h.Since(since, func(e Entry) {
+		out = append(out, fmt.Sprint(e.Seq))
+	})
+	return strings.Join(out, ",")
+}
+
+func pushN(h History, from, to uint64) {
+	for seq := from; seq <= to; seq += 1 {
+		h.Push(Entry{Entry: logrus.Entry{Message: fmt.Sprint("entry ", seq), Level: logrus.InfoLevel, Time: time.Now(), Data: logrus.Fields{"n": seq}}, Seq: seq})
+	}
+}
+
+func TestDiskHistory_Reopen(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	h, err := NewDiskHistory(dir, DiskOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pushN(h, 1, 5)
+	h.Push(Entry{Entry: logrus.Entry{Message: "err", Level: logrus.ErrorLevel, Data: logrus.Fields{"error": errors.New("boom"), "ch": make(chan int)}}, Seq: 6})
+	_ = h.Close()
+	epoch := h.Epoch()
+
+	h, err = NewDiskHistory(dir, DiskOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+	if epoch == "" || h.Epoch() != epoch {
+		fmt.Printf("Epoch %q became %q\n", epoch, h.Epoch())
+		t.Fail()
+	}
+	if out := seqs(h, 2); out != "3,4,5,6" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+	if out := messages(h, 2); out != "entry 5,err" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+	e, ok := h.Newest()
+	if !ok || e.Seq != 6 || e.Level != logrus.ErrorLevel || e.Data["error"] != "boom" {
+		fmt.Printf("Got %#v\n", e)
+		t.Fail()
+	}
+	if e, ok := h.Oldest(); !ok || e.Seq != 1 || e.Data["n"] != int64(1) {
+		fmt.Printf("Got %#v\n", e)
+		t.Fail()
+	}
+	pushN(h, 7, 7)
+	if out := seqs(h, 5); out != "6,7" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+}
+
+func TestDiskHistory_Recover(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	h, err := NewDiskHistory(dir, DiskOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pushN(h, 1, 3)
+	_ = h.Close()
+
+	// A crash part way through writing a record
+	path := h.segments[0].path
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString(`{"seq":4,"time":"`)
+	_ = f.Close()
+
+	h, err = NewDiskHistory(dir, DiskOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+	pushN(h, 4, 4)
+	if out := seqs(h, 0); out != "1,2,3,4" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+}
+
+func TestDiskHistory_Retention(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	h, err := NewDiskHistory(dir, DiskOptions{SegmentSize: 300, MaxBytes: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+	pushN(h, 1, 50)
+	if err := h.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*" + segmentExt))
+	if len(files) > 5 || len(files) < 3 {
+		fmt.Printf("Got %d segments\n", len(files))
+		t.Fail()
+	}
+	oldest, _ := h.Oldest()
+	newest, _ := h.Newest()
+	if oldest.Seq <= 1 || newest.Seq != 50 {
+		fmt.Printf("Got %d to %d\n", oldest.Seq, newest.Seq)
+		t.Fail()
+	}
+	if out := messages(h, 3); out != "entry 48,entry 49,entry 50" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+	// Reading newest first crosses segments, and stops when asked
+	out := make([]string, 0)
+	h.Reverse(func(e Entry) bool {
+		out = append(out, fmt.Sprint(e.Seq))
+		return len(out) < 8
+	})
+	if strings.Join(out, ",") != "50,49,48,47,46,45,44,43" {
+		fmt.Printf("Got %v\n", out)
+		t.Fail()
+	}
+}
+
+func TestDiskHistory_MaxAge(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	h, err := NewDiskHistory(dir, DiskOptions{MaxAge: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+	h.Push(Entry{Entry: logrus.Entry{Message: "old", Time: time.Now().Add(-2 * time.Hour)}, Seq: 1})
+	pushN(h, 2, 3)
+	if out := seqs(h, 0); out != "2,3" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+	// Expired entries aren't counted among the newest, whether they've been
+	// written yet or not
+	for _, sync := range []bool{false, true} {
+		if sync {
+			_ = h.Sync()
+		}
+		if out := messages(h, 2); out != "entry 2,entry 3" {
+			fmt.Printf("Synced %v: got %s\n", sync, out)
+			t.Fail()
+		}
+	}
+}
+
+// Entries are readable as soon as they're pushed, and on disk once synced
+func TestDiskHistory_Sync(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	h, err := NewDiskHistory(dir, DiskOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+	pushN(h, 1, 3)
+	if out := seqs(h, 1); out != "2,3" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+	if e, ok := h.Newest(); !ok || e.Seq != 3 || e.Data["n"] != int64(3) {
+		fmt.Printf("Got %#v\n", e)
+		t.Fail()
+	}
+	if err := h.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*" + segmentExt))
+	if len(files) != 1 {
+		t.Fatalf("Got %d segments", len(files))
+	}
+	data, _ := ioutil.ReadFile(files[0])
+	if strings.Count(string(data), "\n") != 3 {
+		fmt.Printf("Got %q\n", data)
+		t.Fail()
+	}
+	if st := h.Stats(); st.Entries != 3 || st.Bytes != int64(len(data)) {
+		fmt.Printf("Stats %#v\n", st)
+		t.Fail()
+	}
+}
+
+func TestDispatcher_DiskHistory(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	h, err := NewDiskHistory(dir, DiskOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pushN(h, 1, 10)
+	d := NewDispatcher(HistoryStore(h))
+	s, err := NewSelectorAfter("", "", d.Epoch(), 9, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 10 from before, and the dispatcher's started entry
+	a, b := read(s), read(s)
+	if a == nil || b == nil || a.Seq != 10 || b.Seq != 11 {
+		fmt.Printf("Got %#v %#v\n", a, b)
+		t.Fail()
+	}
+	s.Stop()
+	d.Stop()
+}
+
+// Numbers read back from the disk compare as they were logged
+func TestDispatcher_DiskHistoryNumbers(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	h, err := NewDiskHistory(dir, DiskOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDispatcher(HistoryStore(h))
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	log.WithFields(logrus.Fields{"status": 500, "elapsed": 1.5}).Info("failed")
+	log.WithFields(logrus.Fields{"status": 200, "elapsed": 0.5}).Info("ok")
+	// Wait for both entries, after the started entry, to reach the history
+	for i := 0; h.Stats().Entries < 3; i += 1 {
+		if i == 100 {
+			t.Fatal("timed out")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	var tests = []struct {
+		expression string
+		count int
+	}{
+		{"Field(status) == 500", 1},
+		{"Field(status) >= 500", 1},
+		{"Field(status) < 500", 1},
+		{"Field(elapsed) == 1.5", 1},
+	}
+	for _, test := range tests {
+		res, err := d.Query(HistoryQuery{Expression: test.expression})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Entries) != test.count {
+			fmt.Printf("%s matched %d entries, expected %d\n", test.expression, len(res.Entries), test.count)
+			t.Fail()
+		}
+	}
+}
+
+// Values encoded as they were logged are read back from the disk, waiting to
+// be written or not, as they were, so queries match them as they do live
+func TestDispatcher_DiskHistoryRaw(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	h, err := NewDiskHistory(dir, DiskOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDispatcher(HistoryStore(h))
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	live, err := NewSelector(`Field(obj) == '{"a":1}'`, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log.WithFields(logrus.Fields{"obj": map[string]int{"a": 1}, "list": []string{"x"}}).Info("nested")
+	log.WithField("obj", json.RawMessage(`{"a":2}`)).Info("other")
+	if e := read(live); e == nil || e.Message != "nested" {
+		t.Fatalf("Got %#v", e)
+	}
+	live.Stop()
+
+	check := func(when string, d *Dispatcher) {
+		res, err := d.Query(HistoryQuery{Expression: `Field(obj) == '{"a":1}' && Field(list) == '["x"]'`})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Entries) != 1 || res.Entries[0].Message != "nested" {
+			fmt.Printf("Got %d entries %s\n", len(res.Entries), when)
+			t.Fail()
+			return
+		}
+		if _, ok := res.Entries[0].Data["obj"].(json.RawMessage); !ok {
+			fmt.Printf("Got %#v %s\n", res.Entries[0].Data["obj"], when)
+			t.Fail()
+		}
+	}
+	// Possibly still waiting to be written
+	check("before writing", d)
+	// Closing the dispatcher closes the history, writing what's pending
+	if err := d.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	h, err = NewDiskHistory(dir, DiskOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d = NewDispatcher(HistoryStore(h))
+	defer d.Stop()
+	check("after reopening", d)
+}
+
+// A dispatcher carries on numbering after entries which have expired
+func TestDispatcher_DiskHistoryExpired(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	h, err := NewDiskHistory(dir, DiskOptions{MaxAge: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for seq := uint64(1); seq <= 6; seq += 1 {
+		h.Push(Entry{Entry: logrus.Entry{Message: "old", Time: time.Now().Add(-2 * time.Hour)}, Seq: seq})
+	}
+	_ = h.Close()
+
+	h, err = NewDiskHistory(dir, DiskOptions{MaxAge: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDispatcher(HistoryStore(h))
+	d.Stop()
+	h, err = NewDiskHistory(dir, DiskOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+	if out := seqs(h, 0); out != "1,2,3,4,5,6,7" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+}
@@ -23,50 +23,174 @@
 package dispatcher
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"github.com/jwriteclub/weblog/predicate"
 	"github.com/sirupsen/logrus"
+	"io"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 type Dispatcher struct {
+	// Entries discarded by the hook, and dispatched, those delivered to and
+	// dropped by every selector, the alert version and the last sequence
+	// number assigned, first for atomic alignment
+	dropped uint64
+	ingested uint64
+	delivered uint64
+	selectorDropped uint64
+	alertVersion uint64
+	// Only changed by the dispatch goroutine, which may read it directly
+	seq uint64
+	// The union of the levels wanted by the selectors and the history, read
+	// atomically by the hook
+	levels uint32
+	lm *sync.Mutex
+	selectorLevels map[*Selector]predicate.LevelSet
+	historyLevels predicate.LevelSet
 	q chan logrus.Entry
-	stop chan bool
+	// Closed by Close, which stops the dispatch goroutine, and means the hook
+	// never blocks
+	done chan struct{}
+	closeOnce *sync.Once
+	// The error closing the history, returned by Close
+	closeErr error
 	wg *sync.WaitGroup
-	history [logBuffer]logrus.Entry
+	history History
 	selectors []*Selector
-	register chan *Selector
+	register chan registration
+	replayed chan *Selector
 	unregister chan *Selector
-	ptr int
-	curr int
+	historySize int
+	retention []retention
+	selectorQueue int
+	ingestQueue int
+	byteBudget int
+	overflow OverflowPolicy
+	timeout time.Duration
+	shutdown ShutdownPolicy
+	ctx context.Context
+	name string
+	// Identifies the numbering of the entries, see Epoch
+	epoch string
+	// Set when there are redaction rules
+	redact *redactor
+	// Set when there are fields to add
+	enrich *enricher
+	filterWorkers int
+	// Set when filterWorkers is more than one
+	filter *filterPool
+	// The ingest rate reported by Stats, and the time and count it was
+	// measured from, guarded by lm. See measure.
+	rate float64
+	rated bool
+	rateTime time.Time
+	rateCount uint64
+	// The alert rules, by name, and the channel closed when they next change
+	am *sync.Mutex
+	alerts map[string]*alerter
+	alertChanged chan struct{}
 }
 
+// registration asks the dispatch goroutine to add a selector. It replies with
+// the sequence number of the last entry dispatched, which the history is
+// replayed up to.
+type registration struct {
+	s *Selector
+	seq chan uint64
+}
+
+// How often gaps and repeats which didn't fit in a selector's queue are tried
+// again
+const retryInterval = time.Second
+
+// Default sizes, see the options of the same names
 const chanBuffer = 64
 const logBuffer = 64
 
-func NewDispatcher() (ret *Dispatcher) {
+func NewDispatcher(opts ...Option) (ret *Dispatcher) {
 	ret = &Dispatcher{}
-	ret.q = make(chan logrus.Entry, chanBuffer)
-	ret.stop = make(chan bool, 0)
+	ret.historySize = logBuffer
+	ret.selectorQueue = chanBuffer
+	ret.ingestQueue = chanBuffer
+	for _, opt := range opts {
+		opt(ret)
+	}
+	if ret.ingestQueue == 0 && ret.overflow == OverflowDropOldest {
+		// Without a queue there's never an older entry to drop
+		ret.overflow = OverflowDropNewest
+	}
+	ret.q = make(chan logrus.Entry, ret.ingestQueue)
+	ret.done = make(chan struct{})
+	ret.closeOnce = &sync.Once{}
 	ret.wg = &sync.WaitGroup{}
-	ret.history = [logBuffer]logrus.Entry{}
+	if ret.history == nil {
+		if len(ret.retention) > 0 {
+			ret.history = newLevelHistory(ret.historySize, ret.retention, ret.byteBudget)
+		} else {
+			ret.history = newMemoryHistory(ret.historySize, ret.byteBudget)
+		}
+	}
 	ret.selectors = make([]*Selector, 0)
-	ret.register = make(chan *Selector, 0)
+	ret.register = make(chan registration, 0)
+	ret.replayed = make(chan *Selector, 0)
 	ret.unregister = make(chan *Selector, 0)
-	ret.ptr = 0
-	ret.curr = 0
-	ret.history[ret.ptr] = logrus.Entry{Data:logrus.Fields{"prefix": "weblog-dispatcher", "event": "started"}, Message: "Weblog dispatcher started", Level:logrus.InfoLevel, Time: time.Now()}
+	ret.lm = &sync.Mutex{}
+	ret.am = &sync.Mutex{}
+	ret.alerts = make(map[string]*alerter)
+	ret.alertChanged = make(chan struct{})
+	ret.selectorLevels = make(map[*Selector]predicate.LevelSet)
+	ret.historyLevels = predicate.AllLevels
+	ret.levels = uint32(predicate.AllLevels)
+	ret.rateTime = time.Now()
+	// Carry on numbering from a history which outlived a previous dispatcher
+	ret.seq = lastSeq(ret.history)
+	ret.epoch = newEpoch()
+	if sq, ok := ret.history.(sequencer); ok {
+		ret.epoch = sq.Epoch()
+	}
+	started := logrus.Entry{Data:logrus.Fields{"prefix": "weblog-dispatcher", "event": "started"}, Message: "Weblog dispatcher started", Level:logrus.InfoLevel, Time: time.Now()}
+	ret.stamp(&started)
+	ret.history.Push(ret.number(started))
+	if ret.filterWorkers > 1 {
+		ret.filter = newFilterPool(ret.filterWorkers)
+	}
+	ret.wg.Add(1)
 	go ret.dispatch()
+	if ret.ctx != nil {
+		go func() {
+			select {
+			case <-ret.ctx.Done():
+				_ = ret.Close()
+			case <-ret.done:
+				break
+			}
+		}()
+	}
 	return
 }
 
 func (r *Dispatcher) dispatch() {
 	defer r.wg.Done()
-	r.wg.Add(1)
 	run := true
+	rateTicker := time.NewTicker(rateInterval)
+	defer rateTicker.Stop()
+	retryTicker := time.NewTicker(retryInterval)
+	defer retryTicker.Stop()
 
 	for run {
 		select {
+		case <-rateTicker.C:
+			r.measure()
+			break
+		case <-retryTicker.C:
+			r.retry()
+			break
 		case s:=<-r.unregister:
 			fmt.Printf("dispatcher: unregistered a selector")
 			sel := make([]*Selector, 0)
@@ -77,71 +201,327 @@ func (r *Dispatcher) dispatch() {
 				sel = append(sel, c)
 			}
 			r.selectors = sel
+			// Let anything waiting on the selector know it's finished. One
+			// still being replayed to is closed once the replay is done.
+			if !s.replaying {
+				s.close()
+			}
 			break
-		case <-r.stop:
+		case <-r.done:
 			run = false
+			r.shut()
 			break
-		case e := <- r.q:
+		case le := <- r.q:
 //			fmt.Printf("dispatcher: got a log entry\n")
-			r.curr += 1
-			r.curr %= logBuffer
-			if r.curr == r.ptr {
-				r.ptr += 1
-				r.ptr %= logBuffer
-			}
-			r.history[r.curr] = e
-			for _, s := range r.selectors {
-				// Try to write the queue, but if it's full, don't deadlock
-				select {
-				case s.q<- e:
-					break
-				default:
-					break
-				}
-			}
+			r.process(le)
 			break
-		case s := <- r.register:
+		case reg := <- r.register:
 			fmt.Printf("dispatcher: registered a selector\n")
-			r.selectors = append(r.selectors, s)
-			p := r.ptr
-			for p != r.curr {
-				select {
-				case s.q <- r.history[p]:
-					break
-				default:
-					break
-				}
-				p += 1
-				p %= logBuffer
+			r.selectors = append(r.selectors, reg.s)
+			reg.s.replaying = true
+			reg.seq <- r.seq
+			break
+		case s := <-r.replayed:
+			r.live(s)
+			break
+		}
+	}
+}
+
+// process numbers an entry, keeps it in the history and sends it on
+func (r *Dispatcher) process(le logrus.Entry) {
+	e := r.number(le)
+	if r.keeps(e.Level) {
+		r.history.Push(e)
+	}
+	r.send(e)
+	atomic.AddUint64(&r.ingested, 1)
+}
+
+// shut finishes the dispatch goroutine's work, once the dispatcher is closed
+func (r *Dispatcher) shut() {
+	if r.shutdown == ShutdownDrain {
+		r.drain()
+	} else {
+		r.discard()
+	}
+	for _, s := range r.selectors {
+		r.clearLevels(s)
+		// Those still being replayed to are closed by Register
+		if !s.replaying {
+			s.close()
+		}
+	}
+	r.selectors = nil
+	if r.filter != nil {
+		r.filter.stop()
+	}
+	if c, ok := r.history.(io.Closer); ok {
+		r.closeErr = c.Close()
+	}
+}
+
+// drain processes the entries left in the ingest queue
+func (r *Dispatcher) drain() {
+	for {
+		select {
+		case le := <-r.q:
+			r.process(le)
+		default:
+			return
+		}
+	}
+}
+
+// discard drops the entries left in the ingest queue
+func (r *Dispatcher) discard() {
+	for {
+		select {
+		case <-r.q:
+			r.drop()
+		default:
+			return
+		}
+	}
+}
+
+// replay sends the history, up to the entry with sequence number seq, to a
+// newly registered selector. It's called from the goroutine registering the
+// selector, as reading the history may take a while, and meanwhile the
+// dispatch goroutine holds the entries after seq for it, see live.
+func (r *Dispatcher) replay(s *Selector, seq uint64) {
+	if !s.resume {
+		// Only the newest matching entries which fit in the queue are sent
+		n := cap(s.q)
+		matches := make([]Entry, 0, n)
+		newestFirst(r.history, func(e Entry) bool {
+			if len(matches) >= n {
+				return false
 			}
-			// Add the current one as well, or else selectors catching up miss out on one entry
-			select {
-			case s.q <- r.history[r.curr]:
-				break
-			default:
-				break
+			if e.Seq <= seq && s.true(&e.Entry) {
+				matches = append(matches, e)
 			}
-			break
+			return true
+		})
+		for i := len(matches) - 1; i >= 0; i -= 1 {
+			s.deliver(matches[i])
 		}
+		return
 	}
+	if gap := gapSince(r.history, s.after, seq + 1); gap.Dropped > 0 {
+		// The entries in between are gone, or were never kept
+		s.gap = gap
+		s.flush()
+	}
+	r.history.Since(s.after, func(e Entry) {
+		if e.Seq <= seq {
+			s.accept(e)
+		}
+	})
 }
 
+// live sends a selector the entries held for it while the history was
+// replayed, and from then on sends entries straight to it. If it was
+// unregistered meanwhile, it's closed instead.
+func (r *Dispatcher) live(s *Selector) {
+	s.replaying = false
+	held, heldGap := s.held, s.heldGap
+	s.held, s.heldGap = nil, Gap{}
+	registered := false
+	for _, c := range r.selectors {
+		registered = registered || c == s
+	}
+	if !registered {
+		s.close()
+		return
+	}
+	for _, e := range held {
+		s.deliver(e)
+	}
+	if heldGap.Dropped > 0 {
+		s.gap.merge(&heldGap)
+		s.flush()
+	}
+}
+
+// retry queues the gaps and repeats waiting for room in selectors' queues,
+// in case no more entries arrive to queue them ahead of
+func (r *Dispatcher) retry() {
+	for _, s := range r.selectors {
+		if !s.replaying {
+			s.flush()
+		}
+	}
+}
+
+// number assigns the next sequence number to an entry
+func (r *Dispatcher) number(e logrus.Entry) Entry {
+	return Entry{Entry: e, Seq: atomic.AddUint64(&r.seq, 1), Stream: r.name}
+}
+
+// send offers an entry to every registered selector it matches. Filtering
+// here, rather than when the entry is read, means a selector's queue only
+// fills with entries it wants.
+func (r *Dispatcher) send(e Entry) {
+	if r.filter == nil || len(r.selectors) < 2 {
+		for _, s := range r.selectors {
+			if s.true(&e.Entry) {
+				s.receive(e)
+			}
+		}
+		return
+	}
+	matches := r.filter.match(&e.Entry, r.selectors)
+	for i, s := range r.selectors {
+		if matches[i] {
+			s.receive(e)
+		}
+	}
+}
+
+// keeps reports whether entries at level l are kept in the history
+func (r *Dispatcher) keeps(l logrus.Level) bool {
+	defer r.lm.Unlock()
+	r.lm.Lock()
+	return r.historyLevels.Has(l)
+}
+
+// Register adds a selector, which is sent the history and then new entries.
+// The history is read by the calling goroutine, so logging isn't held up
+// while it is. Once the dispatcher is closed, the selector is closed straight
+// away.
 func (r *Dispatcher) Register(s *Selector) {
-	r.register<-s
+	r.setLevels(s, s.Levels())
+	reg := registration{s: s, seq: make(chan uint64, 1)}
+	select {
+	case r.register<-reg:
+		break
+	case <-r.done:
+		// Never registered, so never unregistered either
+		r.clearLevels(s)
+		s.close()
+		return
+	}
+	r.replay(s, <-reg.seq)
+	select {
+	case r.replayed<-s:
+		break
+	case <-r.done:
+		// The dispatch goroutine has finished, or will without closing it
+		r.clearLevels(s)
+		s.close()
+	}
 }
 
 func (r *Dispatcher) Unregister(s *Selector) {
-	r.unregister<-s
+	r.clearLevels(s)
+	select {
+	case r.unregister<-s:
+		break
+	case <-r.done:
+		break
+	}
+}
+
+// SetHistoryLevels sets the levels kept in the history for selectors which
+// register later. By default every level is kept. Entries at levels neither
+// kept in the history nor wanted by a registered selector are discarded by
+// the hook without being queued.
+func (r *Dispatcher) SetHistoryLevels(levels ...logrus.Level) {
+	defer r.lm.Unlock()
+	r.lm.Lock()
+	r.historyLevels = predicate.NewLevelSet(levels...)
+	r.updateLevels()
+}
+
+// Levels returns the levels of the entries the dispatcher currently needs
+func (r *Dispatcher) Levels() []logrus.Level {
+	return predicate.LevelSet(atomic.LoadUint32(&r.levels)).Levels()
+}
+
+func (r *Dispatcher) wants(l logrus.Level) bool {
+	return predicate.LevelSet(atomic.LoadUint32(&r.levels)).Has(l)
+}
+
+func (r *Dispatcher) setLevels(s *Selector, levels predicate.LevelSet) {
+	defer r.lm.Unlock()
+	r.lm.Lock()
+	r.selectorLevels[s] = levels
+	r.updateLevels()
+}
+
+func (r *Dispatcher) clearLevels(s *Selector) {
+	defer r.lm.Unlock()
+	r.lm.Lock()
+	delete(r.selectorLevels, s)
+	r.updateLevels()
+}
+
+// updateLevels recomputes the union of wanted levels. r.lm must be held.
+func (r *Dispatcher) updateLevels() {
+	levels := r.historyLevels
+	for _, l := range r.selectorLevels {
+		levels |= l
+	}
+	atomic.StoreUint32(&r.levels, uint32(levels))
+}
+
+// Epoch identifies the numbering of the dispatcher's entries. Sequence numbers
+// can only be compared within an epoch: a new dispatcher, after a restart
+// say, starts a new one, unless its history carries on from the last, as a
+// DiskHistory does.
+func (r *Dispatcher) Epoch() string {
+	return r.epoch
+}
+
+// newEpoch returns a random epoch
+func newEpoch() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		// Unique enough, as long as two dispatchers don't start together
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return hex.EncodeToString(b)
+}
+
+// Name returns the name of the dispatcher's stream, see the Name option
+func (r *Dispatcher) Name() string {
+	return r.name
+}
+
+// stamp adds the enrichment fields to an entry, redacts it, including the
+// fields just added, and puts the stream in its context, if the dispatcher is
+// named, for predicates to find
+func (r *Dispatcher) stamp(e *logrus.Entry) {
+	if r.enrich != nil {
+		r.enrich.apply(e)
+	}
+	if r.redact != nil {
+		r.redact.apply(e)
+	}
+	if r.name != "" {
+		e.Context = predicate.WithStream(e.Context, r.name)
+	}
 }
 
 func (r *Dispatcher) Hook() logrus.Hook {
 	return DispatcherHook{r}
 }
 
+// Close stops the dispatcher. Queued entries are dispatched or discarded,
+// according to the ShutdownPolicy, then every selector is closed, and the
+// history too if it's an io.Closer. Entries logged afterwards are dropped.
+// Close may be called more than once, and always returns the error from
+// closing the history.
+func (r *Dispatcher) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.done)
+	})
+	r.wg.Wait()
+	return r.closeErr
+}
+
+// Stop is Close, ignoring the error
 func (r *Dispatcher) Stop() {
-	defer r.wg.Wait()
-	for _, s := range r.selectors {
-		r.Unregister(s)
-	}
-	r.stop <- true
+	_ = r.Close()
 }
@@ -0,0 +1,521 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * dispatcher_test.go: Test of dispatching entries to selectors
+ */
+
+package dispatcher
+
+import (
+	"testing"
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+	"github.com/jwriteclub/weblog/predicate"
+	"github.com/sirupsen/logrus"
+)
+
+// read waits for the next entry from a selector
+func read(s *Selector) *Entry {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if e := s.MaybeRead(); e != nil {
+			return e
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return nil
+}
+
+func TestDispatcher_Seq(t *testing.T) {
+	d := NewDispatcher()
+	defer d.Stop()
+	log := logrus.New()
+	log.AddHook(d.Hook())
+	s, err := NewSelector("Prefix(test)", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	log.WithField("prefix", "test").Info("one")
+	log.WithField("prefix", "other").Info("skipped")
+	log.WithField("prefix", "test").Info("two")
+	one, two := read(s), read(s)
+	if one == nil || two == nil {
+		t.Fatal("timed out")
+	}
+	// The dispatcher's own started entry is 1
+	if one.Message != "one" || one.Seq != 2 || two.Message != "two" || two.Seq != 4 {
+		fmt.Printf("Got %s=%d, %s=%d\n", one.Message, one.Seq, two.Message, two.Seq)
+		t.Fail()
+	}
+}
+
+func TestDispatcher_Resume(t *testing.T) {
+	d := NewDispatcher(HistorySize(3))
+	defer d.Stop()
+	log := logrus.New()
+	log.AddHook(d.Hook())
+	s, err := NewSelector("", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	for _, m := range []string{"a", "b", "c", "d", "e"} {
+		log.Info(m)
+	}
+	// Wait for everything to reach the history: started is 1, a to e are 2 to 6
+	for e := read(s); e == nil || e.Seq != 6; e = read(s) {
+		if e == nil {
+			t.Fatal("timed out")
+		}
+	}
+
+	var tests = []struct {
+		after uint64
+		output string
+	}{
+		{4, "5,6"},
+		{6, ""},
+		{1, "gap 2-3,4,5,6"},
+		{100, "4,5,6"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(fmt.Sprint(test.after), func(t *testing.T) {
+			r, err := NewSelectorAfter(predicate.SyntaxSelect, "", d.Epoch(), test.after, d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Stop()
+			if r.Resumed() != (test.after <= 6) {
+				fmt.Printf("Resumed %v\n", r.Resumed())
+				t.Fail()
+			}
+			// Once the dispatch loop takes another message, the replay is done
+			x, _ := NewSelector("", d)
+			x.Stop()
+			out := make([]string, 0)
+			for e := r.MaybeRead(); e != nil; e = r.MaybeRead() {
+				if e.Gap != nil {
+					out = append(out, fmt.Sprintf("gap %d-%d", e.Gap.FirstSeq, e.Gap.LastSeq))
+				} else {
+					out = append(out, fmt.Sprint(e.Seq))
+				}
+			}
+			if strings.Join(out, ",") != test.output {
+				fmt.Printf("Expected %s but got %s\n", test.output, strings.Join(out, ","))
+				t.Fail()
+			}
+		})
+	}
+
+	// Numbers from another epoch, such as before a restart, aren't resumed
+	r, err := NewSelectorAfter(predicate.SyntaxSelect, "", "restarted", 4, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Stop()
+	if r.Resumed() {
+		t.Fail()
+	}
+}
+
+// Replay fills the queue with the newest matching entries, not the matches
+// among the newest entries
+func TestDispatcher_ReplayFiltered(t *testing.T) {
+	d := NewDispatcher(HistorySize(20), SelectorQueue(2))
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	for _, m := range []string{"one", "two", "three"} {
+		log.WithField("prefix", "test").Info(m)
+		for i := 0; i < 5; i += 1 {
+			log.WithField("prefix", "other").Info("noise")
+		}
+	}
+	// Wait for everything to reach the history: started, then 18 entries
+	for i := 0; d.Stats().History.Entries < 19; i += 1 {
+		if i == 100 {
+			t.Fatal("timed out")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	s, err := NewSelector("Prefix(test)", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	one, two := read(s), read(s)
+	if one == nil || two == nil {
+		t.Fatal("timed out")
+	}
+	if one.Message != "two" || two.Message != "three" {
+		fmt.Printf("Got %s, %s\n", one.Message, two.Message)
+		t.Fail()
+	}
+}
+
+// slowHistory holds up reading the history newest first until released
+type slowHistory struct {
+	*memoryHistory
+	reading chan struct{}
+	release chan struct{}
+}
+
+func (h slowHistory) Reverse(f func(e Entry) bool) {
+	h.reading <- struct{}{}
+	<-h.release
+	newestFirst(h.memoryHistory, f)
+}
+
+// Logging carries on while the history is replayed to a new selector, and
+// the entries logged meanwhile follow the replay
+func TestDispatcher_ReplayConcurrent(t *testing.T) {
+	h := slowHistory{newMemoryHistory(64, 0), make(chan struct{}), make(chan struct{})}
+	d := NewDispatcher(HistoryStore(h), IngestQueue(0))
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	log.Info("before")
+
+	created := make(chan *Selector)
+	go func() {
+		s, _ := NewSelector("", d)
+		created <- s
+	}()
+	<-h.reading
+	logged := make(chan struct{})
+	go func() {
+		for i := 0; i < 10; i += 1 {
+			log.Info(i)
+		}
+		close(logged)
+	}()
+	select {
+	case <-logged:
+		break
+	case <-time.After(time.Second):
+		t.Fatal("logging blocked by the replay")
+	}
+	close(h.release)
+	s := <-created
+	defer s.Stop()
+
+	out := make([]string, 0)
+	for len(out) < 12 {
+		e := read(s)
+		if e == nil {
+			t.Fatal("timed out")
+		}
+		out = append(out, e.Message)
+	}
+	if strings.Join(out, ",") != "Weblog dispatcher started,before,0,1,2,3,4,5,6,7,8,9" {
+		fmt.Printf("Got %s\n", strings.Join(out, ","))
+		t.Fail()
+	}
+}
+
+// The hook is added when only errors are wanted, but logrus must still pass
+// it the levels a later selector wants
+func TestDispatcher_HookLevels(t *testing.T) {
+	d := NewDispatcher()
+	defer d.Stop()
+	d.SetHistoryLevels(logrus.ErrorLevel)
+	log := logrus.New()
+	log.Out = discard{}
+	log.SetLevel(logrus.DebugLevel)
+	log.AddHook(d.Hook())
+	s, err := NewSelector("level == debug", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	log.Debug("wanted")
+	if e := read(s); e == nil || e.Message != "wanted" {
+		fmt.Printf("Got %#v\n", e)
+		t.Fail()
+	}
+}
+
+func TestDispatcher_Close(t *testing.T) {
+	d := NewDispatcher(IngestQueue(100), SelectorQueue(100))
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	s, err := NewSelector("", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 50; i += 1 {
+		log.Info(i)
+	}
+	if d.Close() != nil || d.Close() != nil {
+		t.Fail()
+	}
+	d.Stop()
+	log.Info("after")
+
+	// Everything queued is drained to the selector, then it's closed
+	n := 0
+	for e := s.MaybeRead(); e != nil; e = s.MaybeRead() {
+		n += 1
+	}
+	if n != 51 || !s.Closed() {
+		fmt.Printf("Read %d entries, closed %v\n", n, s.Closed())
+		t.Fail()
+	}
+	if d.Dropped() != 1 {
+		fmt.Printf("Dropped %d, expected only the entry after closing\n", d.Dropped())
+		t.Fail()
+	}
+	s.Stop()
+
+	late, err := NewSelector("", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !late.Closed() || late.MaybeRead() != nil {
+		t.Fail()
+	}
+	// A selector which was never registered isn't reported
+	if st := d.Stats(); len(st.Selectors) != 0 {
+		fmt.Printf("Selectors %#v\n", st.Selectors)
+		t.Fail()
+	}
+	late.Stop()
+}
+
+// Entries discarded from the queue on closing are counted as dropped
+func TestDispatcher_CloseDiscard(t *testing.T) {
+	d := NewDispatcher(IngestQueue(1000), SelectorQueue(1000), Shutdown(ShutdownDiscard))
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	s, err := NewSelector("Prefix(test)", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	for i := 0; i < 500; i += 1 {
+		log.WithField("prefix", "test").Info(i)
+	}
+	if err := d.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Each entry was either dispatched before closing, or discarded
+	n := 0
+	for e := s.MaybeRead(); e != nil; e = s.MaybeRead() {
+		n += 1
+	}
+	if uint64(n) + d.Dropped() != 500 {
+		fmt.Printf("Read %d entries and dropped %d\n", n, d.Dropped())
+		t.Fail()
+	}
+}
+
+func TestDispatcher_Context(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := NewDispatcher(Context(ctx))
+	s, err := NewSelector("", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	cancel()
+	deadline := time.Now().Add(time.Second)
+	for !s.Closed() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if !s.Closed() {
+		fmt.Printf("Selector not closed after the context was cancelled\n")
+		t.Fail()
+	}
+}
+
+func TestSelector_Next(t *testing.T) {
+	d := NewDispatcher()
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	s, err := NewSelector("Prefix(test)", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		log.WithField("prefix", "other").Info("skipped")
+		log.WithField("prefix", "test").Info("wanted")
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	e, err := s.Next(ctx)
+	if err != nil || e.Message != "wanted" {
+		fmt.Printf("Got %#v, %v\n", e, err)
+		t.Fail()
+	}
+
+	short, cancelShort := context.WithTimeout(context.Background(), 10 * time.Millisecond)
+	defer cancelShort()
+	if _, err := s.Next(short); err != context.DeadlineExceeded {
+		fmt.Printf("Expected the deadline, got %v\n", err)
+		t.Fail()
+	}
+	s.Stop()
+	if _, err := s.Next(ctx); err != ErrClosed {
+		fmt.Printf("Expected ErrClosed, got %v\n", err)
+		t.Fail()
+	}
+}
+
+func TestSelector_C(t *testing.T) {
+	d := NewDispatcher()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	s, err := NewSelector("Prefix(test) | fields n", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := make(chan Entry, 10)
+	o, err := NewSelector("Prefix(test)", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o.OnEntry(func(e Entry) {
+		seen <- e
+	})
+	log.WithFields(logrus.Fields{"prefix": "test", "n": 1}).Info("one")
+	select {
+	case e := <-s.C():
+		if e.Message != "one" || len(e.Data) != 1 {
+			fmt.Printf("Got %#v\n", e)
+			t.Fail()
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out")
+	}
+	select {
+	case e := <-seen:
+		if e.Message != "one" {
+			t.Fail()
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out")
+	}
+
+	d.Stop()
+	select {
+	case _, ok := <-s.C():
+		if ok {
+			t.Fail()
+		}
+	case <-time.After(time.Second):
+		fmt.Printf("C not closed after the dispatcher was closed\n")
+		t.Fail()
+	}
+}
+
+// A reader may give up on C, and stopping the selector mustn't leave anything
+// waiting to send to it
+func TestSelector_CAbandoned(t *testing.T) {
+	d := NewDispatcher()
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	s, err := NewSelector("Prefix(test)", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := runtime.NumGoroutine()
+	s.C()
+	log.WithField("prefix", "test").Info("unread")
+	time.Sleep(50 * time.Millisecond)
+	s.Stop()
+	for i := 0; runtime.NumGoroutine() > before; i += 1 {
+		if i == 100 {
+			fmt.Printf("%d goroutines, from %d\n", runtime.NumGoroutine(), before)
+			t.Fail()
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// A selector for rare entries mustn't lose them to a flood of entries it
+// doesn't want
+func TestSelector_FilteredQueue(t *testing.T) {
+	for _, workers := range []int{0, 4} {
+		d := NewDispatcher(SelectorQueue(4), FilterWorkers(workers))
+		log := logrus.New()
+		log.Out = discard{}
+		log.SetLevel(logrus.DebugLevel)
+		log.AddHook(d.Hook())
+		errs, err := NewSelector("level >= error", d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// More selectors, so the workers share them
+		others := make([]*Selector, 0)
+		for i := 0; i < 3; i += 1 {
+			o, err := NewSelector(fmt.Sprintf("Prefix(other%d)", i), d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			others = append(others, o)
+		}
+		for i := 0; i < 100; i += 1 {
+			log.WithField("n", i).Debug("noise")
+		}
+		log.WithField("prefix", "other1").Error("rare")
+		log.WithField("n", 100).Debug("noise")
+		d.Stop()
+
+		got := make([]string, 0)
+		for e := range errs.C() {
+			if e.Gap != nil {
+				fmt.Printf("%d workers: unexpected gap %#v\n", workers, e.Gap)
+				t.Fail()
+				continue
+			}
+			got = append(got, e.Message)
+		}
+		if strings.Join(got, ",") != "rare" || errs.Dropped() != 0 {
+			fmt.Printf("%d workers: got %v, dropped %d\n", workers, got, errs.Dropped())
+			t.Fail()
+		}
+		for i, o := range others {
+			n := 0
+			for range o.C() {
+				n += 1
+			}
+			if (i == 1) != (n == 1) {
+				fmt.Printf("%d workers: selector %d got %d entries\n", workers, i, n)
+				t.Fail()
+			}
+		}
+	}
+}
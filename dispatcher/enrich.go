@@ -0,0 +1,101 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * enrich.go: Fields added to every entry
+ */
+
+package dispatcher
+
+import (
+	"github.com/sirupsen/logrus"
+	"os"
+	"runtime"
+	"runtime/debug"
+)
+
+// Enrich adds fields to every entry, such as the region or deployment, so
+// that entries exported or federated elsewhere can be traced back to this
+// instance. An entry's own fields of the same names are kept. The fields can
+// be queried like any other, e.g. Field(region) == "eu-west", or
+// region:eu-west in shorthand.
+func Enrich(fields logrus.Fields) Option {
+	return func(r *Dispatcher) {
+		for k, v := range fields {
+			r.enricher().fields[k] = freezeValue(v)
+		}
+	}
+}
+
+// EnrichHost adds the host's name and the process id, as the hostname and pid
+// fields
+func EnrichHost() Option {
+	fields := logrus.Fields{"pid": os.Getpid()}
+	if host, err := os.Hostname(); err == nil {
+		fields["hostname"] = host
+	}
+	return Enrich(fields)
+}
+
+// EnrichBuild adds the main module's version, and the version of Go it was
+// built with, as the version and go_version fields
+func EnrichBuild() Option {
+	fields := logrus.Fields{"go_version": runtime.Version()}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		fields["version"] = info.Main.Version
+	}
+	return Enrich(fields)
+}
+
+// Enricher calls f with each entry, from the goroutine which logged it, and
+// adds the fields it returns, unless the entry already has them. f sees the
+// entry before it's redacted, and must not change it.
+func Enricher(f func(e *logrus.Entry) logrus.Fields) Option {
+	return func(r *Dispatcher) {
+		r.enricher().funcs = append(r.enricher().funcs, f)
+	}
+}
+
+// enricher adds fields to entries in the hook, before they are redacted
+type enricher struct {
+	fields logrus.Fields
+	funcs []func(e *logrus.Entry) logrus.Fields
+}
+
+func (r *Dispatcher) enricher() *enricher {
+	if r.enrich == nil {
+		r.enrich = &enricher{fields: logrus.Fields{}}
+	}
+	return r.enrich
+}
+
+// apply enriches an entry, which must already be frozen
+func (en *enricher) apply(e *logrus.Entry) {
+	for k, v := range en.fields {
+		if _, ok := e.Data[k]; !ok {
+			e.Data[k] = v
+		}
+	}
+	for _, f := range en.funcs {
+		for k, v := range f(e) {
+			if _, ok := e.Data[k]; !ok {
+				e.Data[k] = freezeValue(v)
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * enrich_test.go: Test of adding fields to every entry
+ */
+
+package dispatcher
+
+import (
+	"testing"
+	"fmt"
+	"os"
+	"github.com/jwriteclub/weblog/predicate"
+	"github.com/sirupsen/logrus"
+)
+
+func TestDispatcher_Enrich(t *testing.T) {
+	d := NewDispatcher(
+		EnrichHost(),
+		EnrichBuild(),
+		Enrich(logrus.Fields{"region": "eu-west", "prefix": "ignored"}),
+		Enricher(func(e *logrus.Entry) logrus.Fields {
+			return logrus.Fields{"shout": e.Level <= logrus.ErrorLevel, "tags": []string{"a"}}
+		}))
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	s, err := NewSelectorSyntax(predicate.SyntaxShorthand, "region:eu-west prefix:test shout:true", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	sel, err := NewSelectorSyntax(predicate.SyntaxSelect, `Prefix(test) && Field(region) == "eu-west"`, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sel.Stop()
+	log.WithField("prefix", "test").Info("quiet")
+	log.WithFields(logrus.Fields{"prefix": "test", "region": "us-east"}).Error("elsewhere")
+	log.WithField("prefix", "test").Error("loud")
+
+	e := read(s)
+	if e == nil || e.Message != "loud" {
+		t.Fatalf("Got %#v", e)
+	}
+	if e.Data["pid"] != os.Getpid() || e.Data["hostname"] == nil || e.Data["go_version"] == nil {
+		fmt.Printf("Not enriched: %#v\n", e.Data)
+		t.Fail()
+	}
+	if fmt.Sprint(e.Data["tags"]) != `["a"]` {
+		fmt.Printf("Tags not frozen: %#v\n", e.Data["tags"])
+		t.Fail()
+	}
+	if e := read(s); e != nil {
+		fmt.Printf("Unexpected %#v\n", e)
+		t.Fail()
+	}
+	if e := read(sel); e == nil || e.Message != "quiet" {
+		t.Fatalf("Got %#v", e)
+	}
+	if e := read(sel); e == nil || e.Message != "loud" {
+		t.Fatalf("Got %#v", e)
+	}
+}
+
+// Enriched fields are redacted like the entry's own
+func TestDispatcher_EnrichRedacted(t *testing.T) {
+	d := NewDispatcher(
+		Enrich(logrus.Fields{"api_key": "secret"}),
+		RedactField("api_key", MaskReplace))
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	s, err := NewSelector("", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	log.Info("enriched")
+	for e := read(s); e == nil || e.Message != "enriched"; e = read(s) {
+		if e == nil {
+			t.Fatal("timed out")
+		}
+	}
+	res, err := d.Query(HistoryQuery{Expression: "HasField(api_key)"})
+	if err != nil || len(res.Entries) == 0 {
+		t.Fatalf("Got %v %#v", err, res)
+	}
+	for _, e := range res.Entries {
+		if e.Data["api_key"] != "[REDACTED]" {
+			fmt.Printf("Not redacted: %#v\n", e.Data)
+			t.Fail()
+		}
+	}
+}
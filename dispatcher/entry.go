@@ -0,0 +1,107 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * entry.go: Entries delivered to selectors
+ */
+
+package dispatcher
+
+import (
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+// Entry is an item in a selector's stream: either a log entry, or when Gap
+// is set, a marker standing in for entries which were dropped at that point
+type Entry struct {
+	logrus.Entry
+	// Seq is assigned by the dispatcher as entries arrive, starting from 1.
+	// A gap has the Seq of the last entry it stands in for. Entries are
+	// numbered as they're taken from the ingest queue, so those the hook
+	// discards under an OverflowPolicy are never numbered, and no gap counts
+	// them; see Dispatcher.Dropped.
+	Seq uint64
+	// Stream is the name of the dispatcher the entry was logged to, if it's
+	// named. Selectors' expressions see it as the stream field, in place of
+	// any field of that name the entry was logged with.
+	Stream string
+	Gap *Gap
+	// Repeat is set when the entry is a repeat of an earlier one, folded
+	// into it by a dedupe stage
+	Repeat *Repeat
+}
+
+// Repeat updates an entry already delivered with the repeats folded into it
+type Repeat struct {
+	// Of is the sequence number of the entry repeated
+	Of uint64 `json:"of"`
+	// Count is the number of times the entry has been seen, including the
+	// first
+	Count uint64 `json:"repeat_count"`
+	// The times of the first and latest occurrences
+	FirstTime time.Time `json:"first_time"`
+	LastTime time.Time `json:"last_time"`
+}
+
+// Gap describes matching entries dropped because a selector's queue was full.
+// At the start of a resumed stream, it may also describe entries missing from
+// the history, which can't be known to match. Entries dropped by the hook,
+// before they were numbered, aren't included.
+type Gap struct {
+	Dropped uint64 `json:"dropped"`
+	// The times of the first and last dropped entries
+	From time.Time `json:"from"`
+	To time.Time `json:"to"`
+	// The sequence numbers of the first and last dropped entries
+	FirstSeq uint64 `json:"first_seq"`
+	LastSeq uint64 `json:"last_seq"`
+}
+
+func (g *Gap) add(e *Entry) {
+	if g.Dropped == 0 {
+		g.FirstSeq = e.Seq
+	}
+	g.LastSeq = e.Seq
+	if g.Dropped == 0 || e.Time.Before(g.From) {
+		g.From = e.Time
+	}
+	if e.Time.After(g.To) {
+		g.To = e.Time
+	}
+	g.Dropped += 1
+}
+
+// merge adds the entries of a later gap
+func (g *Gap) merge(o *Gap) {
+	if o.Dropped == 0 {
+		return
+	}
+	if g.Dropped == 0 {
+		*g = *o
+		return
+	}
+	g.LastSeq = o.LastSeq
+	if o.From.Before(g.From) {
+		g.From = o.From
+	}
+	if o.To.After(g.To) {
+		g.To = o.To
+	}
+	g.Dropped += o.Dropped
+}
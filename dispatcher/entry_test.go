@@ -0,0 +1,71 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * entry_test.go: Test of gaps in a selector's stream
+ */
+
+package dispatcher
+
+import (
+	"testing"
+	"fmt"
+	"time"
+	"github.com/sirupsen/logrus"
+)
+
+func TestSelector_Gap(t *testing.T) {
+	s := &Selector{q: make(chan Entry, 2), d: &Dispatcher{}}
+	base := time.Now()
+	for i, m := range []string{"a", "b", "c", "d"} {
+		s.offer(Entry{Entry: logrus.Entry{Message: m, Time: base.Add(time.Duration(i) * time.Second)}, Seq: uint64(i + 1)})
+	}
+	if (<-s.q).Message != "a" || (<-s.q).Message != "b" {
+		t.Fail()
+	}
+	s.offer(Entry{Entry: logrus.Entry{Message: "e"}, Seq: 5})
+	g := <-s.q
+	if g.Gap == nil || g.Gap.Dropped != 2 || g.Gap.FirstSeq != 3 || g.Gap.LastSeq != 4 || g.Seq != 4 || !g.Gap.From.Equal(base.Add(2 * time.Second)) || !g.Gap.To.Equal(base.Add(3 * time.Second)) {
+		fmt.Printf("Expected a gap of 2, got %#v\n", g.Gap)
+		t.Fail()
+	}
+	if e := <-s.q; e.Gap != nil || e.Message != "e" {
+		fmt.Printf("Expected e, got %#v\n", e)
+		t.Fail()
+	}
+	if s.Dropped() != 2 {
+		fmt.Printf("Dropped %d\n", s.Dropped())
+		t.Fail()
+	}
+}
+
+func TestSelector_GapFull(t *testing.T) {
+	s := &Selector{q: make(chan Entry, 1), d: &Dispatcher{}}
+	s.offer(Entry{Entry: logrus.Entry{Message: "a"}})
+	s.offer(Entry{Entry: logrus.Entry{Message: "b"}})
+	<-s.q
+	// The gap takes the only space, so c is dropped as well
+	s.offer(Entry{Entry: logrus.Entry{Message: "c"}})
+	if g := <-s.q; g.Gap == nil || g.Gap.Dropped != 1 {
+		t.Fail()
+	}
+	s.offer(Entry{Entry: logrus.Entry{Message: "d"}})
+	if g := <-s.q; g.Gap == nil || g.Gap.Dropped != 1 {
+		t.Fail()
+	}
+}
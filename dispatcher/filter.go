@@ -0,0 +1,83 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * filter.go: Parallel evaluation of selector predicates
+ */
+
+package dispatcher
+
+import (
+	"github.com/sirupsen/logrus"
+	"sync"
+)
+
+// filterPool evaluates the predicates of many selectors against an entry in
+// parallel, for when predicates are expensive
+type filterPool struct {
+	jobs chan filterJob
+	workers int
+}
+
+// filterJob is a share of the selectors to test an entry against
+type filterJob struct {
+	e *logrus.Entry
+	selectors []*Selector
+	matches []bool
+	wg *sync.WaitGroup
+}
+
+func newFilterPool(workers int) *filterPool {
+	p := &filterPool{jobs: make(chan filterJob, workers), workers: workers}
+	for i := 0; i < workers; i += 1 {
+		go p.work()
+	}
+	return p
+}
+
+func (p *filterPool) work() {
+	for job := range p.jobs {
+		for i, s := range job.selectors {
+			job.matches[i] = s.true(job.e)
+		}
+		job.wg.Done()
+	}
+}
+
+// match reports which of the selectors match e. The entry mustn't be changed
+// until it returns.
+func (p *filterPool) match(e *logrus.Entry, selectors []*Selector) []bool {
+	matches := make([]bool, len(selectors))
+	wg := &sync.WaitGroup{}
+	share := (len(selectors) + p.workers - 1) / p.workers
+	for i := 0; i < len(selectors); i += share {
+		end := i + share
+		if end > len(selectors) {
+			end = len(selectors)
+		}
+		wg.Add(1)
+		p.jobs <- filterJob{e: e, selectors: selectors[i:end], matches: matches[i:end], wg: wg}
+	}
+	wg.Wait()
+	return matches
+}
+
+// stop ends the workers
+func (p *filterPool) stop() {
+	close(p.jobs)
+}
@@ -0,0 +1,82 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * freeze.go: Immutable copies of entries taken as they're logged
+ */
+
+package dispatcher
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"reflect"
+	"time"
+)
+
+// freeze copies an entry as it's logged, so that nothing the dispatcher,
+// selectors or handlers read afterwards is shared with the application,
+// which may carry on modifying its values. Values of basic kinds are copied
+// as they are. Errors become their message, values with a text form, such as
+// net.IP, become that, and anything else (pointers, slices, maps and
+// structs) is encoded as JSON there and then, as a json.RawMessage.
+func freeze(e *logrus.Entry) logrus.Entry {
+	ret := *e
+	ret.Buffer = nil
+	ret.Data = make(logrus.Fields, len(e.Data))
+	for k, v := range e.Data {
+		ret.Data[k] = freezeValue(v)
+	}
+	return ret
+}
+
+func freezeValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case time.Time:
+		return v
+	case json.RawMessage:
+		// Already encoded, but the caller may reuse the array behind it
+		return append(json.RawMessage(nil), t...)
+	case error:
+		return t.Error()
+	}
+	switch reflect.TypeOf(v).Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return v
+	}
+	switch t := v.(type) {
+	case encoding.TextMarshaler:
+		if text, err := t.MarshalText(); err == nil {
+			return string(text)
+		}
+	case fmt.Stringer:
+		return t.String()
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return json.RawMessage(data)
+}
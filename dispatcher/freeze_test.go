@@ -0,0 +1,168 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * freeze_test.go: Test of copying entries as they're logged
+ */
+
+package dispatcher
+
+import (
+	"testing"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"sync"
+	"time"
+	"github.com/jwriteclub/weblog/predicate"
+	"github.com/sirupsen/logrus"
+)
+
+type kind string
+
+func TestFreeze(t *testing.T) {
+	now := time.Now()
+	m := map[string]int{"a": 1}
+	sl := []int{1, 2}
+	p := &struct{ N int }{3}
+	raw := json.RawMessage(`{"r":1}`)
+	e := &logrus.Entry{Data: logrus.Fields{"raw": raw,
+		"int": 1, "string": "s", "kind": kind("k"), "time": now, "nil": nil,
+		"error": errors.New("boom"), "map": m, "slice": sl, "ptr": p, "chan": make(chan int),
+		"ip": net.ParseIP("1.2.3.4"), "level": logrus.WarnLevel, "duration": time.Second,
+	}}
+	f := freeze(e)
+	m["a"] = 2
+	sl[0] = 2
+	p.N = 4
+	raw[5] = '2'
+
+	var tests = []struct {
+		key string
+		output interface{}
+	}{
+		{"int", 1},
+		{"string", "s"},
+		{"kind", kind("k")},
+		{"time", now},
+		{"nil", nil},
+		{"error", "boom"},
+		{"map", json.RawMessage(`{"a":1}`)},
+		{"slice", json.RawMessage(`[1,2]`)},
+		{"ptr", json.RawMessage(`{"N":3}`)},
+		{"raw", json.RawMessage(`{"r":1}`)},
+		{"ip", "1.2.3.4"},
+		{"level", logrus.WarnLevel},
+		{"duration", time.Second},
+	}
+	for _, test := range tests {
+		if !reflect.DeepEqual(f.Data[test.key], test.output) {
+			fmt.Printf("%s: expected %#v but got %#v\n", test.key, test.output, f.Data[test.key])
+			t.Fail()
+		}
+	}
+	if _, ok := f.Data["chan"].(string); !ok {
+		fmt.Printf("chan: expected a string but got %#v\n", f.Data["chan"])
+		t.Fail()
+	}
+	e.Data["int"] = 2
+	if f.Data["int"] != 1 {
+		t.Fail()
+	}
+	q, err := predicate.CompileQuery(predicate.SyntaxSelect, "Field(ip) == '1.2.3.4'")
+	if err != nil || !q.Predicate.True(&f) {
+		fmt.Printf("The frozen ip doesn't match: %v\n", err)
+		t.Fail()
+	}
+}
+
+// TestDispatcher_ConcurrentMutation is meant to be run with -race. The
+// application keeps modifying the values it logged while selectors, queries
+// and encoding read them.
+func TestDispatcher_ConcurrentMutation(t *testing.T) {
+	d := NewDispatcher(SelectorQueue(16))
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+
+	done := make(chan bool)
+	readers := &sync.WaitGroup{}
+	for _, q := range []string{"", "Field(n) > 10 | fields m, s", "| count by field(n)"} {
+		s, err := NewSelector(q, d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			defer s.Stop()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if e := s.MaybeRead(); e != nil {
+					_, _ = json.Marshal(e.Data)
+				}
+				s.Snapshot()
+			}
+		}()
+	}
+	readers.Add(1)
+	go func() {
+		defer readers.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			_, _ = d.Query(HistoryQuery{Expression: "Field(m) != nil", Order: NewestFirst})
+		}
+	}()
+
+	writers := &sync.WaitGroup{}
+	for w := 0; w < 8; w += 1 {
+		writers.Add(1)
+		go func(w int) {
+			defer writers.Done()
+			m := map[string]int{"w": w}
+			sl := []int{w}
+			p := &struct{ N int }{w}
+			for i := 0; i < 200; i += 1 {
+				log.WithFields(logrus.Fields{"n": i, "m": m, "s": sl, "p": p}).Info("mutating")
+				m["i"] = i
+				sl[0] = i
+				sl = append(sl, i)
+				p.N = i
+			}
+		}(w)
+	}
+	writers.Wait()
+	close(done)
+	readers.Wait()
+}
+
+type discard struct{}
+func (discard) Write(p []byte) (int, error) {
+	return len(p), nil
+}
@@ -0,0 +1,459 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * history.go: Storage of recent log entries
+ */
+
+package dispatcher
+
+import (
+	"encoding/json"
+	"github.com/jwriteclub/weblog/predicate"
+	"github.com/sirupsen/logrus"
+	"sort"
+	"sync"
+)
+
+// History stores recent entries, so they can be replayed to selectors which
+// register later. The dispatcher only calls Push from its dispatch goroutine,
+// but the other methods may be called concurrently with it.
+type History interface {
+	// Push adds the newest entry
+	Push(e Entry)
+	// Last calls f for each of the newest n entries, oldest first
+	Last(n int, f func(e Entry))
+	// Since calls f for each entry with a sequence number after seq, oldest
+	// first
+	Since(seq uint64, f func(e Entry))
+	// Oldest and Newest return the oldest and newest entries held, if any
+	Oldest() (Entry, bool)
+	Newest() (Entry, bool)
+}
+
+// memoryHistory keeps the most recent entries in memory, up to a maximum
+// count and, when budget is set, a maximum estimated size in bytes
+type memoryHistory struct {
+	m *sync.RWMutex
+	entries []Entry
+	sizes []int
+	// Index of the oldest entry, and the number of entries held
+	start int
+	n int
+	bytes int
+	budget int
+	// The sequence number of the newest entry evicted
+	evicted uint64
+}
+
+func newMemoryHistory(size int, budget int) *memoryHistory {
+	return &memoryHistory{
+		m: &sync.RWMutex{},
+		entries: make([]Entry, size),
+		sizes: make([]int, size),
+		budget: budget,
+	}
+}
+
+func (h *memoryHistory) Push(e Entry) {
+	defer h.m.Unlock()
+	h.m.Lock()
+	if len(h.entries) == 0 {
+		return
+	}
+	if h.n == len(h.entries) {
+		h.evict()
+	}
+	i := (h.start + h.n) % len(h.entries)
+	h.entries[i] = e
+	h.sizes[i] = entrySize(&e.Entry)
+	h.bytes += h.sizes[i]
+	h.n += 1
+	// Always keep the newest entry, even if it's over budget on its own
+	for h.budget > 0 && h.bytes > h.budget && h.n > 1 {
+		h.evict()
+	}
+}
+
+// evict removes the oldest entry
+func (h *memoryHistory) evict() {
+	h.evicted = h.entries[h.start].Seq
+	h.bytes -= h.sizes[h.start]
+	h.entries[h.start] = Entry{}
+	h.sizes[h.start] = 0
+	h.start = (h.start + 1) % len(h.entries)
+	h.n -= 1
+}
+
+func (h *memoryHistory) Last(n int, f func(e Entry)) {
+	for _, e := range h.copyLast(n) {
+		f(e)
+	}
+}
+
+func (h *memoryHistory) Since(seq uint64, f func(e Entry)) {
+	for _, e := range h.copyLast(len(h.entries)) {
+		if e.Seq > seq {
+			f(e)
+		}
+	}
+}
+
+// copyLast copies the newest n entries, oldest first, so that they can be
+// read, and matched against a query, without holding h.m
+func (h *memoryHistory) copyLast(n int) []Entry {
+	defer h.m.RUnlock()
+	h.m.RLock()
+	if n > h.n {
+		n = h.n
+	}
+	ret := make([]Entry, 0, n)
+	for i := h.n - n; i < h.n; i += 1 {
+		ret = append(ret, h.entries[(h.start + i) % len(h.entries)])
+	}
+	return ret
+}
+
+// size returns the estimated size of the entries held
+func (h *memoryHistory) size() int {
+	defer h.m.RUnlock()
+	h.m.RLock()
+	return h.bytes
+}
+
+// trim evicts the oldest entry, unless it's the only one, and reports
+// whether it did
+func (h *memoryHistory) trim() bool {
+	defer h.m.Unlock()
+	h.m.Lock()
+	if h.n <= 1 {
+		return false
+	}
+	h.evict()
+	return true
+}
+
+func (h *memoryHistory) Stats() HistoryStats {
+	defer h.m.RUnlock()
+	h.m.RLock()
+	return HistoryStats{Entries: h.n, Bytes: int64(h.bytes), MaxEntries: len(h.entries), MaxBytes: int64(h.budget)}
+}
+
+func (h *memoryHistory) Oldest() (Entry, bool) {
+	defer h.m.RUnlock()
+	h.m.RLock()
+	if h.n == 0 {
+		return Entry{}, false
+	}
+	return h.entries[h.start], true
+}
+func (h *memoryHistory) Newest() (Entry, bool) {
+	defer h.m.RUnlock()
+	h.m.RLock()
+	if h.n == 0 {
+		return Entry{}, false
+	}
+	return h.entries[(h.start + h.n - 1) % len(h.entries)], true
+}
+
+// reverser is implemented by histories which can be read newest first
+// without reading every entry, see newestFirst
+type reverser interface {
+	// Reverse calls f for each entry, newest first, until f returns false
+	Reverse(f func(e Entry) bool)
+}
+
+// forwarder is implemented by histories which can be read oldest first
+// without reading every entry, see oldestFirst
+type forwarder interface {
+	// Forward calls f for each entry with a sequence number after seq, oldest
+	// first, until f returns false
+	Forward(seq uint64, f func(e Entry) bool)
+}
+
+// oldestFirst calls f for each entry in h with a sequence number after seq,
+// oldest first, until f returns false
+func oldestFirst(h History, seq uint64, f func(e Entry) bool) {
+	if r, ok := h.(forwarder); ok {
+		r.Forward(seq, f)
+		return
+	}
+	stopped := false
+	h.Since(seq, func(e Entry) {
+		stopped = stopped || !f(e)
+	})
+}
+
+// sequencer is implemented by histories which outlive a dispatcher, and keep
+// its numbering for the next: they remember the sequence number of the newest
+// entry pushed after it's no longer held, see lastSeq, and the epoch the
+// numbers belong to, see Dispatcher.Epoch
+type sequencer interface {
+	LastSeq() uint64
+	Epoch() string
+}
+
+// lastSeq returns the sequence number of the newest entry pushed to h, as far
+// as it knows, so that a dispatcher given a history which outlived another
+// carries on numbering after it
+func lastSeq(h History) uint64 {
+	if s, ok := h.(sequencer); ok {
+		return s.LastSeq()
+	}
+	if newest, ok := h.Newest(); ok {
+		return newest.Seq
+	}
+	return 0
+}
+
+// newestFirst calls f for each entry in h, newest first, until f returns
+// false
+func newestFirst(h History, f func(e Entry) bool) {
+	if r, ok := h.(reverser); ok {
+		r.Reverse(f)
+		return
+	}
+	entries := make([]Entry, 0)
+	h.Since(0, func(e Entry) {
+		entries = append(entries, e)
+	})
+	for i := len(entries) - 1; i >= 0; i -= 1 {
+		if !f(entries[i]) {
+			return
+		}
+	}
+}
+
+// gapSince returns the entries after seq which are missing from h, given the
+// next sequence number to be assigned. Entries before the oldest held are
+// gone, or were never kept, and a levelHistory may also have lost entries
+// from between those it holds.
+func gapSince(h History, seq uint64, next uint64) Gap {
+	last := next - 1
+	if oldest, ok := h.Oldest(); ok {
+		last = oldest.Seq - 1
+	}
+	held := uint64(0)
+	if lh, ok := h.(*levelHistory); ok {
+		if evicted, n := lh.lost(seq); evicted > last {
+			last, held = evicted, n
+		}
+	}
+	if last <= seq || last - seq <= held {
+		return Gap{}
+	}
+	return Gap{Dropped: last - seq - held, FirstSeq: seq + 1, LastSeq: last}
+}
+
+// retention is the number of entries kept for a set of levels, see
+// LevelHistorySize
+type retention struct {
+	n int
+	levels predicate.LevelSet
+}
+
+// levelHistory keeps a memoryHistory for each retention, and one for the
+// other levels, merging them by sequence number when they're read. The rings
+// are only used under m.
+type levelHistory struct {
+	m *sync.RWMutex
+	rings []*memoryHistory
+	// The ring for each level which has its own retention. Other levels use
+	// the first ring.
+	byLevel map[logrus.Level]*memoryHistory
+	budget int
+}
+
+func newLevelHistory(size int, retentions []retention, budget int) *levelHistory {
+	h := &levelHistory{m: &sync.RWMutex{}, byLevel: make(map[logrus.Level]*memoryHistory), budget: budget}
+	h.rings = append(h.rings, newMemoryHistory(size, 0))
+	for _, r := range retentions {
+		ring := newMemoryHistory(r.n, 0)
+		h.rings = append(h.rings, ring)
+		// A later retention for a level replaces an earlier one
+		for _, l := range r.levels.Levels() {
+			h.byLevel[l] = ring
+		}
+	}
+	return h
+}
+
+func (h *levelHistory) ring(l logrus.Level) *memoryHistory {
+	if ring, ok := h.byLevel[l]; ok {
+		return ring
+	}
+	return h.rings[0]
+}
+
+func (h *levelHistory) Push(e Entry) {
+	defer h.m.Unlock()
+	h.m.Lock()
+	ring := h.ring(e.Level)
+	ring.Push(e)
+	if h.budget <= 0 {
+		return
+	}
+	// Evict from the ring added to, then the others, oldest first
+	for h.size() > h.budget && ring.trim() {
+	}
+	for h.size() > h.budget {
+		var oldest *memoryHistory
+		var seq uint64
+		for _, r := range h.rings {
+			if e, ok := r.Oldest(); ok && r.n > 1 && (oldest == nil || e.Seq < seq) {
+				oldest, seq = r, e.Seq
+			}
+		}
+		if oldest == nil || !oldest.trim() {
+			return
+		}
+	}
+}
+
+// size is the estimated size of every ring. h.m must be held.
+func (h *levelHistory) size() (ret int) {
+	for _, r := range h.rings {
+		ret += r.size()
+	}
+	return
+}
+
+// collect merges the entries read from each ring by sequence number
+func (h *levelHistory) collect(read func(ring *memoryHistory, f func(e Entry))) []Entry {
+	defer h.m.RUnlock()
+	h.m.RLock()
+	ret := make([]Entry, 0)
+	for _, r := range h.rings {
+		read(r, func(e Entry) {
+			ret = append(ret, e)
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Seq < ret[j].Seq
+	})
+	return ret
+}
+
+func (h *levelHistory) Last(n int, f func(e Entry)) {
+	entries := h.collect(func(ring *memoryHistory, f func(e Entry)) {
+		ring.Last(n, f)
+	})
+	if len(entries) > n {
+		entries = entries[len(entries) - n:]
+	}
+	for _, e := range entries {
+		f(e)
+	}
+}
+
+func (h *levelHistory) Since(seq uint64, f func(e Entry)) {
+	entries := h.collect(func(ring *memoryHistory, f func(e Entry)) {
+		ring.Since(seq, f)
+	})
+	for _, e := range entries {
+		f(e)
+	}
+}
+
+func (h *levelHistory) Oldest() (ret Entry, ok bool) {
+	defer h.m.RUnlock()
+	h.m.RLock()
+	for _, r := range h.rings {
+		if e, found := r.Oldest(); found && (!ok || e.Seq < ret.Seq) {
+			ret, ok = e, true
+		}
+	}
+	return
+}
+
+func (h *levelHistory) Newest() (ret Entry, ok bool) {
+	defer h.m.RUnlock()
+	h.m.RLock()
+	for _, r := range h.rings {
+		if e, found := r.Newest(); found && (!ok || e.Seq > ret.Seq) {
+			ret, ok = e, true
+		}
+	}
+	return
+}
+
+// lost returns the newest entry evicted from any ring, and how many entries
+// after seq, up to and including it, are still held. As the rings evict at
+// different rates, entries may be missing from between those still held.
+func (h *levelHistory) lost(seq uint64) (last uint64, held uint64) {
+	defer h.m.RUnlock()
+	h.m.RLock()
+	for _, r := range h.rings {
+		r.m.RLock()
+		if r.evicted > last {
+			last = r.evicted
+		}
+		r.m.RUnlock()
+	}
+	for _, r := range h.rings {
+		r.m.RLock()
+		for i := 0; i < r.n; i += 1 {
+			e := r.entries[(r.start + i) % len(r.entries)]
+			if e.Seq > seq && e.Seq <= last {
+				held += 1
+			}
+		}
+		r.m.RUnlock()
+	}
+	return
+}
+
+func (h *levelHistory) Stats() (ret HistoryStats) {
+	defer h.m.RUnlock()
+	h.m.RLock()
+	for _, r := range h.rings {
+		st := r.Stats()
+		ret.Entries += st.Entries
+		ret.Bytes += st.Bytes
+		ret.MaxEntries += st.MaxEntries
+	}
+	ret.MaxBytes = int64(h.budget)
+	return
+}
+
+// entryOverhead is a rough size of an entry and its data map, excluding the
+// contents of either
+const entryOverhead = 256
+
+// entrySize estimates the memory used by an entry. It's cheap rather than
+// exact: only strings and byte slices are measured, other values count as a
+// word or two.
+func entrySize(e *logrus.Entry) int {
+	size := entryOverhead + len(e.Message)
+	for k, v := range e.Data {
+		size += len(k)
+		switch t := v.(type) {
+		case string:
+			size += len(t)
+		case []byte:
+			size += len(t)
+		case json.RawMessage:
+			size += len(t)
+		case error:
+			size += len(t.Error())
+		default:
+			size += 16
+		}
+	}
+	return size
+}
@@ -0,0 +1,224 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * history_test.go: Test of the history ring buffer
+ */
+
+package dispatcher
+
+import (
+	"testing"
+	"fmt"
+	"strings"
+	"time"
+	"github.com/jwriteclub/weblog/predicate"
+	"github.com/sirupsen/logrus"
+)
+
+func messages(h History, n int) string {
+	out := make([]string, 0)
+	h.Last(n, func(e Entry) {
+		out = append(out, e.Message)
+	})
+	return strings.Join(out, ",")
+}
+
+func TestHistory_Size(t *testing.T) {
+	h := newMemoryHistory(3, 0)
+	for _, m := range []string{"a", "b", "c", "d", "e"} {
+		h.Push(Entry{Entry: logrus.Entry{Message: m}})
+	}
+	if out := messages(h, 10); out != "c,d,e" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+	if out := messages(h, 2); out != "d,e" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+	if h.bytes != 3 * (entryOverhead + 1) {
+		fmt.Printf("Got %d bytes\n", h.bytes)
+		t.Fail()
+	}
+}
+
+func TestHistory_ByteBudget(t *testing.T) {
+	h := newMemoryHistory(100, 3 * entryOverhead + 20)
+	h.Push(Entry{Entry: logrus.Entry{Message: "a"}})
+	h.Push(Entry{Entry: logrus.Entry{Message: "b"}})
+	h.Push(Entry{Entry: logrus.Entry{Message: "c", Data: logrus.Fields{"body": strings.Repeat("x", 10)}}})
+	if out := messages(h, 10); out != "a,b,c" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+	h.Push(Entry{Entry: logrus.Entry{Message: "d"}})
+	if out := messages(h, 10); out != "b,c,d" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+	h.Push(Entry{Entry: logrus.Entry{Message: strings.Repeat("x", 4 * entryOverhead)}})
+	if h.n != 1 {
+		fmt.Printf("Expected only the newest entry, got %d\n", h.n)
+		t.Fail()
+	}
+}
+
+func TestHistory_Empty(t *testing.T) {
+	h := newMemoryHistory(0, 0)
+	h.Push(Entry{Entry: logrus.Entry{Message: "a"}})
+	if out := messages(h, 10); out != "" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+}
+
+// Entries are read without holding the history's lock, so a slow query
+// doesn't hold up the dispatcher
+func TestHistory_Unlocked(t *testing.T) {
+	h := newMemoryHistory(3, 0)
+	h.Push(Entry{Entry: logrus.Entry{Message: "a"}, Seq: 1})
+	done := make(chan string)
+	go func() {
+		out := make([]string, 0)
+		h.Since(0, func(e Entry) {
+			h.Push(Entry{Entry: logrus.Entry{Message: "b"}, Seq: 2})
+			out = append(out, e.Message)
+		})
+		done <- strings.Join(out, ",")
+	}()
+	select {
+	case out := <-done:
+		if out != "a" || messages(h, 10) != "a,b" {
+			fmt.Printf("Got %s, then %s\n", out, messages(h, 10))
+			t.Fail()
+		}
+	case <-time.After(time.Second):
+		fmt.Printf("Push blocked while reading\n")
+		t.FailNow()
+	}
+}
+
+func TestHistory_Levels(t *testing.T) {
+	h := newLevelHistory(3, []retention{
+		{2, predicate.NewLevelSet(logrus.ErrorLevel, logrus.WarnLevel)},
+		{1, predicate.NewLevelSet(logrus.DebugLevel)},
+	}, 0)
+	var seq uint64
+	push := func(level logrus.Level, msg string) {
+		seq += 1
+		h.Push(Entry{Entry: logrus.Entry{Level: level, Message: msg}, Seq: seq})
+	}
+	push(logrus.ErrorLevel, "e1")
+	push(logrus.InfoLevel, "i1")
+	push(logrus.WarnLevel, "w1")
+	for i := 0; i < 10; i += 1 {
+		push(logrus.DebugLevel, fmt.Sprintf("d%d", i))
+	}
+	push(logrus.InfoLevel, "i2")
+	if out := messages(h, 10); out != "e1,i1,w1,d9,i2" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+	if out := messages(h, 2); out != "d9,i2" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+	push(logrus.ErrorLevel, "e2")
+	out := make([]string, 0)
+	h.Since(2, func(e Entry) {
+		out = append(out, e.Message)
+	})
+	if strings.Join(out, ",") != "w1,d9,i2,e2" {
+		fmt.Printf("Got %v since 2\n", out)
+		t.Fail()
+	}
+	if o, ok := h.Oldest(); !ok || o.Message != "i1" {
+		fmt.Printf("Oldest %#v\n", o)
+		t.Fail()
+	}
+	if n, ok := h.Newest(); !ok || n.Message != "e2" {
+		fmt.Printf("Newest %#v\n", n)
+		t.Fail()
+	}
+	if st := h.Stats(); st.Entries != 5 || st.MaxEntries != 6 {
+		fmt.Printf("Stats %#v\n", st)
+		t.Fail()
+	}
+
+	// The debug ring has lost d0 to d8, after the oldest entry, i1
+	var gaps = []struct {
+		after uint64
+		gap Gap
+	}{
+		{0, Gap{Dropped: 10, FirstSeq: 1, LastSeq: 12}},
+		{2, Gap{Dropped: 9, FirstSeq: 3, LastSeq: 12}},
+		{12, Gap{}},
+		{15, Gap{}},
+	}
+	for _, test := range gaps {
+		if gap := gapSince(h, test.after, seq + 1); gap != test.gap {
+			fmt.Printf("Gap after %d: expected %#v but got %#v\n", test.after, test.gap, gap)
+			t.Fail()
+		}
+	}
+}
+
+func TestHistory_LevelsByteBudget(t *testing.T) {
+	h := newLevelHistory(100, []retention{{100, predicate.NewLevelSet(logrus.ErrorLevel)}}, 4 * (entryOverhead + 2))
+	var seq uint64
+	push := func(level logrus.Level, msg string) {
+		seq += 1
+		h.Push(Entry{Entry: logrus.Entry{Level: level, Message: msg}, Seq: seq})
+	}
+	push(logrus.ErrorLevel, "e1")
+	push(logrus.ErrorLevel, "e2")
+	push(logrus.DebugLevel, "d1")
+	push(logrus.DebugLevel, "d2")
+	// Over budget, the debug entries make room for each other
+	push(logrus.DebugLevel, "d3")
+	if out := messages(h, 10); out != "e1,e2,d2,d3" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+	// Errors make room by evicting the oldest error
+	push(logrus.ErrorLevel, "e3")
+	if out := messages(h, 10); out != "e2,d2,d3,e3" {
+		fmt.Printf("Got %s\n", out)
+		t.Fail()
+	}
+}
+
+func TestDispatcher_LevelHistorySize(t *testing.T) {
+	d := NewDispatcher(HistorySize(4), LevelHistorySize(4, logrus.ErrorLevel))
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.SetLevel(logrus.DebugLevel)
+	log.AddHook(d.Hook())
+	log.Error("the one")
+	for i := 0; i < 100; i += 1 {
+		log.Debug("storm")
+	}
+	d.Stop()
+	res, err := d.Query(HistoryQuery{Expression: "level >= error"})
+	if err != nil || len(res.Entries) != 1 || res.Entries[0].Message != "the one" {
+		fmt.Printf("Got %v %#v\n", err, res)
+		t.Fail()
+	}
+}
@@ -28,11 +28,19 @@ type DispatcherHook struct {
 	d *Dispatcher
 }
 
+// Levels returns every level. Logrus only asks for these once, when the hook
+// is added, but the levels wanted by the dispatcher's selectors and history
+// change as they come and go, so Fire skips entries which aren't wanted.
 func (h DispatcherHook) Levels() []logrus.Level  {
 	return logrus.AllLevels
 }
 
 func (h DispatcherHook) Fire(entry *logrus.Entry) error {
-	h.d.q<-*entry
+	if !h.d.wants(entry.Level) {
+		return nil
+	}
+	e := freeze(entry)
+	h.d.stamp(&e)
+	h.d.ingest(e)
 	return nil
 }
\ No newline at end of file
@@ -0,0 +1,151 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * options.go: Dispatcher configuration
+ */
+
+package dispatcher
+
+import (
+	"context"
+	"github.com/jwriteclub/weblog/predicate"
+	"github.com/sirupsen/logrus"
+)
+
+// Option configures a Dispatcher, see NewDispatcher
+type Option func(*Dispatcher)
+
+// HistorySize sets the number of entries kept for selectors which register
+// later. The default is 64. A new selector is sent as much of the history as
+// fits in its queue, so SelectorQueue should usually be at least as large.
+func HistorySize(n int) Option {
+	return func(r *Dispatcher) {
+		if n >= 0 {
+			r.historySize = n
+		}
+	}
+}
+
+// LevelHistorySize keeps n entries of the given levels in the history, apart
+// from those of other levels, so that a storm of debug entries can't evict
+// the errors, say:
+//
+//     NewDispatcher(LevelHistorySize(10000, logrus.ErrorLevel, logrus.WarnLevel), LevelHistorySize(500, logrus.DebugLevel))
+//
+// Levels not given keep HistorySize entries between them. Replay and queries
+// merge the levels back into the order they were logged in. A ByteBudget
+// applies to the history as a whole, but entries are evicted from the levels
+// being added to first. Like HistorySize, it only applies to the in-memory
+// history, so it's ignored if a HistoryStore is given too.
+func LevelHistorySize(n int, levels ...logrus.Level) Option {
+	return func(r *Dispatcher) {
+		if n >= 0 {
+			r.retention = append(r.retention, retention{n, predicate.NewLevelSet(levels...)})
+		}
+	}
+}
+
+// HistoryStore replaces the in-memory history with h, such as a DiskHistory.
+// HistorySize, LevelHistorySize and ByteBudget only apply to the in-memory
+// history, so h takes precedence, and they're ignored. If h is an io.Closer,
+// it's closed when the dispatcher is stopped.
+func HistoryStore(h History) Option {
+	return func(r *Dispatcher) {
+		r.history = h
+	}
+}
+
+// SelectorQueue sets the number of entries each selector can hold before
+// they are read. Entries arriving when the queue is full are dropped for that
+// selector. The default is 64.
+func SelectorQueue(n int) Option {
+	return func(r *Dispatcher) {
+		if n >= 0 {
+			r.selectorQueue = n
+		}
+	}
+}
+
+// IngestQueue sets the number of entries which can be waiting for the
+// dispatcher before the hook blocks. The default is 64.
+func IngestQueue(n int) Option {
+	return func(r *Dispatcher) {
+		if n >= 0 {
+			r.ingestQueue = n
+		}
+	}
+}
+
+// ByteBudget limits the history to an estimated total size in bytes, evicting
+// the oldest entries first. The newest entry is always kept. Zero, the
+// default, means the history is only limited by HistorySize. Only the
+// history is covered: the ingest and selector queues are limited by their
+// lengths alone, see IngestQueue and SelectorQueue.
+func ByteBudget(bytes int) Option {
+	return func(r *Dispatcher) {
+		if bytes >= 0 {
+			r.byteBudget = bytes
+		}
+	}
+}
+
+// ShutdownPolicy decides what happens to entries still in the ingest queue
+// when the dispatcher is closed
+type ShutdownPolicy int
+const (
+	// ShutdownDrain dispatches them to the history and selectors. This is the
+	// default.
+	ShutdownDrain ShutdownPolicy = iota
+	// ShutdownDiscard drops them, counting them in Dropped
+	ShutdownDiscard
+)
+
+// Shutdown sets the policy for entries queued when the dispatcher is closed
+func Shutdown(policy ShutdownPolicy) Option {
+	return func(r *Dispatcher) {
+		r.shutdown = policy
+	}
+}
+
+// Name names the dispatcher's stream of entries, which is given to each entry
+// as its Stream, and seen by selectors' expressions as the field stream, so
+// that selectors spanning several streams of a Registry can tell them apart
+func Name(name string) Option {
+	return func(r *Dispatcher) {
+		r.name = name
+	}
+}
+
+// Context closes the dispatcher when ctx is done
+func Context(ctx context.Context) Option {
+	return func(r *Dispatcher) {
+		r.ctx = ctx
+	}
+}
+
+// FilterWorkers evaluates selector predicates on n goroutines, which helps
+// when there are many selectors with expensive predicates. By default they are
+// evaluated one after another by the dispatcher.
+func FilterWorkers(n int) Option {
+	return func(r *Dispatcher) {
+		if n >= 0 {
+			r.filterWorkers = n
+		}
+	}
+}
@@ -0,0 +1,144 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * overflow.go: Handling of a full ingest queue
+ */
+
+package dispatcher
+
+import (
+	"github.com/sirupsen/logrus"
+	"sync/atomic"
+	"time"
+)
+
+// OverflowPolicy decides what the hook does with an entry when the ingest
+// queue is full. Whatever the policy, entries logged after the dispatcher is
+// stopped are dropped without blocking.
+type OverflowPolicy int
+const (
+	// OverflowBlock waits for room in the queue. This is the default.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowBlockTimeout waits for room in the queue, but drops the entry
+	// if there's none within the timeout, see BlockTimeout
+	OverflowBlockTimeout
+	// OverflowDropNewest drops the entry being logged
+	OverflowDropNewest
+	// OverflowDropOldest drops the oldest queued entry to make room. With an
+	// IngestQueue of 0 it behaves as OverflowDropNewest.
+	OverflowDropOldest
+)
+func (p OverflowPolicy) String() string {
+	switch p {
+	case OverflowBlock:
+		return "block"
+	case OverflowBlockTimeout:
+		return "block-timeout"
+	case OverflowDropNewest:
+		return "drop-newest"
+	case OverflowDropOldest:
+		return "drop-oldest"
+	default:
+		return "unknown"
+	}
+}
+
+// Overflow sets the policy used when the ingest queue is full
+func Overflow(policy OverflowPolicy) Option {
+	return func(r *Dispatcher) {
+		r.overflow = policy
+	}
+}
+
+// BlockTimeout selects OverflowBlockTimeout, waiting at most d for room
+func BlockTimeout(d time.Duration) Option {
+	return func(r *Dispatcher) {
+		r.overflow = OverflowBlockTimeout
+		r.timeout = d
+	}
+}
+
+// Dropped returns the number of entries the hook has discarded, because the
+// ingest queue was full or the dispatcher was stopped, and those left in the
+// queue when it was closed under ShutdownDiscard. These are discarded before
+// they're numbered, so selectors don't see them as gaps.
+func (r *Dispatcher) Dropped() uint64 {
+	return atomic.LoadUint64(&r.dropped)
+}
+
+func (r *Dispatcher) drop() {
+	atomic.AddUint64(&r.dropped, 1)
+}
+
+// ingest queues an entry for the dispatch goroutine, according to the
+// overflow policy
+func (r *Dispatcher) ingest(e logrus.Entry) {
+	select {
+	case <-r.done:
+		r.drop()
+		return
+	default:
+		break
+	}
+	switch r.overflow {
+	case OverflowBlockTimeout:
+		t := time.NewTimer(r.timeout)
+		defer t.Stop()
+		select {
+		case r.q <- e:
+			break
+		case <-r.done:
+			r.drop()
+		case <-t.C:
+			r.drop()
+		}
+	case OverflowDropNewest:
+		select {
+		case r.q <- e:
+			break
+		default:
+			r.drop()
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case r.q <- e:
+				return
+			default:
+				break
+			}
+			select {
+			case <-r.q:
+				r.drop()
+			case <-r.done:
+				r.drop()
+				return
+			default:
+				break
+			}
+		}
+	default:
+		select {
+		case r.q <- e:
+			break
+		case <-r.done:
+			r.drop()
+		}
+	}
+}
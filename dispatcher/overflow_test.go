@@ -0,0 +1,121 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * overflow_test.go: Test of the ingest overflow policies
+ */
+
+package dispatcher
+
+import (
+	"testing"
+	"fmt"
+	"time"
+	"github.com/sirupsen/logrus"
+)
+
+// stalled returns a dispatcher whose queue is never read
+func stalled(policy OverflowPolicy) *Dispatcher {
+	return &Dispatcher{q: make(chan logrus.Entry, 2), done: make(chan struct{}), overflow: policy, timeout: 10 * time.Millisecond}
+}
+
+func fill(r *Dispatcher) {
+	for _, m := range []string{"a", "b", "c"} {
+		r.ingest(logrus.Entry{Message: m})
+	}
+}
+
+func TestOverflow_DropNewest(t *testing.T) {
+	r := stalled(OverflowDropNewest)
+	fill(r)
+	if r.Dropped() != 1 || (<-r.q).Message != "a" || (<-r.q).Message != "b" {
+		fmt.Printf("Dropped %d\n", r.Dropped())
+		t.Fail()
+	}
+}
+
+func TestOverflow_DropOldest(t *testing.T) {
+	r := stalled(OverflowDropOldest)
+	fill(r)
+	if r.Dropped() != 1 || (<-r.q).Message != "b" || (<-r.q).Message != "c" {
+		fmt.Printf("Dropped %d\n", r.Dropped())
+		t.Fail()
+	}
+}
+
+func TestOverflow_BlockTimeout(t *testing.T) {
+	r := stalled(OverflowBlockTimeout)
+	start := time.Now()
+	fill(r)
+	if r.Dropped() != 1 || time.Since(start) < r.timeout {
+		fmt.Printf("Dropped %d after %s\n", r.Dropped(), time.Since(start))
+		t.Fail()
+	}
+}
+
+func TestOverflow_Stopped(t *testing.T) {
+	r := NewDispatcher(IngestQueue(1))
+	r.Stop()
+	done := make(chan bool)
+	go func() {
+		fill(r)
+		done <- true
+	}()
+	select {
+	case <-done:
+		break
+	case <-time.After(time.Second):
+		fmt.Printf("Fire blocked after Stop\n")
+		t.FailNow()
+	}
+	if r.Dropped() != 3 {
+		fmt.Printf("Dropped %d\n", r.Dropped())
+		t.Fail()
+	}
+}
+
+// Dropping the oldest entry needs a queue to drop it from
+func TestOverflow_DropOldestUnqueued(t *testing.T) {
+	r := NewDispatcher(IngestQueue(0), Overflow(OverflowDropOldest))
+	defer r.Stop()
+	if r.overflow != OverflowDropNewest {
+		fmt.Printf("Got %s\n", r.overflow)
+		t.Fail()
+	}
+
+	// Nor may a hook trying to make room outlive the dispatcher
+	s := &Dispatcher{q: make(chan logrus.Entry), done: make(chan struct{}), overflow: OverflowDropOldest}
+	done := make(chan bool)
+	go func() {
+		s.ingest(logrus.Entry{Message: "a"})
+		done <- true
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(s.done)
+	select {
+	case <-done:
+		break
+	case <-time.After(time.Second):
+		fmt.Printf("Fire kept trying after Stop\n")
+		t.FailNow()
+	}
+	if s.Dropped() != 1 {
+		fmt.Printf("Dropped %d\n", s.Dropped())
+		t.Fail()
+	}
+}
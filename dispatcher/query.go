@@ -0,0 +1,116 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * query.go: Searches of the retained history
+ */
+
+package dispatcher
+
+import (
+	"fmt"
+	"github.com/jwriteclub/weblog/predicate"
+	"time"
+)
+
+// Order is the order of the results of a history query
+type Order int
+const (
+	OldestFirst Order = iota
+	NewestFirst
+)
+
+// DefaultQueryLimit is the number of results returned when a query doesn't
+// set a limit
+const DefaultQueryLimit = 100
+
+// HistoryQuery is a search of the history for entries matching an expression
+type HistoryQuery struct {
+	Syntax predicate.Syntax
+	// Expression may be followed by fields and drop stages, but not by an
+	// aggregating stage
+	Expression string
+	// From and To limit the entries to a time range, inclusive. Zero times
+	// are unbounded.
+	From time.Time
+	To time.Time
+	// Limit is the most entries returned, DefaultQueryLimit if not set
+	Limit int
+	Order Order
+	// Cursor continues a previous query from where it stopped, see
+	// HistoryResult
+	Cursor uint64
+}
+
+// HistoryResult is a page of the results of a history query
+type HistoryResult struct {
+	Entries []Entry
+	// Cursor is set when there may be more results, and is passed in the
+	// next HistoryQuery to fetch them. It is the sequence number of the last
+	// entry returned.
+	Cursor uint64
+}
+
+// Query searches the entries retained in the history
+func (r *Dispatcher) Query(hq HistoryQuery) (HistoryResult, error) {
+	res := HistoryResult{}
+	q, err := predicate.CompileQuery(hq.Syntax, hq.Expression)
+	if err != nil {
+		return res, err
+	}
+	if st := q.Aggregate(); st != nil {
+		return res, fmt.Errorf("%s can't be used in a history query", st.Kind)
+	}
+	if st := q.Dedupe(); st != nil {
+		return res, fmt.Errorf("%s can't be used in a history query", st.Kind)
+	}
+	limit := hq.Limit
+	if limit <= 0 {
+		limit = DefaultQueryLimit
+	}
+
+	// The extra match shows there are more
+	matches := make([]Entry, 0, limit + 1)
+	match := func(e Entry) bool {
+		if hq.Order == NewestFirst && hq.Cursor != 0 && e.Seq >= hq.Cursor {
+			return true
+		}
+		if (!hq.From.IsZero() && e.Time.Before(hq.From)) || (!hq.To.IsZero() && e.Time.After(hq.To)) {
+			return true
+		}
+		if !q.Predicate.True(&e.Entry) {
+			return true
+		}
+		e.Data = q.Project(e.Data)
+		matches = append(matches, e)
+		return len(matches) <= limit
+	}
+	if hq.Order == NewestFirst {
+		newestFirst(r.history, match)
+	} else {
+		oldestFirst(r.history, hq.Cursor, match)
+	}
+
+	more := len(matches) > limit
+	if more {
+		matches = matches[:limit]
+		res.Cursor = matches[limit - 1].Seq
+	}
+	res.Entries = matches
+	return res, nil
+}
@@ -0,0 +1,143 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * query_test.go: Test of history queries
+ */
+
+package dispatcher
+
+import (
+	"testing"
+	"fmt"
+	"strings"
+	"time"
+	"github.com/jwriteclub/weblog/predicate"
+	"github.com/sirupsen/logrus"
+)
+
+func TestDispatcher_Query(t *testing.T) {
+	base := time.Now().Add(-time.Hour)
+	h := newMemoryHistory(100, 0)
+	for seq := uint64(1); seq <= 20; seq += 1 {
+		level := logrus.InfoLevel
+		if seq % 2 == 0 {
+			level = logrus.ErrorLevel
+		}
+		h.Push(Entry{Entry: logrus.Entry{Level: level, Time: base.Add(time.Duration(seq) * time.Minute), Data: logrus.Fields{"n": seq, "body": "..."}}, Seq: seq})
+	}
+	d := &Dispatcher{history: h}
+
+	var tests = []struct {
+		query HistoryQuery
+		output string
+		cursor uint64
+	}{
+		{HistoryQuery{Expression: "level >= error", Limit: 3}, "2,4,6", 6},
+		{HistoryQuery{Expression: "level >= error", Limit: 3, Cursor: 6}, "8,10,12", 12},
+		{HistoryQuery{Expression: "level >= error", Limit: 3, Cursor: 16}, "18,20", 0},
+		{HistoryQuery{Expression: "level >= error", Limit: 3, Order: NewestFirst}, "20,18,16", 16},
+		{HistoryQuery{Expression: "level >= error", Limit: 3, Order: NewestFirst, Cursor: 6}, "4,2", 0},
+		{HistoryQuery{Expression: "level >= error", Limit: 10, From: base.Add(5 * time.Minute), To: base.Add(9 * time.Minute)}, "6,8", 0},
+		{HistoryQuery{Syntax: predicate.SyntaxShorthand, Expression: "n:>=15 | fields n", Order: NewestFirst}, "20,19,18,17,16,15", 0},
+		{HistoryQuery{Expression: "", Limit: 20}, "1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,19,20", 0},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(fmt.Sprintf("%#v", test.query), func(t *testing.T) {
+			res, err := d.Query(test.query)
+			if err != nil {
+				fmt.Printf("Error: %s\n", err.Error())
+				t.Fail()
+				return
+			}
+			out := make([]string, 0)
+			for _, e := range res.Entries {
+				out = append(out, fmt.Sprint(e.Seq))
+			}
+			if strings.Join(out, ",") != test.output || res.Cursor != test.cursor {
+				fmt.Printf("Expected %s (%d) but got %s (%d)\n", test.output, test.cursor, strings.Join(out, ","), res.Cursor)
+				t.Fail()
+			}
+		})
+	}
+
+	res, _ := d.Query(HistoryQuery{Expression: "| fields n", Limit: 1})
+	if _, ok := res.Entries[0].Data["body"]; ok {
+		fmt.Printf("Expected body to be dropped\n")
+		t.Fail()
+	}
+	if _, err := d.Query(HistoryQuery{Expression: "| count"}); err == nil {
+		fmt.Printf("Expected an error for an aggregating query\n")
+		t.Fail()
+	}
+}
+
+// countedHistory counts the entries read from it
+type countedHistory struct {
+	*memoryHistory
+	read *int
+}
+
+func (h countedHistory) Forward(seq uint64, f func(e Entry) bool) {
+	oldestFirst(h.memoryHistory, seq, func(e Entry) bool {
+		*h.read += 1
+		return f(e)
+	})
+}
+
+func (h countedHistory) Reverse(f func(e Entry) bool) {
+	newestFirst(h.memoryHistory, func(e Entry) bool {
+		*h.read += 1
+		return f(e)
+	})
+}
+
+// A query stops reading the history once it has a page, and one more match
+func TestDispatcher_QueryStops(t *testing.T) {
+	read := 0
+	h := countedHistory{newMemoryHistory(100, 0), &read}
+	for seq := uint64(1); seq <= 20; seq += 1 {
+		level := logrus.InfoLevel
+		if seq % 2 == 0 {
+			level = logrus.ErrorLevel
+		}
+		h.Push(Entry{Entry: logrus.Entry{Level: level, Data: logrus.Fields{}}, Seq: seq})
+	}
+	d := &Dispatcher{history: h}
+
+	var tests = []struct {
+		query HistoryQuery
+		read int
+	}{
+		{HistoryQuery{Expression: "level >= error", Limit: 3}, 8},
+		{HistoryQuery{Expression: "level >= error", Limit: 3, Cursor: 6}, 8},
+		{HistoryQuery{Expression: "level >= error", Limit: 3, Order: NewestFirst}, 7},
+	}
+	for _, test := range tests {
+		read = 0
+		if _, err := d.Query(test.query); err != nil {
+			t.Fatal(err)
+		}
+		if read != test.read {
+			fmt.Printf("%#v read %d entries, expected %d\n", test.query, read, test.read)
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,219 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * redact.go: Redaction of entries before they are dispatched
+ */
+
+package dispatcher
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Mask is how redacted text is replaced
+type Mask int
+const (
+	// MaskReplace replaces it with [REDACTED]
+	MaskReplace Mask = iota
+	// MaskHash replaces it with a keyed hash, so that equal values can still
+	// be matched up without being revealed. The key is chosen when the
+	// process starts.
+	MaskHash
+)
+
+const redacted = "[REDACTED]"
+
+var hashKey = func() []byte {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+	return key
+}()
+
+func (m Mask) apply(s string) string {
+	if m == MaskHash {
+		mac := hmac.New(sha256.New, hashKey)
+		mac.Write([]byte(s))
+		return "hash:" + hex.EncodeToString(mac.Sum(nil))[:16]
+	}
+	return redacted
+}
+
+// RedactField masks the values of fields whose names match a glob pattern,
+// ignoring case. Fields inside values logged as maps or structs are masked
+// too.
+func RedactField(pattern string, mask Mask) Option {
+	return func(r *Dispatcher) {
+		r.redactor().fields = append(r.redactor().fields, fieldRule{strings.ToLower(pattern), mask})
+	}
+}
+
+// RedactPattern masks text matching re in the message and every string value
+func RedactPattern(re *regexp.Regexp, mask Mask) Option {
+	return func(r *Dispatcher) {
+		r.redactor().patterns = append(r.redactor().patterns, patternRule{re, mask})
+	}
+}
+
+// RedactFunc calls f with each entry, after the other rules, to redact it
+// however it likes. The entry's data is a copy, which f may change.
+func RedactFunc(f func(e *logrus.Entry)) Option {
+	return func(r *Dispatcher) {
+		r.redactor().funcs = append(r.redactor().funcs, f)
+	}
+}
+
+// redactor applies the redaction rules, in the hook, so that nothing
+// unredacted reaches the history or any selector
+type redactor struct {
+	fields []fieldRule
+	patterns []patternRule
+	funcs []func(e *logrus.Entry)
+}
+
+type fieldRule struct {
+	pattern string
+	mask Mask
+}
+
+type patternRule struct {
+	re *regexp.Regexp
+	mask Mask
+}
+
+func (r *Dispatcher) redactor() *redactor {
+	if r.redact == nil {
+		r.redact = &redactor{}
+	}
+	return r.redact
+}
+
+// apply redacts an entry, which must already be frozen
+func (rd *redactor) apply(e *logrus.Entry) {
+	e.Message = rd.text(e.Message)
+	for k, v := range e.Data {
+		e.Data[k] = rd.value(k, v)
+	}
+	for _, f := range rd.funcs {
+		f(e)
+	}
+}
+
+// field returns the rule for a field name, if there is one
+func (rd *redactor) field(name string) (Mask, bool) {
+	name = strings.ToLower(name)
+	for _, rule := range rd.fields {
+		if ok, err := path.Match(rule.pattern, name); err == nil && ok {
+			return rule.mask, true
+		}
+	}
+	return 0, false
+}
+
+// text applies the patterns to a string
+func (rd *redactor) text(s string) string {
+	for _, rule := range rd.patterns {
+		s = rule.re.ReplaceAllStringFunc(s, rule.mask.apply)
+	}
+	return s
+}
+
+// value redacts a frozen value of the field name
+func (rd *redactor) value(name string, v interface{}) interface{} {
+	if mask, ok := rd.field(name); ok {
+		switch t := v.(type) {
+		case nil:
+			return nil
+		case string:
+			return mask.apply(t)
+		case json.RawMessage:
+			return mask.apply(string(t))
+		default:
+			return mask.apply(fmt.Sprint(t))
+		}
+	}
+	switch t := v.(type) {
+	case string:
+		return rd.text(t)
+	case json.RawMessage:
+		return rd.raw(t)
+	}
+	return v
+}
+
+// raw redacts the fields and strings inside a JSON value. It's only
+// re-encoded if something was redacted.
+func (rd *redactor) raw(data json.RawMessage) json.RawMessage {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if dec.Decode(&v) != nil {
+		return json.RawMessage(rd.text(string(data)))
+	}
+	v, changed := rd.walk(v)
+	if !changed {
+		return data
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		return json.RawMessage(`"` + redacted + `"`)
+	}
+	return out
+}
+
+// walk redacts a decoded JSON value, reporting whether anything changed
+func (rd *redactor) walk(v interface{}) (interface{}, bool) {
+	changed := false
+	switch t := v.(type) {
+	case string:
+		s := rd.text(t)
+		return s, s != t
+	case map[string]interface{}:
+		for k, c := range t {
+			if mask, ok := rd.field(k); ok {
+				if c != nil {
+					t[k] = mask.apply(fmt.Sprint(c))
+					changed = true
+				}
+				continue
+			}
+			if n, ch := rd.walk(c); ch {
+				t[k] = n
+				changed = true
+			}
+		}
+	case []interface{}:
+		for i, c := range t {
+			if n, ch := rd.walk(c); ch {
+				t[i] = n
+				changed = true
+			}
+		}
+	}
+	return v, changed
+}
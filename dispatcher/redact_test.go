@@ -0,0 +1,122 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * redact_test.go: Test of redacting entries before they are dispatched
+ */
+
+package dispatcher
+
+import (
+	"testing"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"github.com/sirupsen/logrus"
+)
+
+type account struct {
+	Name string `json:"name"`
+	Password string `json:"password"`
+}
+
+func TestRedact(t *testing.T) {
+	card := regexp.MustCompile(`\b\d{4}-\d{4}-\d{4}-\d{4}\b`)
+	email := regexp.MustCompile(`[a-z]+@example\.com`)
+	var tests = []struct {
+		name string
+		opts []Option
+		message string
+		fields logrus.Fields
+		// The redacted message and fields, JSON encoded
+		output string
+	}{
+		{"field", []Option{RedactField("password", MaskReplace)}, "login",
+			logrus.Fields{"Password": "hunter2", "user": "bob"},
+			`login {"Password":"[REDACTED]","user":"bob"}`},
+		{"glob", []Option{RedactField("*token*", MaskReplace)}, "call",
+			logrus.Fields{"api_token": 1234, "tokens": nil, "url": "/"},
+			`call {"api_token":"[REDACTED]","tokens":null,"url":"/"}`},
+		{"nested", []Option{RedactField("password", MaskReplace)}, "created",
+			logrus.Fields{"account": account{"bob", "hunter2"}},
+			`created {"account":{"name":"bob","password":"[REDACTED]"}}`},
+		{"pattern", []Option{RedactPattern(card, MaskReplace)}, "charged 4111-1111-1111-1111",
+			logrus.Fields{"card": "4111-1111-1111-1111", "amount": "12.00"},
+			`charged [REDACTED] {"amount":"12.00","card":"[REDACTED]"}`},
+		{"nested pattern", []Option{RedactPattern(card, MaskReplace)}, "created",
+			logrus.Fields{"account": account{"4111-1111-1111-1111", "x"}},
+			`created {"account":{"name":"[REDACTED]","password":"x"}}`},
+		{"func", []Option{RedactFunc(func(e *logrus.Entry) { delete(e.Data, "secret") })}, "hi",
+			logrus.Fields{"secret": "x", "user": "bob"},
+			`hi {"user":"bob"}`},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			d := NewDispatcher(test.opts...)
+			defer d.Stop()
+			e := freeze(&logrus.Entry{Message: test.message, Data: test.fields})
+			d.redact.apply(&e)
+			data, err := json.Marshal(e.Data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out := e.Message + " " + string(data); out != test.output {
+				fmt.Printf("Expected %s but got %s\n", test.output, out)
+				t.Fail()
+			}
+		})
+	}
+
+	t.Run("hash", func(t *testing.T) {
+		d := NewDispatcher(RedactPattern(email, MaskHash))
+		defer d.Stop()
+		e := freeze(&logrus.Entry{Message: "bob@example.com then alice@example.com then bob@example.com", Data: logrus.Fields{}})
+		d.redact.apply(&e)
+		words := strings.Split(e.Message, " then ")
+		if strings.Contains(e.Message, "@") || !strings.HasPrefix(words[0], "hash:") || words[0] != words[2] || words[0] == words[1] {
+			fmt.Printf("Got %s\n", e.Message)
+			t.Fail()
+		}
+	})
+}
+
+func TestDispatcher_Redact(t *testing.T) {
+	d := NewDispatcher(RedactField("password", MaskReplace))
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	s, err := NewSelector("Prefix(test)", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	log.WithFields(logrus.Fields{"prefix": "test", "password": "hunter2"}).Info("login")
+
+	if e := read(s); e == nil || e.Data["password"] != redacted {
+		fmt.Printf("Selector got %#v\n", e)
+		t.Fail()
+	}
+	if e, ok := d.history.Newest(); !ok || e.Data["password"] != redacted {
+		fmt.Printf("History has %#v\n", e)
+		t.Fail()
+	}
+}
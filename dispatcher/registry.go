@@ -0,0 +1,260 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * registry.go: Named streams of entries
+ */
+
+package dispatcher
+
+import (
+	"context"
+	"fmt"
+	"github.com/jwriteclub/weblog/predicate"
+	"sort"
+	"sync"
+	"time"
+)
+
+// StreamField is the field selectors see the name of the stream an entry was
+// logged to as, see the Name option and Entry.Stream
+const StreamField = predicate.StreamField
+
+// Registry holds named dispatchers, one for each independent stream of
+// entries, such as an application's access, job and audit logs
+type Registry struct {
+	m *sync.RWMutex
+	streams map[string]*Dispatcher
+	// Closed when a stream is next added or removed
+	changed chan struct{}
+}
+
+func NewRegistry() *Registry {
+	return &Registry{m: &sync.RWMutex{}, streams: make(map[string]*Dispatcher), changed: make(chan struct{})}
+}
+
+// Add adds a dispatcher under its name, which must be set with the Name option
+// and not already be in use
+func (g *Registry) Add(d *Dispatcher) error {
+	if d.Name() == "" {
+		return fmt.Errorf("a dispatcher needs a name to be added to a registry")
+	}
+	defer g.m.Unlock()
+	g.m.Lock()
+	if _, ok := g.streams[d.Name()]; ok {
+		return fmt.Errorf("stream %q already exists", d.Name())
+	}
+	g.streams[d.Name()] = d
+	g.change()
+	return nil
+}
+
+// Remove removes a stream from the registry, without closing its dispatcher
+func (g *Registry) Remove(name string) {
+	defer g.m.Unlock()
+	g.m.Lock()
+	if _, ok := g.streams[name]; ok {
+		delete(g.streams, name)
+		g.change()
+	}
+}
+
+// Changed returns a channel which is closed the next time a stream is added
+// or removed. Call it again after each change for the next one.
+func (g *Registry) Changed() <-chan struct{} {
+	defer g.m.RUnlock()
+	g.m.RLock()
+	return g.changed
+}
+
+// change wakes anyone waiting for the streams to change, with the lock held
+func (g *Registry) change() {
+	close(g.changed)
+	g.changed = make(chan struct{})
+}
+
+// Get returns the dispatcher for a stream, or nil if there isn't one
+func (g *Registry) Get(name string) *Dispatcher {
+	defer g.m.RUnlock()
+	g.m.RLock()
+	return g.streams[name]
+}
+
+// Names returns the names of the streams, in order
+func (g *Registry) Names() []string {
+	defer g.m.RUnlock()
+	g.m.RLock()
+	ret := make([]string, 0, len(g.streams))
+	for name := range g.streams {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Close closes every dispatcher in the registry, returning the first error
+func (g *Registry) Close() (err error) {
+	for _, name := range g.Names() {
+		if d := g.Get(name); d != nil {
+			if e := d.Close(); e != nil && err == nil {
+				err = e
+			}
+		}
+	}
+	return
+}
+
+// MultiSelector is a selector spanning several streams of a Registry. The
+// entries of each stream arrive in order, but the streams are interleaved as
+// they're read, and each has its own sequence numbers. Entries can be told
+// apart by their Stream, which the expression sees as the stream field, to
+// group by it, say.
+type MultiSelector struct {
+	selectors []*Selector
+	c chan Entry
+	done chan struct{}
+	stopOnce *sync.Once
+}
+
+// NewSelector creates a selector for the named streams, or every stream if
+// none are named. An aggregating stage counts the entries of all of them
+// together. Streams added to the registry later aren't included, see Changed.
+func (g *Registry) NewSelector(syntax predicate.Syntax, expression string, streams ...string) (ret *MultiSelector, err error) {
+	ret = &MultiSelector{c: make(chan Entry), done: make(chan struct{}), stopOnce: &sync.Once{}}
+	if len(streams) == 0 {
+		streams = g.Names()
+		// There may be none yet, but the expression must still be valid
+		if len(streams) == 0 {
+			_, err = predicate.CompileQuery(syntax, expression)
+		}
+	}
+	for _, name := range streams {
+		d := g.Get(name)
+		if d == nil {
+			err = fmt.Errorf("unknown stream %q", name)
+			break
+		}
+		var s *Selector
+		s, err = prepareSelector(syntax, expression, d, false, 0)
+		if err != nil {
+			break
+		}
+		// Every stream feeds the same aggregator
+		if len(ret.selectors) > 0 && s.agg != nil {
+			s.agg = ret.selectors[0].agg
+		}
+		ret.selectors = append(ret.selectors, s)
+	}
+	if err != nil {
+		ret.selectors = nil
+		close(ret.c)
+		return
+	}
+
+	wg := &sync.WaitGroup{}
+	for _, s := range ret.selectors {
+		s.start()
+		wg.Add(1)
+		go ret.pump(s, wg)
+	}
+	go func() {
+		wg.Wait()
+		// Without any streams, there's nothing to close it but Stop
+		if len(ret.selectors) == 0 {
+			<-ret.done
+		}
+		close(ret.c)
+	}()
+	return
+}
+
+// pump forwards a stream's entries until it's closed or the selector stopped
+func (m *MultiSelector) pump(s *Selector, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for e := range s.C() {
+		select {
+		case m.c <- e:
+			break
+		case <-m.done:
+			return
+		}
+	}
+}
+
+// C returns a channel of the matching entries, and gaps, of every stream,
+// which is closed once the selector is stopped or, if it has any streams, all
+// their dispatchers closed
+func (m *MultiSelector) C() <-chan Entry {
+	return m.c
+}
+
+// Next waits for the next matching entry, or gap, like Selector.Next
+func (m *MultiSelector) Next(ctx context.Context) (Entry, error) {
+	select {
+	case ent, ok := <-m.c:
+		if !ok {
+			return Entry{}, ErrClosed
+		}
+		return ent, nil
+	case <-ctx.Done():
+		return Entry{}, ctx.Err()
+	}
+}
+
+// Aggregating reports whether the query ends in an aggregating stage, see
+// Selector.Aggregating
+func (m *MultiSelector) Aggregating() bool {
+	return len(m.selectors) > 0 && m.selectors[0].Aggregating()
+}
+
+// Snapshot returns the current state of the aggregating stage, across every
+// stream, or nil if there isn't one
+func (m *MultiSelector) Snapshot() *Snapshot {
+	if len(m.selectors) == 0 {
+		return nil
+	}
+	return m.selectors[0].Snapshot()
+}
+
+// Dropped returns the number of entries dropped by all the streams
+func (m *MultiSelector) Dropped() (ret uint64) {
+	for _, s := range m.selectors {
+		ret += s.Dropped()
+	}
+	return
+}
+
+// Stop stops the selector for every stream. It may be called more than once.
+func (m *MultiSelector) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.done)
+		for _, s := range m.selectors {
+			s.Stop()
+		}
+	})
+}
+
+func (m *MultiSelector) BaseTime() time.Time {
+	return baseTimestamp
+}
+
+// Epoch is empty, as the streams each number their entries in their own
+// epoch, and can't be resumed together
+func (m *MultiSelector) Epoch() string {
+	return ""
+}
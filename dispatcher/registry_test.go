@@ -0,0 +1,170 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * registry_test.go: Test of named streams
+ */
+
+package dispatcher
+
+import (
+	"testing"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"github.com/jwriteclub/weblog/predicate"
+	"github.com/sirupsen/logrus"
+)
+
+// streams creates a registry of named dispatchers, each with a logger
+func streams(t *testing.T, names ...string) (*Registry, map[string]*logrus.Logger) {
+	g := NewRegistry()
+	logs := make(map[string]*logrus.Logger)
+	for _, name := range names {
+		d := NewDispatcher(Name(name))
+		if err := g.Add(d); err != nil {
+			t.Fatal(err)
+		}
+		log := logrus.New()
+		log.Out = discard{}
+		log.AddHook(d.Hook())
+		logs[name] = log
+	}
+	return g, logs
+}
+
+func TestRegistry_Add(t *testing.T) {
+	g, _ := streams(t, "jobs", "access")
+	defer g.Close()
+	if strings.Join(g.Names(), ",") != "access,jobs" {
+		fmt.Printf("Names %v\n", g.Names())
+		t.Fail()
+	}
+	if g.Add(NewDispatcher(Name("jobs"))) == nil {
+		fmt.Printf("Added a duplicate stream\n")
+		t.Fail()
+	}
+	if g.Add(NewDispatcher()) == nil {
+		fmt.Printf("Added an unnamed stream\n")
+		t.Fail()
+	}
+	if g.Get("jobs") == nil || g.Get("jobs").Name() != "jobs" || g.Get("audit") != nil {
+		t.Fail()
+	}
+	changed := g.Changed()
+	g.Remove("jobs")
+	if strings.Join(g.Names(), ",") != "access" {
+		fmt.Printf("Names %v after removing\n", g.Names())
+		t.Fail()
+	}
+	select {
+	case <-changed:
+		break
+	default:
+		fmt.Printf("Removing didn't close the channel\n")
+		t.Fail()
+	}
+}
+
+func TestRegistry_Selector(t *testing.T) {
+	g, logs := streams(t, "jobs", "access", "audit")
+	defer g.Close()
+
+	all, err := g.NewSelector(predicate.SyntaxShorthand, "prefix:test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer all.Stop()
+	some, err := g.NewSelector(predicate.SyntaxShorthand, "prefix:test stream:jobs", "jobs", "audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer some.Stop()
+	counts, err := g.NewSelector(predicate.SyntaxShorthand, "prefix:test | count by stream")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer counts.Stop()
+	if _, err := g.NewSelector(predicate.SyntaxShorthand, "", "missing"); err == nil {
+		fmt.Printf("Selected an unknown stream\n")
+		t.Fail()
+	}
+
+	logs["jobs"].WithField("prefix", "test").Info("job")
+	logs["access"].WithField("prefix", "test").Info("access")
+	logs["audit"].WithFields(logrus.Fields{"prefix": "test", "stream": "own"}).Info("audit")
+	logs["jobs"].WithField("prefix", "other").Info("skipped")
+
+	got := make([]string, 0)
+	for len(got) < 3 {
+		select {
+		case e := <-all.C():
+			got = append(got, fmt.Sprintf("%s/%s", e.Stream, e.Message))
+		case <-time.After(time.Second):
+			t.Fatal("timed out")
+		}
+	}
+	sort.Strings(got)
+	// The stream is the dispatcher's name, whatever field the entry has
+	if strings.Join(got, ",") != "access/access,audit/audit,jobs/job" {
+		fmt.Printf("Got %v\n", got)
+		t.Fail()
+	}
+	select {
+	case e := <-some.C():
+		if e.Message != "job" {
+			fmt.Printf("Got %#v\n", e)
+			t.Fail()
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out")
+	}
+
+	if !counts.Aggregating() {
+		t.Fatal("not aggregating")
+	}
+	deadline := time.Now().Add(time.Second)
+	for counts.Snapshot().Total != 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	snap := counts.Snapshot()
+	if snap.Total != 3 || len(snap.Groups) != 3 {
+		fmt.Printf("Snapshot %#v\n", snap)
+		t.Fail()
+	}
+	for _, grp := range snap.Groups {
+		if g.Get(grp.Key) == nil {
+			fmt.Printf("Counted stream %q\n", grp.Key)
+			t.Fail()
+		}
+	}
+
+	// Closing a stream doesn't end the selector, but stopping it does
+	g.Get("jobs").Close()
+	all.Stop()
+	timeout := time.After(time.Second)
+	for open := true; open; {
+		select {
+		case _, open = <-all.C():
+			break
+		case <-timeout:
+			t.Fatal("C not closed after stopping")
+		}
+	}
+}
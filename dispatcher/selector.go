@@ -23,90 +23,456 @@
 package dispatcher
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/jwriteclub/weblog/predicate"
 	"github.com/sirupsen/logrus"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 var baseTimestamp = time.Now()
 
+// The id of the last selector created
+var selectorID uint64
+
+// ErrClosed is returned by Next once a selector is stopped or its dispatcher
+// closed, and by AddAlert once the dispatcher is closed
+var ErrClosed = errors.New("selector closed")
+
 type Selector struct {
-	q chan logrus.Entry
+	// Entries dropped from the queue, and delivered to it or the aggregating
+	// stage, first for atomic alignment
+	dropped uint64
+	delivered uint64
+	// Identifies the selector in Stats
+	id uint64
+	// Set once the queue is closed
+	closed uint32
+	q chan Entry
+	closeOnce *sync.Once
+	// Dropped entries not yet reported in the queue, only used by the
+	// goroutine delivering to the selector: the one registering it while the
+	// history is replayed, then the dispatch goroutine
+	gap Gap
 	predicate predicate.BoolOp
+	query predicate.Query
+	expression string
+	// The levels the predicate can match
+	levels predicate.LevelSet
+	// Set when the query ends in an aggregating stage
+	agg *aggregator
+	// Set when the query has a dedupe stage, whose state is only used by the
+	// goroutine delivering to the selector
+	dedupe *deduper
+	// The latest repeat of each entry which didn't fit in the queue, to be
+	// queued once there's room. There's at most one for each entry the
+	// deduper is folding into. Only used by the goroutine delivering to the
+	// selector.
+	repeats map[uint64]Entry
 	d *Dispatcher
 	t *time.Ticker
 	m *sync.RWMutex
 	reg bool
+	// When resume is set, the dispatcher replays the history after the
+	// sequence number after, rather than just the newest entries. It's
+	// cleared if the dispatcher hasn't reached after.
+	resume bool
+	after uint64
+	// Set by the dispatch goroutine while the history is replayed, when the
+	// entries it dispatches are held, up to the size of the queue, and those
+	// beyond counted as a gap. Only used by the dispatch goroutine.
+	replaying bool
+	held []Entry
+	heldGap Gap
 }
 
 func NewSelector(expression string, dispatcher *Dispatcher) (ret *Selector, err error) {
+	return NewSelectorSyntax(predicate.SyntaxSelect, expression, dispatcher)
+}
+
+// NewSelectorSyntax is like NewSelector, but the expression is parsed with the
+// given query syntax
+func NewSelectorSyntax(syntax predicate.Syntax, expression string, dispatcher *Dispatcher) (ret *Selector, err error) {
+	return newSelector(syntax, expression, dispatcher, false, 0)
+}
+
+// NewSelectorAfter is like NewSelectorSyntax, but resumes a stream which
+// had already seen the entry with sequence number after, in the given epoch.
+// Every retained entry after it is replayed, as far as the queue allows, and a
+// gap is reported first if the history no longer reaches back that far. If the
+// epoch isn't the dispatcher's (it has been restarted, say) or after is later
+// than any entry it has seen, the selector starts as a new one would.
+func NewSelectorAfter(syntax predicate.Syntax, expression string, epoch string, after uint64, dispatcher *Dispatcher) (ret *Selector, err error) {
+	return newSelector(syntax, expression, dispatcher, epoch == dispatcher.Epoch(), after)
+}
+
+func newSelector(syntax predicate.Syntax, expression string, dispatcher *Dispatcher, resume bool, after uint64) (ret *Selector, err error) {
+	ret, err = prepareSelector(syntax, expression, dispatcher, resume, after)
+	if err != nil {
+		return
+	}
+	ret.start()
+	return
+}
+
+// prepareSelector creates a selector without registering it
+func prepareSelector(syntax predicate.Syntax, expression string, dispatcher *Dispatcher, resume bool, after uint64) (ret *Selector, err error) {
 	ret = &Selector{}
-	ret.q = make(chan logrus.Entry, chanBuffer)
+	ret.id = atomic.AddUint64(&selectorID, 1)
+	// The sequence number only grows, so a stream which can be resumed now
+	// still can be when the selector is registered
+	ret.resume = resume && after <= atomic.LoadUint64(&dispatcher.seq)
+	ret.after = after
+	ret.q = make(chan Entry, dispatcher.selectorQueue)
+	ret.closeOnce = &sync.Once{}
 	ret.d = dispatcher
 	ret.m = &sync.RWMutex{}
-	ret.d.Register(ret)
-	ret.reg = true
-	err = ret.Select(expression)
+	ret.levels = predicate.AllLevels
+	// The query is needed before registering, as the history is filtered
+	// with it straight away
+	err = ret.SelectSyntax(syntax, expression)
 	return
 }
 
+// Resumed reports whether the selector resumed a stream, see
+// NewSelectorAfter. If not, it started as a new selector would, and entries
+// seen before mustn't be compared with its sequence numbers.
+func (s *Selector) Resumed() bool {
+	return s.resume
+}
+
+// start registers a prepared selector
+func (s *Selector) start() {
+	s.d.Register(s)
+	defer s.m.Unlock()
+	s.m.Lock()
+	s.reg = true
+}
+
 func (s *Selector) Select(expression string) (err error) {
-	var op interface{}
-	op, err = predicate.Parse("selector", []byte(expression))
+	return s.SelectSyntax(predicate.SyntaxSelect, expression)
+}
+
+// SelectSyntax replaces the selector's query. The expression may be followed
+// by pipe stages, see predicate.CompileQuery.
+func (s *Selector) SelectSyntax(syntax predicate.Syntax, expression string) (err error) {
+	var q predicate.Query
+	q, err = predicate.CompileQuery(syntax, expression)
 	if err != nil {
 		return
 	}
-	if _, ok := op.(predicate.BoolOp); !ok {
-		err = errors.New("Invalid BoolOp from predicate")
-		return
+	levels := predicate.LevelsOf(q.Predicate)
+	if s.registered() {
+		s.d.setLevels(s, levels)
 	}
 	defer s.m.Unlock()
 	s.m.Lock()
-	fmt.Printf("selector: %#v\n", op)
-	p, ok := (op.(predicate.BoolOp))
-	if !ok {
-		panic("unable to convert predicate")
+	fmt.Printf("selector: %#v\n", q)
+	s.predicate = q.Predicate
+	s.query = q
+	s.expression = expression
+	s.levels = levels
+	s.agg = nil
+	if st := q.Aggregate(); st != nil {
+		s.agg = newAggregator(*st)
+	}
+	s.dedupe = nil
+	if st := q.Dedupe(); st != nil {
+		s.dedupe = newDeduper(*st)
 	}
-	s.predicate = p
 	return
 }
 
-func (s *Selector) true(e *logrus.Entry) bool {
+// Levels returns the set of levels the selector's predicate can match
+func (s *Selector) Levels() predicate.LevelSet {
 	defer s.m.RUnlock()
 	s.m.RLock()
-	return s.predicate.True(e)
+	return s.levels
+}
+
+// accept is called with each entry replayed from the history. Matching
+// entries are aggregated, or projected and queued, so the queue only holds
+// entries ready to be read.
+func (s *Selector) accept(e Entry) {
+	if !s.true(&e.Entry) {
+		return
+	}
+	s.deliver(e)
+}
+
+// receive is called by the dispatch goroutine with each matching entry. While
+// the history is still being replayed, entries are held, unless they're
+// aggregated, which doesn't depend on their order.
+func (s *Selector) receive(e Entry) {
+	if !s.replaying {
+		s.deliver(e)
+		return
+	}
+	if s.aggregate(&e.Entry) {
+		s.countDelivered()
+		return
+	}
+	if len(s.held) < cap(s.q) {
+		s.held = append(s.held, e)
+		return
+	}
+	s.heldGap.add(&e)
+	s.countDropped()
+}
+
+// deliver is accept for an entry known to match
+func (s *Selector) deliver(e Entry) {
+	if s.aggregate(&e.Entry) {
+		s.countDelivered()
+		return
+	}
+	e.Repeat = s.fold(&e)
+	s.project(&e.Entry)
+	if e.Repeat != nil {
+		s.update(e)
+		return
+	}
+	s.offer(e)
 }
 
-func (s *Selector) MaybeRead() (e *logrus.Entry) {
-	if !s.reg {
+// fold returns the repeat e is folded into by the dedupe stage, if any. The
+// deduper is only used by the goroutine delivering to the selector.
+func (s *Selector) fold(e *Entry) *Repeat {
+	s.m.RLock()
+	d := s.dedupe
+	s.m.RUnlock()
+	if d == nil {
 		return nil
 	}
-	found := false
-	for !found {
+	return d.fold(e)
+}
+
+// update queues a repeat. Each carries the full count, so when the queue is
+// full it's kept until there's room, replacing any earlier repeat of the same
+// entry, rather than reported as a gap.
+func (s *Selector) update(e Entry) {
+	delete(s.repeats, e.Repeat.Of)
+	if s.flush() {
 		select {
-		case ent := <-s.q:
-			if s.true(&ent) {
-				found = true
-			}
-			e = &ent
-			break
-		default:
-			e = nil
+		case s.q <- e:
+			s.countDelivered()
 			return
+		default:
 			break
 		}
 	}
-	return
+	if s.repeats == nil {
+		s.repeats = make(map[uint64]Entry)
+	}
+	s.repeats[e.Repeat.Of] = e
+}
+
+// offer tries to queue an entry, without blocking. When the queue is full the
+// entry is dropped, and a gap is queued ahead of the next entry which fits.
+func (s *Selector) offer(e Entry) {
+	if !s.flush() {
+		s.drop(&e)
+		return
+	}
+	select {
+	case s.q <- e:
+		s.countDelivered()
+	default:
+		s.drop(&e)
+	}
+}
+
+// flush queues the pending gap, if there is one, and then as many of the
+// repeats waiting as fit. It reports whether the gap was queued, as entries
+// can't be queued ahead of it.
+func (s *Selector) flush() bool {
+	if s.gap.Dropped > 0 {
+		gap := s.gap
+		select {
+		case s.q <- Entry{Seq: gap.LastSeq, Gap: &gap}:
+			s.gap = Gap{}
+		default:
+			return false
+		}
+	}
+	if len(s.repeats) == 0 {
+		return true
+	}
+	pending := make([]Entry, 0, len(s.repeats))
+	for _, e := range s.repeats {
+		pending = append(pending, e)
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].Seq < pending[j].Seq
+	})
+	for _, e := range pending {
+		select {
+		case s.q <- e:
+			delete(s.repeats, e.Repeat.Of)
+			s.countDelivered()
+		default:
+			return true
+		}
+	}
+	return true
+}
+
+func (s *Selector) drop(e *Entry) {
+	s.gap.add(e)
+	s.countDropped()
+}
+
+// countDelivered counts an entry delivered, for the selector and its
+// dispatcher
+func (s *Selector) countDelivered() {
+	atomic.AddUint64(&s.delivered, 1)
+	atomic.AddUint64(&s.d.delivered, 1)
+}
+
+// countDropped counts an entry dropped, for the selector and its dispatcher
+func (s *Selector) countDropped() {
+	atomic.AddUint64(&s.dropped, 1)
+	atomic.AddUint64(&s.d.selectorDropped, 1)
+}
+
+// Dropped returns the number of entries dropped because the selector's queue
+// was full
+func (s *Selector) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+func (s *Selector) true(e *logrus.Entry) bool {
+	defer s.m.RUnlock()
+	s.m.RLock()
+	return s.predicate != nil && s.predicate.True(e)
+}
+
+// project applies the fields and drop stages of the selector's query
+func (s *Selector) project(e *logrus.Entry) {
+	defer s.m.RUnlock()
+	s.m.RLock()
+	e.Data = s.query.Project(e.Data)
+}
+
+// aggregate feeds e to the selector's aggregating stage, if it has one
+func (s *Selector) aggregate(e *logrus.Entry) bool {
+	defer s.m.RUnlock()
+	s.m.RLock()
+	if s.agg == nil {
+		return false
+	}
+	s.agg.add(e)
+	return true
+}
+
+// Aggregating reports whether the selector's query ends in an aggregating
+// stage, in which case no entries are returned, and the results are read with
+// Snapshot instead
+func (s *Selector) Aggregating() bool {
+	defer s.m.RUnlock()
+	s.m.RLock()
+	return s.agg != nil
+}
+
+// Snapshot returns the current state of the selector's aggregating stage, or
+// nil if it doesn't have one
+func (s *Selector) Snapshot() *Snapshot {
+	defer s.m.RUnlock()
+	s.m.RLock()
+	if s.agg == nil {
+		return nil
+	}
+	return s.agg.snapshot(time.Now())
+}
+
+// MaybeRead returns the next matching entry, or a gap where entries were
+// dropped, or nil if there's nothing waiting
+func (s *Selector) MaybeRead() (e *Entry) {
+	if !s.registered() {
+		return nil
+	}
+	select {
+	case ent, ok := <-s.q:
+		if !ok {
+			return nil
+		}
+		return &ent
+	default:
+		return nil
+	}
+}
+
+// Next waits for the next matching entry, or gap. It returns ErrClosed once
+// the selector is stopped or its dispatcher closed, and the context's error if
+// it's done first.
+func (s *Selector) Next(ctx context.Context) (Entry, error) {
+	select {
+	case ent, ok := <-s.q:
+		if !ok {
+			return Entry{}, ErrClosed
+		}
+		return ent, nil
+	case <-ctx.Done():
+		return Entry{}, ctx.Err()
+	}
+}
+
+// C returns a channel of the matching entries, and gaps, which is closed when
+// the selector is stopped or its dispatcher closed
+func (s *Selector) C() <-chan Entry {
+	return s.q
+}
+
+// OnEntry calls f, from another goroutine, with each matching entry, and gap,
+// until the selector is stopped or its dispatcher closed
+func (s *Selector) OnEntry(f func(e Entry)) {
+	c := s.C()
+	go func() {
+		for ent := range c {
+			f(ent)
+		}
+	}()
 }
 
 func (s *Selector) Stop() {
 	s.d.Unregister(s)
+	defer s.m.Unlock()
+	s.m.Lock()
 	s.reg = false
 }
 
+// close closes the selector's queue, when it's unregistered or its dispatcher
+// is closed
+func (s *Selector) close() {
+	s.closeOnce.Do(func() {
+		atomic.StoreUint32(&s.closed, 1)
+		close(s.q)
+	})
+}
+
+// Closed reports whether the selector has been stopped, or its dispatcher
+// closed, after which no more entries arrive. Entries already waiting can
+// still be read.
+func (s *Selector) Closed() bool {
+	return atomic.LoadUint32(&s.closed) == 1
+}
+
+func (s *Selector) registered() bool {
+	defer s.m.RUnlock()
+	s.m.RLock()
+	return s.reg
+}
+
 func (s *Selector) BaseTime() time.Time {
 	return baseTimestamp
+}
+
+// Epoch returns the epoch of the sequence numbers of the selector's entries,
+// see Dispatcher.Epoch
+func (s *Selector) Epoch() string {
+	return s.d.Epoch()
 }
\ No newline at end of file
@@ -0,0 +1,151 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * stats.go: Statistics of a dispatcher and its selectors
+ */
+
+package dispatcher
+
+import (
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// Stats is a snapshot of a dispatcher's activity, see Dispatcher.Stats
+type Stats struct {
+	// Stream is the dispatcher's name, if it has one
+	Stream string `json:"stream,omitempty"`
+	// Ingested is the number of entries dispatched since starting
+	Ingested uint64 `json:"ingested"`
+	// IngestRate is the entries dispatched per second over the last
+	// rateInterval, or since starting until then
+	IngestRate float64 `json:"ingest_rate"`
+	// IngestQueue is the number of entries waiting for the dispatcher
+	IngestQueue int `json:"ingest_queue"`
+	IngestCapacity int `json:"ingest_capacity"`
+	// Dropped is the number of entries the hook discarded, see Dropped
+	Dropped uint64 `json:"dropped"`
+	// Delivered and SelectorDropped are the matching entries delivered to,
+	// or dropped from, every selector since starting, including those no
+	// longer registered
+	Delivered uint64 `json:"delivered"`
+	SelectorDropped uint64 `json:"selector_dropped"`
+	// History is only set if the history is a HistoryReporter
+	History HistoryStats `json:"history"`
+	Selectors []SelectorStats `json:"selectors"`
+}
+
+// HistoryStats is the occupancy of a history. Limits are zero where there are
+// none.
+type HistoryStats struct {
+	Entries int `json:"entries"`
+	Bytes int64 `json:"bytes"`
+	MaxEntries int `json:"max_entries"`
+	MaxBytes int64 `json:"max_bytes"`
+}
+
+// HistoryReporter is implemented by histories which can report their
+// occupancy, as the in-memory history and DiskHistory do
+type HistoryReporter interface {
+	Stats() HistoryStats
+}
+
+// SelectorStats is a snapshot of a registered selector's queue
+type SelectorStats struct {
+	// ID identifies the selector for as long as the process runs
+	ID uint64 `json:"id"`
+	Query string `json:"query"`
+	// Queue is the number of entries waiting to be read
+	Queue int `json:"queue"`
+	Capacity int `json:"capacity"`
+	// Delivered is the number of matching entries queued, or aggregated, and
+	// Dropped the number which didn't fit in the queue
+	Delivered uint64 `json:"delivered"`
+	Dropped uint64 `json:"dropped"`
+}
+
+// The period IngestRate is measured over
+const rateInterval = 10 * time.Second
+
+// Stats returns a snapshot of the dispatcher's activity. A selector whose
+// queue stays near its capacity, or whose dropped count grows, is being read
+// too slowly.
+func (r *Dispatcher) Stats() Stats {
+	st := Stats{
+		Stream: r.name,
+		Ingested: atomic.LoadUint64(&r.ingested),
+		IngestQueue: len(r.q),
+		IngestCapacity: cap(r.q),
+		Dropped: r.Dropped(),
+		Delivered: atomic.LoadUint64(&r.delivered),
+		SelectorDropped: atomic.LoadUint64(&r.selectorDropped),
+		Selectors: make([]SelectorStats, 0),
+	}
+	if h, ok := r.history.(HistoryReporter); ok {
+		st.History = h.Stats()
+	}
+
+	r.lm.Lock()
+	if r.rated {
+		st.IngestRate = r.rate
+	} else if elapsed := time.Since(r.rateTime); elapsed > 0 {
+		st.IngestRate = float64(st.Ingested - r.rateCount) / elapsed.Seconds()
+	}
+	selectors := make([]*Selector, 0, len(r.selectorLevels))
+	for s := range r.selectorLevels {
+		selectors = append(selectors, s)
+	}
+	r.lm.Unlock()
+
+	for _, s := range selectors {
+		st.Selectors = append(st.Selectors, s.stats())
+	}
+	sort.Slice(st.Selectors, func(i, j int) bool {
+		return st.Selectors[i].ID < st.Selectors[j].ID
+	})
+	return st
+}
+
+// measure updates the ingest rate reported by Stats. It's called by the
+// dispatch goroutine every rateInterval, so the rate doesn't depend on how
+// often Stats is called.
+func (r *Dispatcher) measure() {
+	defer r.lm.Unlock()
+	r.lm.Lock()
+	now := time.Now()
+	ingested := atomic.LoadUint64(&r.ingested)
+	if elapsed := now.Sub(r.rateTime); elapsed > 0 {
+		r.rate = float64(ingested - r.rateCount) / elapsed.Seconds()
+	}
+	r.rateTime, r.rateCount, r.rated = now, ingested, true
+}
+
+func (s *Selector) stats() SelectorStats {
+	defer s.m.RUnlock()
+	s.m.RLock()
+	return SelectorStats{
+		ID: s.id,
+		Query: s.expression,
+		Queue: len(s.q),
+		Capacity: cap(s.q),
+		Delivered: atomic.LoadUint64(&s.delivered),
+		Dropped: atomic.LoadUint64(&s.dropped),
+	}
+}
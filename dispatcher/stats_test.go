@@ -0,0 +1,97 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * stats_test.go: Test of dispatcher statistics
+ */
+
+package dispatcher
+
+import (
+	"testing"
+	"fmt"
+	"time"
+	"github.com/sirupsen/logrus"
+)
+
+func TestDispatcher_Stats(t *testing.T) {
+	d := NewDispatcher(SelectorQueue(2), Name("jobs"))
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	s, err := NewSelector("Prefix(test)", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	other, err := NewSelector("Prefix(other)", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 5; i += 1 {
+		log.WithField("prefix", "test").Info("unread")
+	}
+	deadline := time.Now().Add(time.Second)
+	for d.Stats().Ingested < 5 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	st := d.Stats()
+	if st.Stream != "jobs" || st.Ingested != 5 || st.IngestRate <= 0 || st.IngestCapacity != 64 || st.Dropped != 0 {
+		fmt.Printf("Stats %#v\n", st)
+		t.Fail()
+	}
+	// Once measured, the rate only changes when it's measured again, however
+	// often it's read
+	d.measure()
+	rate := d.Stats().IngestRate
+	time.Sleep(10 * time.Millisecond)
+	if rate <= 0 || d.Stats().IngestRate != rate {
+		fmt.Printf("Rate %v, then %v\n", rate, d.Stats().IngestRate)
+		t.Fail()
+	}
+	if st.History.Entries != 6 || st.History.MaxEntries != 64 || st.History.Bytes <= 0 {
+		fmt.Printf("History %#v\n", st.History)
+		t.Fail()
+	}
+	if len(st.Selectors) != 2 {
+		t.Fatalf("Selectors %#v", st.Selectors)
+	}
+	sel := st.Selectors[0]
+	if sel.ID != s.id || sel.Query != "Prefix(test)" || sel.Queue != 2 || sel.Capacity != 2 || sel.Delivered != 2 || sel.Dropped != 3 {
+		fmt.Printf("Selector %#v\n", sel)
+		t.Fail()
+	}
+	if sel := st.Selectors[1]; sel.ID != other.id || sel.Delivered != 0 || sel.Queue != 0 {
+		fmt.Printf("Other selector %#v\n", sel)
+		t.Fail()
+	}
+
+	other.Stop()
+	if n := len(d.Stats().Selectors); n != 1 {
+		fmt.Printf("%d selectors after stopping one\n", n)
+		t.Fail()
+	}
+	// The totals include selectors which are gone
+	s.Stop()
+	if st := d.Stats(); st.Delivered != 2 || st.SelectorDropped != 3 {
+		fmt.Printf("Delivered %d, dropped %d\n", st.Delivered, st.SelectorDropped)
+		t.Fail()
+	}
+}
@@ -0,0 +1,82 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * webhook.go: Alert notifier posting to a URL
+ */
+
+package dispatcher
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// Webhook is a Notifier which posts each alert, encoded as JSON, to a URL
+type Webhook struct {
+	URL string
+	// Added to each request, for authorization, say
+	Header http.Header
+	// Used to make the requests, or webhookClient if nil
+	Client *http.Client
+}
+
+// webhookClient makes the requests of webhooks without a client of their own,
+// giving up after ten seconds
+var webhookClient = &http.Client{Timeout: 10 * time.Second}
+
+// NewWebhook returns a Webhook posting to url, which gives up on a request
+// after ten seconds
+func NewWebhook(url string) *Webhook {
+	return &Webhook{URL: url, Header: http.Header{}, Client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Notify posts the alert, returning an error unless the reply is a success
+func (w *Webhook) Notify(a Alert) error {
+	body, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range w.Header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := w.Client
+	if client == nil {
+		client = webhookClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook %s replied %s", w.URL, resp.Status)
+	}
+	return nil
+}
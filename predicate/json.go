@@ -0,0 +1,370 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * json.go: JSON encoding of the predicate tree
+ */
+
+package predicate
+
+// The JSON form of a predicate is one object per BoolOp, keyed by the name of
+// the operation:
+//
+//     {"and": [op, op, ...]}          OpAnd (at least two operands)
+//     {"or": [op, op, ...]}           OpOr (at least two operands)
+//     {"not": op}                     OpNot
+//     {"prefix": "db"}                OpPrefix
+//     {"hasfield": "user"}            OpHasField
+//     {"contains": "timeout"}         OpContains
+//     {"match": {"field": "prefix", "pattern": "db.*"}}
+//                                     OpMatch
+//     {"eq": [val, val]}              OpEquals, and likewise "ne", "gt",
+//                                     "ge", "lt" and "le"
+//     true, false                     OpTrue, OpFalse
+//
+// Values are JSON literals (numbers, strings, booleans and null), or
+//
+//     {"field": "status"}             OpField
+//     {"level": "error"}              LogLevel
+//     {"entry": "level"}              OpLevel
+//
+// So, for example, {"and":[{"eq":[{"field":"status"},500]},{"prefix":"db"}]}.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"strconv"
+	"strings"
+)
+
+// JSONError is a validation error in a JSON predicate. Path locates the
+// offending node, e.g. $.and[1].eq[0]
+type JSONError struct {
+	Path string
+	Message string
+}
+func (e *JSONError) Error() string {
+	return fmt.Sprintf("json: %s: %s", e.Path, e.Message)
+}
+
+// FromJSON decodes a JSON predicate, as described at the top of json.go
+func FromJSON(data []byte) (BoolOp, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("json: %s", err.Error())
+	}
+	if dec.More() {
+		return nil, &JSONError{"$", "unexpected data after predicate"}
+	}
+	return boolOpFromJSON(v, "$")
+}
+
+// ToJSON encodes a predicate in the form read by FromJSON
+func ToJSON(op BoolOp) ([]byte, error) {
+	v, err := boolOpToJSON(op)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+var jsonComparisons = map[string]func(l, r Valueable) BoolOp {
+	"eq": func(l, r Valueable) BoolOp { return OpEquals{l, r} },
+	"ne": func(l, r Valueable) BoolOp { return OpNot{OpEquals{l, r}} },
+	"gt": func(l, r Valueable) BoolOp { return OpGreater{l, r} },
+	"ge": func(l, r Valueable) BoolOp { return OpOr{OpEquals{l, r}, OpGreater{l, r}} },
+	"lt": func(l, r Valueable) BoolOp { return OpLess{l, r} },
+	"le": func(l, r Valueable) BoolOp { return OpOr{OpEquals{l, r}, OpLess{l, r}} },
+}
+
+func boolOpFromJSON(v interface{}, path string) (BoolOp, error) {
+	switch t := v.(type) {
+	case bool:
+		if t {
+			return OpTrue{}, nil
+		}
+		return OpFalse{}, nil
+	case map[string]interface{}:
+	default:
+		return nil, &JSONError{path, "expected an operation object or a boolean"}
+	}
+	obj := v.(map[string]interface{})
+	if len(obj) != 1 {
+		return nil, &JSONError{path, fmt.Sprintf("expected exactly one operation, got %d keys", len(obj))}
+	}
+	for name, arg := range obj {
+		p := path + "." + name
+		switch name {
+		case "and", "or":
+			arr, ok := arg.([]interface{})
+			if !ok || len(arr) < 2 {
+				return nil, &JSONError{p, "expected an array of at least two operations"}
+			}
+			ops := make([]BoolOp, len(arr))
+			for i, a := range arr {
+				op, err := boolOpFromJSON(a, fmt.Sprintf("%s[%d]", p, i))
+				if err != nil {
+					return nil, err
+				}
+				ops[i] = op
+			}
+			// Right associative, to match the tree the select grammar builds
+			curr := ops[len(ops) - 1]
+			for i := len(ops) - 2; i >= 0; i -= 1 {
+				if name == "and" {
+					curr = OpAnd{ops[i], curr}
+				} else {
+					curr = OpOr{ops[i], curr}
+				}
+			}
+			return curr, nil
+		case "not":
+			inner, err := boolOpFromJSON(arg, p)
+			if err != nil {
+				return nil, err
+			}
+			return OpNot{inner}, nil
+		case "prefix", "hasfield", "contains":
+			str, ok := arg.(string)
+			if !ok {
+				return nil, &JSONError{p, "expected a string"}
+			}
+			switch name {
+			case "prefix":
+				return OpPrefix{str}, nil
+			case "hasfield":
+				return OpHasField{str}, nil
+			default:
+				return OpContains{str}, nil
+			}
+		case "match":
+			m, ok := arg.(map[string]interface{})
+			if !ok {
+				return nil, &JSONError{p, "expected an object with field and pattern"}
+			}
+			field, ok := m["field"].(string)
+			if !ok {
+				return nil, &JSONError{p + ".field", "expected a string"}
+			}
+			pattern, ok := m["pattern"].(string)
+			if !ok {
+				return nil, &JSONError{p + ".pattern", "expected a string"}
+			}
+			if len(m) != 2 {
+				return nil, &JSONError{p, "unexpected keys, expected only field and pattern"}
+			}
+			return OpMatch{field, pattern}, nil
+		default:
+			cmp, ok := jsonComparisons[name]
+			if !ok {
+				return nil, &JSONError{p, "unknown operation"}
+			}
+			arr, ok := arg.([]interface{})
+			if !ok || len(arr) != 2 {
+				return nil, &JSONError{p, "expected an array of two values"}
+			}
+			l, err := valueFromJSON(arr[0], p + "[0]")
+			if err != nil {
+				return nil, err
+			}
+			r, err := valueFromJSON(arr[1], p + "[1]")
+			if err != nil {
+				return nil, err
+			}
+			return cmp(l, r), nil
+		}
+	}
+	panic("unreachable")
+}
+
+func valueFromJSON(v interface{}, path string) (Valueable, error) {
+	switch t := v.(type) {
+	case nil:
+		return Val{typ: ValTypeNil}, nil
+	case bool:
+		return Val{typ: ValTypeBool, bl: t}, nil
+	case string:
+		return Val{typ: ValTypeString, str: t}, nil
+	case json.Number:
+		if itg, err := strconv.ParseInt(string(t), 10, 64); err == nil {
+			return Val{typ: ValTypeInt, itg: itg}, nil
+		}
+		flt, err := strconv.ParseFloat(string(t), 64)
+		if err != nil {
+			return nil, &JSONError{path, "number out of range"}
+		}
+		return Val{typ: ValTypeFloat, flt: flt}, nil
+	case map[string]interface{}:
+		if len(t) != 1 {
+			return nil, &JSONError{path, fmt.Sprintf("expected exactly one key, got %d", len(t))}
+		}
+		for name, arg := range t {
+			p := path + "." + name
+			str, ok := arg.(string)
+			if !ok {
+				return nil, &JSONError{p, "expected a string"}
+			}
+			switch name {
+			case "field":
+				return OpField{str}, nil
+			case "level":
+				lvl, err := newLogLevel(str)
+				if err != nil {
+					return nil, &JSONError{p, fmt.Sprintf("invalid level %q", str)}
+				}
+				return lvl.(Valueable), nil
+			case "entry":
+				if strings.ToLower(str) != "level" {
+					return nil, &JSONError{p, fmt.Sprintf("unknown entry attribute %q", str)}
+				}
+				return OpLevel{}, nil
+			default:
+				return nil, &JSONError{p, "unknown value"}
+			}
+		}
+	}
+	return nil, &JSONError{path, "expected a literal or a value object"}
+}
+
+func boolOpToJSON(op BoolOp) (interface{}, error) {
+	switch o := op.(type) {
+	case OpTrue:
+		return true, nil
+	case OpFalse:
+		return false, nil
+	case OpAnd:
+		return binaryToJSON("and", o.left, o.right)
+	case OpOr:
+		return binaryToJSON("or", o.left, o.right)
+	case OpNot:
+		inner, err := boolOpToJSON(o.inner)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"not": inner}, nil
+	case OpPrefix:
+		return map[string]interface{}{"prefix": o.prefix}, nil
+	case OpHasField:
+		return map[string]interface{}{"hasfield": o.field}, nil
+	case OpContains:
+		return map[string]interface{}{"contains": o.text}, nil
+	case OpMatch:
+		return map[string]interface{}{"match": map[string]string{"field": o.field, "pattern": o.pattern}}, nil
+	case OpEquals:
+		return comparisonToJSON("eq", o.left, o.right)
+	case OpGreater:
+		return comparisonToJSON("gt", o.left, o.right)
+	case OpLess:
+		return comparisonToJSON("lt", o.left, o.right)
+	default:
+		return nil, fmt.Errorf("json: unable to encode %T", op)
+	}
+}
+
+// binaryToJSON flattens right-nested chains of the same operation back into
+// a single array, which is the tree FromJSON builds
+func binaryToJSON(name string, left, right BoolOp) (interface{}, error) {
+	arr := make([]interface{}, 0, 2)
+chain:
+	for {
+		l, err := boolOpToJSON(left)
+		if err != nil {
+			return nil, err
+		}
+		arr = append(arr, l)
+		switch r := right.(type) {
+		case OpAnd:
+			if name != "and" {
+				break chain
+			}
+			left, right = r.left, r.right
+		case OpOr:
+			if name != "or" {
+				break chain
+			}
+			left, right = r.left, r.right
+		default:
+			break chain
+		}
+	}
+	r, err := boolOpToJSON(right)
+	if err != nil {
+		return nil, err
+	}
+	arr = append(arr, r)
+	return map[string]interface{}{name: arr}, nil
+}
+
+func comparisonToJSON(name string, left, right Valueable) (interface{}, error) {
+	l, err := valueToJSON(left)
+	if err != nil {
+		return nil, err
+	}
+	r, err := valueToJSON(right)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{name: []interface{}{l, r}}, nil
+}
+
+func valueToJSON(v Valueable) (interface{}, error) {
+	switch t := v.(type) {
+	case Val:
+		switch t.typ {
+		case ValTypeString:
+			return t.str, nil
+		case ValTypeInt:
+			return t.itg, nil
+		case ValTypeFloat:
+			// Keep a decimal point, so that the value decodes as a float again
+			s := strconv.FormatFloat(t.flt, 'g', -1, 64)
+			if !strings.ContainsAny(s, ".eEnN") {
+				s += ".0"
+			}
+			return json.Number(s), nil
+		case ValTypeBool:
+			return t.bl, nil
+		default:
+			return nil, nil
+		}
+	case OpField:
+		return map[string]string{"field": t.name}, nil
+	case LogLevel:
+		lvl, err := levelName(t.v)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]string{"level": lvl}, nil
+	case OpLevel:
+		return map[string]string{"entry": "level"}, nil
+	default:
+		return nil, fmt.Errorf("json: unable to encode %T", v)
+	}
+}
+
+func levelName(v int64) (string, error) {
+	b, err := logrus.Level(v).MarshalText()
+	if err != nil {
+		return "", fmt.Errorf("json: %s", err.Error())
+	}
+	return string(b), nil
+}
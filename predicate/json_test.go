@@ -0,0 +1,163 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * json_test.go: Test of the JSON predicate encoding
+ */
+
+package predicate
+
+import (
+	"testing"
+	"fmt"
+	"github.com/sirupsen/logrus"
+)
+
+func TestFromJSON(t *testing.T) {
+	var tests = []tst{
+		{"true", true, OpTrue{}},
+		{"false", true, OpFalse{}},
+		{`{"prefix":"db"}`, true, OpPrefix{"db"}},
+		{`{"hasfield":"user"}`, true, OpHasField{"user"}},
+		{`{"contains":"timeout"}`, true, OpContains{"timeout"}},
+		{`{"match":{"field":"prefix","pattern":"db.*"}}`, true, OpMatch{"prefix", "db.*"}},
+		{`{"not":{"prefix":"db"}}`, true, OpNot{OpPrefix{"db"}}},
+		{`{"and":[{"eq":[{"field":"status"},500]},{"prefix":"db"}]}`, true, OpAnd{OpEquals{OpField{"status"}, Val{typ: ValTypeInt, itg: 500}}, OpPrefix{"db"}}},
+		{`{"or":[{"prefix":"a"},{"prefix":"b"},{"prefix":"c"}]}`, true, OpOr{OpPrefix{"a"}, OpOr{OpPrefix{"b"}, OpPrefix{"c"}}}},
+		{`{"eq":[{"field":"ratio"},1.0]}`, true, OpEquals{OpField{"ratio"}, Val{typ: ValTypeFloat, flt: 1.0}}},
+		{`{"eq":[{"field":"ok"},true]}`, true, OpEquals{OpField{"ok"}, Val{typ: ValTypeBool, bl: true}}},
+		{`{"eq":[{"field":"user"},null]}`, true, OpEquals{OpField{"user"}, Val{typ: ValTypeNil}}},
+		{`{"eq":[{"field":"user"},"bob"]}`, true, OpEquals{OpField{"user"}, Val{typ: ValTypeString, str: "bob"}}},
+		{`{"ne":[{"field":"user"},"bob"]}`, true, OpNot{OpEquals{OpField{"user"}, Val{typ: ValTypeString, str: "bob"}}}},
+		{`{"ge":[{"entry":"level"},{"level":"error"}]}`, true, OpOr{OpEquals{OpLevel{}, LogLevel{int64(logrus.ErrorLevel)}}, OpGreater{OpLevel{}, LogLevel{int64(logrus.ErrorLevel)}}}},
+		{`{"lt":[{"field":"status"},500]}`, true, OpLess{OpField{"status"}, Val{typ: ValTypeInt, itg: 500}}},
+		{`{"le":[{"field":"status"},500]}`, true, OpOr{OpEquals{OpField{"status"}, Val{typ: ValTypeInt, itg: 500}}, OpLess{OpField{"status"}, Val{typ: ValTypeInt, itg: 500}}}},
+		{`{"gt":[{"field":"status"},500]}`, true, OpGreater{OpField{"status"}, Val{typ: ValTypeInt, itg: 500}}},
+		{`{`, false, nil},
+		{`{"prefix":"a"} {}`, false, nil},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.input, func(t *testing.T) {
+			op, err := FromJSON([]byte(test.input))
+			if err != nil && test.success {
+				fmt.Printf("Error: %s\n", err.Error())
+				t.Fail()
+				return
+			} else if err == nil && !test.success {
+				fmt.Printf("Expecting an error and got none\n")
+				t.Fail()
+				return
+			} else if err != nil {
+				return
+			}
+			if op != test.output {
+				fmt.Printf("Value mismatch expected %#v but got %#v\n", test.output, op)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestFromJSON_Path(t *testing.T) {
+	var tests = []struct {
+		input, path string
+	}{
+		{`[]`, "$"},
+		{`{"prefix":"a","hasfield":"b"}`, "$"},
+		{`{"bogus":1}`, "$.bogus"},
+		{`{"and":[{"prefix":"a"}]}`, "$.and"},
+		{`{"and":[{"prefix":"a"},{"or":[{"prefix":"b"},{"eq":[1]}]}]}`, "$.and[1].or[1].eq"},
+		{`{"and":[{"prefix":"a"},{"eq":[{"field":"a"},{"level":"loud"}]}]}`, "$.and[1].eq[1].level"},
+		{`{"eq":[{"entry":"message"},1]}`, "$.eq[0].entry"},
+		{`{"eq":[[1],1]}`, "$.eq[0]"},
+		{`{"not":{"prefix":1}}`, "$.not.prefix"},
+		{`{"match":{"field":"a"}}`, "$.match.pattern"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.input, func(t *testing.T) {
+			_, err := FromJSON([]byte(test.input))
+			jerr, ok := err.(*JSONError)
+			if !ok {
+				fmt.Printf("Expected a JSONError, got %#v\n", err)
+				t.Fail()
+				return
+			}
+			if jerr.Path != test.path {
+				fmt.Printf("Expected path %s, got %s\n", test.path, jerr.Error())
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestToJSON_RoundTrip(t *testing.T) {
+	var tests = []string{
+		"",
+		"Prefix(hello) && HasField('world') && HasField(\"worker\")",
+		"!(Prefix(hello) || HasField('world'))",
+		"(Prefix(a) || Prefix(b)) && Prefix(c)",
+		"Field('hello') >= 'world'",
+		"Field('ratio') == 1.0",
+		"Field('ratio') != 2.5",
+		"level <= warn && Field(ok) == true && Field(x) == nil",
+	}
+
+	for _, input := range tests {
+		input := input
+		t.Run(input, func(t *testing.T) {
+			op, err := Compile(SyntaxSelect, input)
+			if err != nil {
+				fmt.Printf("Error: %s\n", err.Error())
+				t.Fail()
+				return
+			}
+			data, err := ToJSON(op)
+			if err != nil {
+				fmt.Printf("Error: %s\n", err.Error())
+				t.Fail()
+				return
+			}
+			back, err := FromJSON(data)
+			if err != nil {
+				fmt.Printf("Error decoding %s: %s\n", data, err.Error())
+				t.Fail()
+				return
+			}
+			if back != op {
+				fmt.Printf("Round trip mismatch %#v -> %s -> %#v\n", op, data, back)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestToJSON(t *testing.T) {
+	op := OpAnd{OpEquals{OpField{"status"}, Val{typ: ValTypeInt, itg: 500}}, OpPrefix{"db"}}
+	data, err := ToJSON(op)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"and":[{"eq":[{"field":"status"},500]},{"prefix":"db"}]}` {
+		fmt.Printf("Got %s\n", data)
+		t.Fail()
+	}
+}
@@ -0,0 +1,114 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * levels.go: Static analysis of the levels a predicate can match
+ */
+
+package predicate
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// LevelSet is a set of logrus levels, one bit per level
+type LevelSet uint32
+
+// AllLevels is the set of every level in logrus.AllLevels
+var AllLevels = NewLevelSet(logrus.AllLevels...)
+
+func NewLevelSet(levels ...logrus.Level) (s LevelSet) {
+	for _, l := range levels {
+		s |= 1 << uint(l)
+	}
+	return
+}
+func (s LevelSet) Has(l logrus.Level) bool {
+	return s & (1 << uint(l)) != 0
+}
+func (s LevelSet) Levels() []logrus.Level {
+	ret := make([]logrus.Level, 0, len(logrus.AllLevels))
+	for _, l := range logrus.AllLevels {
+		if s.Has(l) {
+			ret = append(ret, l)
+		}
+	}
+	return ret
+}
+
+// LevelsOf returns the levels of the entries which op could possibly match.
+// The answer is conservative: when the level can't be determined from the
+// predicate alone, every level is included.
+func LevelsOf(op BoolOp) LevelSet {
+	may, _ := levelSets(op)
+	return may
+}
+
+// levelSets returns the levels for which op may be true, and the levels for
+// which it must be true whatever the rest of the entry holds. Both are needed
+// so that negation stays precise: !op may be true wherever op need not be.
+func levelSets(op BoolOp) (may, must LevelSet) {
+	switch o := op.(type) {
+	case OpTrue:
+		return AllLevels, AllLevels
+	case OpFalse:
+		return 0, 0
+	case OpAnd:
+		lmay, lmust := levelSets(o.left)
+		rmay, rmust := levelSets(o.right)
+		return lmay & rmay, lmust & rmust
+	case OpOr:
+		lmay, lmust := levelSets(o.left)
+		rmay, rmust := levelSets(o.right)
+		return lmay | rmay, lmust | rmust
+	case OpNot:
+		imay, imust := levelSets(o.inner)
+		return AllLevels &^ imust, AllLevels &^ imay
+	case OpEquals:
+		return comparisonLevels(op, o.left, o.right)
+	case OpGreater:
+		return comparisonLevels(op, o.left, o.right)
+	case OpLess:
+		return comparisonLevels(op, o.left, o.right)
+	default:
+		return AllLevels, 0
+	}
+}
+
+// comparisonLevels works out the levels for a comparison by trying it against
+// an entry at each level, when nothing but the level could affect the result
+func comparisonLevels(op BoolOp, left, right Valueable) (may, must LevelSet) {
+	if !levelOnly(left) || !levelOnly(right) {
+		return AllLevels, 0
+	}
+	for _, l := range logrus.AllLevels {
+		if op.True(&logrus.Entry{Level: l}) {
+			may |= NewLevelSet(l)
+		}
+	}
+	return may, may
+}
+
+// levelOnly reports whether a value depends on nothing but the entry's level
+func levelOnly(v Valueable) bool {
+	switch v.(type) {
+	case Val, LogLevel, OpLevel:
+		return true
+	}
+	return false
+}
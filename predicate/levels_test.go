@@ -0,0 +1,79 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * levels_test.go: Test of predicate level analysis
+ */
+
+package predicate
+
+import (
+	"testing"
+	"fmt"
+	"github.com/sirupsen/logrus"
+)
+
+func TestLevelsOf(t *testing.T) {
+	errorUp := NewLevelSet(logrus.PanicLevel, logrus.FatalLevel, logrus.ErrorLevel)
+	var tests = []struct {
+		input string
+		output LevelSet
+	}{
+		{"", AllLevels},
+		{"Prefix(db)", AllLevels},
+		{"1 == 0", 0},
+		{"level == error", NewLevelSet(logrus.ErrorLevel)},
+		{"level >= error", errorUp},
+		{"level > warn && Prefix(db)", errorUp},
+		{"level >= error || level == debug", errorUp | NewLevelSet(logrus.DebugLevel)},
+		{"level >= error || Prefix(db)", AllLevels},
+		{"!(level < info)", AllLevels &^ NewLevelSet(logrus.DebugLevel, logrus.TraceLevel)},
+		{"!(level < info && Prefix(db))", AllLevels},
+		{"!(level < info || Prefix(db))", AllLevels &^ NewLevelSet(logrus.DebugLevel, logrus.TraceLevel)},
+		{"level == Field(level)", AllLevels},
+		{"level == error && level == warn", 0},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.input, func(t *testing.T) {
+			op, err := Compile(SyntaxSelect, test.input)
+			if err != nil {
+				fmt.Printf("Error: %s\n", err.Error())
+				t.Fail()
+				return
+			}
+			if out := LevelsOf(op); out != test.output {
+				fmt.Printf("Expected %v but got %v\n", test.output.Levels(), out.Levels())
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestLevelSet_Levels(t *testing.T) {
+	s := NewLevelSet(logrus.WarnLevel, logrus.PanicLevel)
+	l := s.Levels()
+	if len(l) != 2 || l[0] != logrus.PanicLevel || l[1] != logrus.WarnLevel {
+		fmt.Printf("Got %v\n", l)
+		t.Fail()
+	}
+	if len(AllLevels.Levels()) != len(logrus.AllLevels) {
+		t.Fail()
+	}
+}
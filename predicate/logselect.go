@@ -103,40 +103,40 @@ var g = &grammar{
 		},
 		{
 			name: "CompareOp",
-			pos:  position{line: 53, col: 1, offset: 1764},
+			pos:  position{line: 56, col: 1, offset: 1970},
 			expr: &actionExpr{
-				pos: position{line: 53, col: 13, offset: 1778},
+				pos: position{line: 56, col: 13, offset: 1984},
 				run: (*parser).callonCompareOp1,
 				expr: &choiceExpr{
-					pos: position{line: 53, col: 14, offset: 1779},
+					pos: position{line: 56, col: 14, offset: 1985},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 53, col: 14, offset: 1779},
+							pos:        position{line: 56, col: 14, offset: 1985},
 							val:        "==",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 53, col: 21, offset: 1786},
+							pos:        position{line: 56, col: 21, offset: 1992},
 							val:        "!=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 53, col: 28, offset: 1793},
+							pos:        position{line: 56, col: 28, offset: 1999},
 							val:        ">=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 53, col: 35, offset: 1800},
+							pos:        position{line: 56, col: 35, offset: 2006},
 							val:        "<=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 53, col: 42, offset: 1807},
+							pos:        position{line: 56, col: 42, offset: 2013},
 							val:        ">",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 53, col: 48, offset: 1813},
+							pos:        position{line: 56, col: 48, offset: 2019},
 							val:        "<",
 							ignoreCase: false,
 						},
@@ -146,44 +146,44 @@ var g = &grammar{
 		},
 		{
 			name: "BoolOr",
-			pos:  position{line: 59, col: 1, offset: 1944},
+			pos:  position{line: 62, col: 1, offset: 2150},
 			expr: &actionExpr{
-				pos: position{line: 59, col: 10, offset: 1955},
+				pos: position{line: 62, col: 10, offset: 2161},
 				run: (*parser).callonBoolOr1,
 				expr: &seqExpr{
-					pos: position{line: 59, col: 10, offset: 1955},
+					pos: position{line: 62, col: 10, offset: 2161},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 59, col: 10, offset: 1955},
+							pos:   position{line: 62, col: 10, offset: 2161},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 59, col: 15, offset: 1960},
+								pos:  position{line: 62, col: 15, offset: 2166},
 								name: "BoolAnd",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 59, col: 23, offset: 1968},
+							pos:   position{line: 62, col: 23, offset: 2174},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 59, col: 28, offset: 1973},
+								pos: position{line: 62, col: 28, offset: 2179},
 								expr: &seqExpr{
-									pos: position{line: 59, col: 29, offset: 1974},
+									pos: position{line: 62, col: 29, offset: 2180},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 59, col: 29, offset: 1974},
+											pos:  position{line: 62, col: 29, offset: 2180},
 											name: "Whitespace",
 										},
 										&litMatcher{
-											pos:        position{line: 59, col: 40, offset: 1985},
+											pos:        position{line: 62, col: 40, offset: 2191},
 											val:        "||",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 59, col: 45, offset: 1990},
+											pos:  position{line: 62, col: 45, offset: 2196},
 											name: "Whitespace",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 59, col: 56, offset: 2001},
+											pos:  position{line: 62, col: 56, offset: 2207},
 											name: "BoolAnd",
 										},
 									},
@@ -196,47 +196,47 @@ var g = &grammar{
 		},
 		{
 			name: "BoolAnd",
-			pos:  position{line: 83, col: 1, offset: 2802},
+			pos:  position{line: 86, col: 1, offset: 3008},
 			expr: &actionExpr{
-				pos: position{line: 83, col: 11, offset: 2814},
+				pos: position{line: 86, col: 11, offset: 3020},
 				run: (*parser).callonBoolAnd1,
 				expr: &seqExpr{
-					pos: position{line: 83, col: 11, offset: 2814},
+					pos: position{line: 86, col: 11, offset: 3020},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 83, col: 11, offset: 2814},
+							pos:   position{line: 86, col: 11, offset: 3020},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 83, col: 16, offset: 2819},
+								pos:  position{line: 86, col: 16, offset: 3025},
 								name: "Factor",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 83, col: 23, offset: 2826},
+							pos:   position{line: 86, col: 23, offset: 3032},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 83, col: 28, offset: 2831},
+								pos: position{line: 86, col: 28, offset: 3037},
 								expr: &seqExpr{
-									pos: position{line: 83, col: 29, offset: 2832},
+									pos: position{line: 86, col: 29, offset: 3038},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 83, col: 29, offset: 2832},
+											pos:  position{line: 86, col: 29, offset: 3038},
 											name: "Whitespace",
 										},
 										&litMatcher{
-											pos:        position{line: 83, col: 40, offset: 2843},
+											pos:        position{line: 86, col: 40, offset: 3049},
 											val:        "&&",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 83, col: 45, offset: 2848},
+											pos:  position{line: 86, col: 45, offset: 3054},
 											name: "Whitespace",
 										},
 										&labeledExpr{
-											pos:   position{line: 83, col: 56, offset: 2859},
+											pos:   position{line: 86, col: 56, offset: 3065},
 											label: "right",
 											expr: &ruleRefExpr{
-												pos:  position{line: 83, col: 62, offset: 2865},
+												pos:  position{line: 86, col: 62, offset: 3071},
 												name: "Factor",
 											},
 										},
@@ -250,23 +250,23 @@ var g = &grammar{
 		},
 		{
 			name: "BoolNot",
-			pos:  position{line: 106, col: 1, offset: 3582},
+			pos:  position{line: 109, col: 1, offset: 3788},
 			expr: &actionExpr{
-				pos: position{line: 106, col: 11, offset: 3594},
+				pos: position{line: 109, col: 11, offset: 3800},
 				run: (*parser).callonBoolNot1,
 				expr: &seqExpr{
-					pos: position{line: 106, col: 11, offset: 3594},
+					pos: position{line: 109, col: 11, offset: 3800},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 106, col: 11, offset: 3594},
+							pos:        position{line: 109, col: 11, offset: 3800},
 							val:        "!",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 106, col: 15, offset: 3598},
+							pos:   position{line: 109, col: 15, offset: 3804},
 							label: "fct",
 							expr: &ruleRefExpr{
-								pos:  position{line: 106, col: 19, offset: 3602},
+								pos:  position{line: 109, col: 19, offset: 3808},
 								name: "Factor",
 							},
 						},
@@ -276,31 +276,31 @@ var g = &grammar{
 		},
 		{
 			name: "Factor",
-			pos:  position{line: 110, col: 1, offset: 3738},
+			pos:  position{line: 113, col: 1, offset: 3944},
 			expr: &choiceExpr{
-				pos: position{line: 110, col: 10, offset: 3749},
+				pos: position{line: 113, col: 10, offset: 3955},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 110, col: 10, offset: 3749},
+						pos: position{line: 113, col: 10, offset: 3955},
 						run: (*parser).callonFactor2,
 						expr: &seqExpr{
-							pos: position{line: 110, col: 10, offset: 3749},
+							pos: position{line: 113, col: 10, offset: 3955},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 110, col: 10, offset: 3749},
+									pos:        position{line: 113, col: 10, offset: 3955},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 110, col: 14, offset: 3753},
+									pos:   position{line: 113, col: 14, offset: 3959},
 									label: "val",
 									expr: &ruleRefExpr{
-										pos:  position{line: 110, col: 18, offset: 3757},
+										pos:  position{line: 113, col: 18, offset: 3963},
 										name: "Bool",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 110, col: 23, offset: 3762},
+									pos:        position{line: 113, col: 23, offset: 3968},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -308,15 +308,15 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 112, col: 5, offset: 3803},
+						pos:  position{line: 115, col: 5, offset: 4009},
 						name: "OpBool",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 112, col: 14, offset: 3812},
+						pos:  position{line: 115, col: 14, offset: 4018},
 						name: "BoolNot",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 112, col: 24, offset: 3822},
+						pos:  position{line: 115, col: 24, offset: 4028},
 						name: "Comparison",
 					},
 				},
@@ -324,41 +324,41 @@ var g = &grammar{
 		},
 		{
 			name: "OpVal",
-			pos:  position{line: 115, col: 1, offset: 3838},
+			pos:  position{line: 118, col: 1, offset: 4044},
 			expr: &actionExpr{
-				pos: position{line: 115, col: 9, offset: 3848},
+				pos: position{line: 118, col: 9, offset: 4054},
 				run: (*parser).callonOpVal1,
 				expr: &seqExpr{
-					pos: position{line: 115, col: 9, offset: 3848},
+					pos: position{line: 118, col: 9, offset: 4054},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 115, col: 9, offset: 3848},
+							pos:  position{line: 118, col: 9, offset: 4054},
 							name: "OpNameField",
 						},
 						&litMatcher{
-							pos:        position{line: 115, col: 21, offset: 3860},
+							pos:        position{line: 118, col: 21, offset: 4066},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 115, col: 25, offset: 3864},
+							pos:   position{line: 118, col: 25, offset: 4070},
 							label: "idt",
 							expr: &choiceExpr{
-								pos: position{line: 115, col: 30, offset: 3869},
+								pos: position{line: 118, col: 30, offset: 4075},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 115, col: 30, offset: 3869},
+										pos:  position{line: 118, col: 30, offset: 4075},
 										name: "Ident",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 115, col: 38, offset: 3877},
+										pos:  position{line: 118, col: 38, offset: 4083},
 										name: "String",
 									},
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 115, col: 46, offset: 3885},
+							pos:        position{line: 118, col: 46, offset: 4091},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -368,54 +368,54 @@ var g = &grammar{
 		},
 		{
 			name: "OpBool",
-			pos:  position{line: 120, col: 1, offset: 4082},
+			pos:  position{line: 123, col: 1, offset: 4288},
 			expr: &actionExpr{
-				pos: position{line: 120, col: 10, offset: 4093},
+				pos: position{line: 123, col: 10, offset: 4299},
 				run: (*parser).callonOpBool1,
 				expr: &seqExpr{
-					pos: position{line: 120, col: 10, offset: 4093},
+					pos: position{line: 123, col: 10, offset: 4299},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 120, col: 10, offset: 4093},
+							pos:   position{line: 123, col: 10, offset: 4299},
 							label: "nme",
 							expr: &choiceExpr{
-								pos: position{line: 120, col: 15, offset: 4098},
+								pos: position{line: 123, col: 15, offset: 4304},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 120, col: 15, offset: 4098},
+										pos:  position{line: 123, col: 15, offset: 4304},
 										name: "OpNamePrefix",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 120, col: 30, offset: 4113},
+										pos:  position{line: 123, col: 30, offset: 4319},
 										name: "OpNameHasField",
 									},
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 120, col: 46, offset: 4129},
+							pos:        position{line: 123, col: 46, offset: 4335},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 120, col: 50, offset: 4133},
+							pos:   position{line: 123, col: 50, offset: 4339},
 							label: "idt",
 							expr: &choiceExpr{
-								pos: position{line: 120, col: 55, offset: 4138},
+								pos: position{line: 123, col: 55, offset: 4344},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 120, col: 55, offset: 4138},
+										pos:  position{line: 123, col: 55, offset: 4344},
 										name: "Ident",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 120, col: 63, offset: 4146},
+										pos:  position{line: 123, col: 63, offset: 4352},
 										name: "String",
 									},
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 120, col: 71, offset: 4154},
+							pos:        position{line: 123, col: 71, offset: 4360},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -425,12 +425,12 @@ var g = &grammar{
 		},
 		{
 			name: "OpNameHasField",
-			pos:  position{line: 133, col: 1, offset: 4554},
+			pos:  position{line: 136, col: 1, offset: 4760},
 			expr: &actionExpr{
-				pos: position{line: 133, col: 18, offset: 4573},
+				pos: position{line: 136, col: 18, offset: 4779},
 				run: (*parser).callonOpNameHasField1,
 				expr: &litMatcher{
-					pos:        position{line: 133, col: 18, offset: 4573},
+					pos:        position{line: 136, col: 18, offset: 4779},
 					val:        "hasfield",
 					ignoreCase: true,
 				},
@@ -438,12 +438,12 @@ var g = &grammar{
 		},
 		{
 			name: "OpNamePrefix",
-			pos:  position{line: 136, col: 1, offset: 4619},
+			pos:  position{line: 139, col: 1, offset: 4825},
 			expr: &actionExpr{
-				pos: position{line: 136, col: 16, offset: 4636},
+				pos: position{line: 139, col: 16, offset: 4842},
 				run: (*parser).callonOpNamePrefix1,
 				expr: &litMatcher{
-					pos:        position{line: 136, col: 16, offset: 4636},
+					pos:        position{line: 139, col: 16, offset: 4842},
 					val:        "prefix",
 					ignoreCase: true,
 				},
@@ -451,12 +451,12 @@ var g = &grammar{
 		},
 		{
 			name: "OpNameField",
-			pos:  position{line: 139, col: 1, offset: 4678},
+			pos:  position{line: 142, col: 1, offset: 4884},
 			expr: &actionExpr{
-				pos: position{line: 139, col: 15, offset: 4694},
+				pos: position{line: 142, col: 15, offset: 4900},
 				run: (*parser).callonOpNameField1,
 				expr: &litMatcher{
-					pos:        position{line: 139, col: 15, offset: 4694},
+					pos:        position{line: 142, col: 15, offset: 4900},
 					val:        "field",
 					ignoreCase: true,
 				},
@@ -464,28 +464,32 @@ var g = &grammar{
 		},
 		{
 			name: "Value",
-			pos:  position{line: 145, col: 1, offset: 4856},
+			pos:  position{line: 148, col: 1, offset: 5062},
 			expr: &choiceExpr{
-				pos: position{line: 145, col: 9, offset: 4866},
+				pos: position{line: 148, col: 9, offset: 5072},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 145, col: 9, offset: 4866},
+						pos:  position{line: 148, col: 9, offset: 5072},
 						name: "OpVal",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 145, col: 17, offset: 4874},
+						pos:  position{line: 148, col: 17, offset: 5080},
 						name: "NumberVal",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 145, col: 29, offset: 4886},
+						pos:  position{line: 148, col: 29, offset: 5092},
 						name: "LogLevel",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 145, col: 40, offset: 4897},
+						pos:  position{line: 148, col: 40, offset: 5103},
 						name: "LiteralVal",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 145, col: 53, offset: 4910},
+						pos:  position{line: 148, col: 53, offset: 5116},
+						name: "EntryLevel",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 148, col: 66, offset: 5129},
 						name: "StringVal",
 					},
 				},
@@ -493,24 +497,24 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralVal",
-			pos:  position{line: 148, col: 1, offset: 4951},
+			pos:  position{line: 151, col: 1, offset: 5170},
 			expr: &choiceExpr{
-				pos: position{line: 148, col: 14, offset: 4966},
+				pos: position{line: 151, col: 14, offset: 5185},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 148, col: 14, offset: 4966},
+						pos:  position{line: 151, col: 14, offset: 5185},
 						name: "LVTrue",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 148, col: 23, offset: 4975},
+						pos:  position{line: 151, col: 23, offset: 5194},
 						name: "LVFalse",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 148, col: 33, offset: 4985},
+						pos:  position{line: 151, col: 33, offset: 5204},
 						name: "LVNull",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 148, col: 42, offset: 4994},
+						pos:  position{line: 151, col: 42, offset: 5213},
 						name: "LVNil",
 					},
 				},
@@ -518,12 +522,12 @@ var g = &grammar{
 		},
 		{
 			name: "LVTrue",
-			pos:  position{line: 149, col: 1, offset: 5001},
+			pos:  position{line: 152, col: 1, offset: 5220},
 			expr: &actionExpr{
-				pos: position{line: 149, col: 10, offset: 5012},
+				pos: position{line: 152, col: 10, offset: 5231},
 				run: (*parser).callonLVTrue1,
 				expr: &litMatcher{
-					pos:        position{line: 149, col: 10, offset: 5012},
+					pos:        position{line: 152, col: 10, offset: 5231},
 					val:        "true",
 					ignoreCase: true,
 				},
@@ -531,12 +535,12 @@ var g = &grammar{
 		},
 		{
 			name: "LVFalse",
-			pos:  position{line: 152, col: 1, offset: 5075},
+			pos:  position{line: 155, col: 1, offset: 5294},
 			expr: &actionExpr{
-				pos: position{line: 152, col: 11, offset: 5087},
+				pos: position{line: 155, col: 11, offset: 5306},
 				run: (*parser).callonLVFalse1,
 				expr: &litMatcher{
-					pos:        position{line: 152, col: 11, offset: 5087},
+					pos:        position{line: 155, col: 11, offset: 5306},
 					val:        "false",
 					ignoreCase: true,
 				},
@@ -544,12 +548,12 @@ var g = &grammar{
 		},
 		{
 			name: "LVNull",
-			pos:  position{line: 155, col: 1, offset: 5152},
+			pos:  position{line: 158, col: 1, offset: 5371},
 			expr: &actionExpr{
-				pos: position{line: 155, col: 10, offset: 5163},
+				pos: position{line: 158, col: 10, offset: 5382},
 				run: (*parser).callonLVNull1,
 				expr: &litMatcher{
-					pos:        position{line: 155, col: 10, offset: 5163},
+					pos:        position{line: 158, col: 10, offset: 5382},
 					val:        "null",
 					ignoreCase: true,
 				},
@@ -557,12 +561,12 @@ var g = &grammar{
 		},
 		{
 			name: "LVNil",
-			pos:  position{line: 158, col: 1, offset: 5215},
+			pos:  position{line: 161, col: 1, offset: 5434},
 			expr: &actionExpr{
-				pos: position{line: 158, col: 9, offset: 5225},
+				pos: position{line: 161, col: 9, offset: 5444},
 				run: (*parser).callonLVNil1,
 				expr: &litMatcher{
-					pos:        position{line: 158, col: 9, offset: 5225},
+					pos:        position{line: 161, col: 9, offset: 5444},
 					val:        "nil",
 					ignoreCase: true,
 				},
@@ -570,45 +574,45 @@ var g = &grammar{
 		},
 		{
 			name: "LogLevel",
-			pos:  position{line: 165, col: 1, offset: 5456},
+			pos:  position{line: 168, col: 1, offset: 5675},
 			expr: &actionExpr{
-				pos: position{line: 165, col: 12, offset: 5469},
+				pos: position{line: 168, col: 12, offset: 5688},
 				run: (*parser).callonLogLevel1,
 				expr: &choiceExpr{
-					pos: position{line: 165, col: 13, offset: 5470},
+					pos: position{line: 168, col: 13, offset: 5689},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 165, col: 13, offset: 5470},
+							pos:        position{line: 168, col: 13, offset: 5689},
 							val:        "panic",
 							ignoreCase: true,
 						},
 						&litMatcher{
-							pos:        position{line: 165, col: 24, offset: 5481},
+							pos:        position{line: 168, col: 24, offset: 5700},
 							val:        "fatal",
 							ignoreCase: true,
 						},
 						&litMatcher{
-							pos:        position{line: 165, col: 35, offset: 5492},
+							pos:        position{line: 168, col: 35, offset: 5711},
 							val:        "error",
 							ignoreCase: true,
 						},
 						&litMatcher{
-							pos:        position{line: 165, col: 46, offset: 5503},
+							pos:        position{line: 168, col: 46, offset: 5722},
 							val:        "warn",
 							ignoreCase: true,
 						},
 						&litMatcher{
-							pos:        position{line: 165, col: 56, offset: 5513},
+							pos:        position{line: 168, col: 56, offset: 5732},
 							val:        "warning",
 							ignoreCase: true,
 						},
 						&litMatcher{
-							pos:        position{line: 165, col: 69, offset: 5526},
+							pos:        position{line: 168, col: 69, offset: 5745},
 							val:        "info",
 							ignoreCase: true,
 						},
 						&litMatcher{
-							pos:        position{line: 165, col: 79, offset: 5536},
+							pos:        position{line: 168, col: 79, offset: 5755},
 							val:        "debug",
 							ignoreCase: true,
 						},
@@ -616,24 +620,53 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "EntryLevel",
+			pos:  position{line: 175, col: 1, offset: 5984},
+			expr: &actionExpr{
+				pos: position{line: 175, col: 14, offset: 5999},
+				run: (*parser).callonEntryLevel1,
+				expr: &seqExpr{
+					pos: position{line: 175, col: 14, offset: 5999},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 175, col: 14, offset: 5999},
+							val:        "level",
+							ignoreCase: true,
+						},
+						&notExpr{
+							pos: position{line: 175, col: 23, offset: 6008},
+							expr: &charClassMatcher{
+								pos:        position{line: 175, col: 24, offset: 6009},
+								val:        "[a-zA-Z0-9-_]",
+								chars:      []rune{'-', '_'},
+								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "StringVal",
-			pos:  position{line: 171, col: 1, offset: 5675},
+			pos:  position{line: 180, col: 1, offset: 6116},
 			expr: &actionExpr{
-				pos: position{line: 171, col: 13, offset: 5689},
+				pos: position{line: 180, col: 13, offset: 6130},
 				run: (*parser).callonStringVal1,
 				expr: &labeledExpr{
-					pos:   position{line: 171, col: 13, offset: 5689},
+					pos:   position{line: 180, col: 13, offset: 6130},
 					label: "str",
 					expr: &choiceExpr{
-						pos: position{line: 171, col: 18, offset: 5694},
+						pos: position{line: 180, col: 18, offset: 6135},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 171, col: 18, offset: 5694},
+								pos:  position{line: 180, col: 18, offset: 6135},
 								name: "Ident",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 171, col: 26, offset: 5702},
+								pos:  position{line: 180, col: 26, offset: 6143},
 								name: "String",
 							},
 						},
@@ -643,24 +676,24 @@ var g = &grammar{
 		},
 		{
 			name: "Ident",
-			pos:  position{line: 177, col: 1, offset: 5899},
+			pos:  position{line: 186, col: 1, offset: 6340},
 			expr: &actionExpr{
-				pos: position{line: 177, col: 9, offset: 5909},
+				pos: position{line: 186, col: 9, offset: 6350},
 				run: (*parser).callonIdent1,
 				expr: &seqExpr{
-					pos: position{line: 177, col: 9, offset: 5909},
+					pos: position{line: 186, col: 9, offset: 6350},
 					exprs: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 177, col: 9, offset: 5909},
+							pos:        position{line: 186, col: 9, offset: 6350},
 							val:        "[a-zA-Z]",
 							ranges:     []rune{'a', 'z', 'A', 'Z'},
 							ignoreCase: false,
 							inverted:   false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 177, col: 17, offset: 5917},
+							pos: position{line: 186, col: 17, offset: 6358},
 							expr: &charClassMatcher{
-								pos:        position{line: 177, col: 17, offset: 5917},
+								pos:        position{line: 186, col: 17, offset: 6358},
 								val:        "[a-zA-Z0-9-_]",
 								chars:      []rune{'-', '_'},
 								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -674,16 +707,16 @@ var g = &grammar{
 		},
 		{
 			name: "String",
-			pos:  position{line: 183, col: 1, offset: 6066},
+			pos:  position{line: 192, col: 1, offset: 6507},
 			expr: &choiceExpr{
-				pos: position{line: 183, col: 10, offset: 6077},
+				pos: position{line: 192, col: 10, offset: 6518},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 183, col: 10, offset: 6077},
+						pos:  position{line: 192, col: 10, offset: 6518},
 						name: "DoubleString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 183, col: 25, offset: 6092},
+						pos:  position{line: 192, col: 25, offset: 6533},
 						name: "SingleString",
 					},
 				},
@@ -691,50 +724,50 @@ var g = &grammar{
 		},
 		{
 			name: "SingleString",
-			pos:  position{line: 184, col: 1, offset: 6106},
+			pos:  position{line: 193, col: 1, offset: 6547},
 			expr: &actionExpr{
-				pos: position{line: 184, col: 16, offset: 6123},
+				pos: position{line: 193, col: 16, offset: 6564},
 				run: (*parser).callonSingleString1,
 				expr: &seqExpr{
-					pos: position{line: 184, col: 16, offset: 6123},
+					pos: position{line: 193, col: 16, offset: 6564},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 184, col: 16, offset: 6123},
+							pos:  position{line: 193, col: 16, offset: 6564},
 							name: "SingleQuote",
 						},
 						&labeledExpr{
-							pos:   position{line: 184, col: 28, offset: 6135},
+							pos:   position{line: 193, col: 28, offset: 6576},
 							label: "chr",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 184, col: 32, offset: 6139},
+								pos: position{line: 193, col: 32, offset: 6580},
 								expr: &choiceExpr{
-									pos: position{line: 184, col: 34, offset: 6141},
+									pos: position{line: 193, col: 34, offset: 6582},
 									alternatives: []interface{}{
 										&seqExpr{
-											pos: position{line: 184, col: 34, offset: 6141},
+											pos: position{line: 193, col: 34, offset: 6582},
 											exprs: []interface{}{
 												&notExpr{
-													pos: position{line: 184, col: 34, offset: 6141},
+													pos: position{line: 193, col: 34, offset: 6582},
 													expr: &ruleRefExpr{
-														pos:  position{line: 184, col: 35, offset: 6142},
+														pos:  position{line: 193, col: 35, offset: 6583},
 														name: "SingleEscapedChar",
 													},
 												},
 												&anyMatcher{
-													line: 184, col: 53, offset: 6160,
+													line: 193, col: 53, offset: 6601,
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 184, col: 57, offset: 6164},
+											pos: position{line: 193, col: 57, offset: 6605},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 184, col: 57, offset: 6164},
+													pos:        position{line: 193, col: 57, offset: 6605},
 													val:        "\\",
 													ignoreCase: false,
 												},
 												&ruleRefExpr{
-													pos:  position{line: 184, col: 62, offset: 6169},
+													pos:  position{line: 193, col: 62, offset: 6610},
 													name: "SingleEscapeSequence",
 												},
 											},
@@ -744,7 +777,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 184, col: 86, offset: 6193},
+							pos:  position{line: 193, col: 86, offset: 6634},
 							name: "SingleQuote",
 						},
 					},
@@ -753,9 +786,9 @@ var g = &grammar{
 		},
 		{
 			name: "SingleEscapedChar",
-			pos:  position{line: 188, col: 1, offset: 6283},
+			pos:  position{line: 197, col: 1, offset: 6724},
 			expr: &charClassMatcher{
-				pos:        position{line: 188, col: 21, offset: 6305},
+				pos:        position{line: 197, col: 21, offset: 6746},
 				val:        "[\\x00-\\x1f'\\\\]",
 				chars:      []rune{'\'', '\\'},
 				ranges:     []rune{'\x00', '\x1f'},
@@ -765,16 +798,16 @@ var g = &grammar{
 		},
 		{
 			name: "SingleEscapeSequence",
-			pos:  position{line: 189, col: 1, offset: 6321},
+			pos:  position{line: 198, col: 1, offset: 6762},
 			expr: &choiceExpr{
-				pos: position{line: 189, col: 24, offset: 6346},
+				pos: position{line: 198, col: 24, offset: 6787},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 189, col: 24, offset: 6346},
+						pos:  position{line: 198, col: 24, offset: 6787},
 						name: "SingleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 189, col: 43, offset: 6365},
+						pos:  position{line: 198, col: 43, offset: 6806},
 						name: "UnicodeEscape",
 					},
 				},
@@ -782,9 +815,9 @@ var g = &grammar{
 		},
 		{
 			name: "SingleCharEscape",
-			pos:  position{line: 190, col: 1, offset: 6380},
+			pos:  position{line: 199, col: 1, offset: 6821},
 			expr: &charClassMatcher{
-				pos:        position{line: 190, col: 20, offset: 6401},
+				pos:        position{line: 199, col: 20, offset: 6842},
 				val:        "['bfnrt]",
 				chars:      []rune{'\'', 'b', 'f', 'n', 'r', 't'},
 				ignoreCase: false,
@@ -793,56 +826,56 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuote",
-			pos:  position{line: 191, col: 1, offset: 6411},
+			pos:  position{line: 200, col: 1, offset: 6852},
 			expr: &litMatcher{
-				pos:        position{line: 191, col: 15, offset: 6427},
+				pos:        position{line: 200, col: 15, offset: 6868},
 				val:        "'",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "DoubleString",
-			pos:  position{line: 192, col: 1, offset: 6432},
+			pos:  position{line: 201, col: 1, offset: 6873},
 			expr: &actionExpr{
-				pos: position{line: 192, col: 16, offset: 6449},
+				pos: position{line: 201, col: 16, offset: 6890},
 				run: (*parser).callonDoubleString1,
 				expr: &seqExpr{
-					pos: position{line: 192, col: 16, offset: 6449},
+					pos: position{line: 201, col: 16, offset: 6890},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 192, col: 16, offset: 6449},
+							pos:  position{line: 201, col: 16, offset: 6890},
 							name: "DoubleQuote",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 192, col: 28, offset: 6461},
+							pos: position{line: 201, col: 28, offset: 6902},
 							expr: &choiceExpr{
-								pos: position{line: 192, col: 30, offset: 6463},
+								pos: position{line: 201, col: 30, offset: 6904},
 								alternatives: []interface{}{
 									&seqExpr{
-										pos: position{line: 192, col: 30, offset: 6463},
+										pos: position{line: 201, col: 30, offset: 6904},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 192, col: 30, offset: 6463},
+												pos: position{line: 201, col: 30, offset: 6904},
 												expr: &ruleRefExpr{
-													pos:  position{line: 192, col: 31, offset: 6464},
+													pos:  position{line: 201, col: 31, offset: 6905},
 													name: "DoubleEscapedChar",
 												},
 											},
 											&anyMatcher{
-												line: 192, col: 49, offset: 6482,
+												line: 201, col: 49, offset: 6923,
 											},
 										},
 									},
 									&seqExpr{
-										pos: position{line: 192, col: 53, offset: 6486},
+										pos: position{line: 201, col: 53, offset: 6927},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 192, col: 53, offset: 6486},
+												pos:        position{line: 201, col: 53, offset: 6927},
 												val:        "\\",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 192, col: 58, offset: 6491},
+												pos:  position{line: 201, col: 58, offset: 6932},
 												name: "DoubleEscapeSequence",
 											},
 										},
@@ -851,7 +884,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 192, col: 82, offset: 6515},
+							pos:  position{line: 201, col: 82, offset: 6956},
 							name: "DoubleQuote",
 						},
 					},
@@ -860,9 +893,9 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleEscapedChar",
-			pos:  position{line: 195, col: 1, offset: 6572},
+			pos:  position{line: 204, col: 1, offset: 7013},
 			expr: &charClassMatcher{
-				pos:        position{line: 195, col: 21, offset: 6594},
+				pos:        position{line: 204, col: 21, offset: 7035},
 				val:        "[\\x00-\\x1f\"\\\\]",
 				chars:      []rune{'"', '\\'},
 				ranges:     []rune{'\x00', '\x1f'},
@@ -872,16 +905,16 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleEscapeSequence",
-			pos:  position{line: 196, col: 1, offset: 6610},
+			pos:  position{line: 205, col: 1, offset: 7051},
 			expr: &choiceExpr{
-				pos: position{line: 196, col: 24, offset: 6635},
+				pos: position{line: 205, col: 24, offset: 7076},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 196, col: 24, offset: 6635},
+						pos:  position{line: 205, col: 24, offset: 7076},
 						name: "DoubleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 196, col: 43, offset: 6654},
+						pos:  position{line: 205, col: 43, offset: 7095},
 						name: "UnicodeEscape",
 					},
 				},
@@ -889,9 +922,9 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleCharEscape",
-			pos:  position{line: 197, col: 1, offset: 6669},
+			pos:  position{line: 206, col: 1, offset: 7110},
 			expr: &charClassMatcher{
-				pos:        position{line: 197, col: 20, offset: 6690},
+				pos:        position{line: 206, col: 20, offset: 7131},
 				val:        "[\"bfnrt]",
 				chars:      []rune{'"', 'b', 'f', 'n', 'r', 't'},
 				ignoreCase: false,
@@ -900,23 +933,23 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuote",
-			pos:  position{line: 198, col: 1, offset: 6700},
+			pos:  position{line: 207, col: 1, offset: 7141},
 			expr: &litMatcher{
-				pos:        position{line: 198, col: 15, offset: 6716},
+				pos:        position{line: 207, col: 15, offset: 7157},
 				val:        "\"",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "Whitespace",
-			pos:  position{line: 199, col: 1, offset: 6722},
+			pos:  position{line: 208, col: 1, offset: 7163},
 			expr: &actionExpr{
-				pos: position{line: 199, col: 14, offset: 6737},
+				pos: position{line: 208, col: 14, offset: 7178},
 				run: (*parser).callonWhitespace1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 199, col: 14, offset: 6737},
+					pos: position{line: 208, col: 14, offset: 7178},
 					expr: &charClassMatcher{
-						pos:        position{line: 199, col: 14, offset: 6737},
+						pos:        position{line: 208, col: 14, offset: 7178},
 						val:        "[\\t\\n\\v\\f\\r ]",
 						chars:      []rune{'\t', '\n', '\v', '\f', '\r', ' '},
 						ignoreCase: false,
@@ -927,29 +960,29 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeEscape",
-			pos:  position{line: 202, col: 1, offset: 6779},
+			pos:  position{line: 211, col: 1, offset: 7220},
 			expr: &seqExpr{
-				pos: position{line: 202, col: 17, offset: 6797},
+				pos: position{line: 211, col: 17, offset: 7238},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 202, col: 17, offset: 6797},
+						pos:        position{line: 211, col: 17, offset: 7238},
 						val:        "u",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 202, col: 21, offset: 6801},
+						pos:  position{line: 211, col: 21, offset: 7242},
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 202, col: 30, offset: 6810},
+						pos:  position{line: 211, col: 30, offset: 7251},
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 202, col: 39, offset: 6819},
+						pos:  position{line: 211, col: 39, offset: 7260},
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 202, col: 48, offset: 6828},
+						pos:  position{line: 211, col: 48, offset: 7269},
 						name: "HexDigit",
 					},
 				},
@@ -957,9 +990,9 @@ var g = &grammar{
 		},
 		{
 			name: "HexDigit",
-			pos:  position{line: 203, col: 1, offset: 6838},
+			pos:  position{line: 212, col: 1, offset: 7279},
 			expr: &charClassMatcher{
-				pos:        position{line: 203, col: 12, offset: 6851},
+				pos:        position{line: 212, col: 12, offset: 7292},
 				val:        "[0-9a-f]i",
 				ranges:     []rune{'0', '9', 'a', 'f'},
 				ignoreCase: true,
@@ -968,20 +1001,20 @@ var g = &grammar{
 		},
 		{
 			name: "NumberVal",
-			pos:  position{line: 207, col: 1, offset: 6969},
+			pos:  position{line: 216, col: 1, offset: 7410},
 			expr: &choiceExpr{
-				pos: position{line: 207, col: 13, offset: 6983},
+				pos: position{line: 216, col: 13, offset: 7424},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 207, col: 13, offset: 6983},
+						pos:  position{line: 216, col: 13, offset: 7424},
 						name: "ZeroErr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 207, col: 23, offset: 6993},
+						pos:  position{line: 216, col: 23, offset: 7434},
 						name: "FloatVal",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 207, col: 34, offset: 7004},
+						pos:  position{line: 216, col: 34, offset: 7445},
 						name: "IntegerVal",
 					},
 				},
@@ -989,15 +1022,15 @@ var g = &grammar{
 		},
 		{
 			name: "FloatVal",
-			pos:  position{line: 208, col: 1, offset: 7016},
+			pos:  position{line: 217, col: 1, offset: 7457},
 			expr: &actionExpr{
-				pos: position{line: 208, col: 12, offset: 7029},
+				pos: position{line: 217, col: 12, offset: 7470},
 				run: (*parser).callonFloatVal1,
 				expr: &labeledExpr{
-					pos:   position{line: 208, col: 12, offset: 7029},
+					pos:   position{line: 217, col: 12, offset: 7470},
 					label: "flt",
 					expr: &ruleRefExpr{
-						pos:  position{line: 208, col: 16, offset: 7033},
+						pos:  position{line: 217, col: 16, offset: 7474},
 						name: "Float",
 					},
 				},
@@ -1005,22 +1038,22 @@ var g = &grammar{
 		},
 		{
 			name: "IntegerVal",
-			pos:  position{line: 211, col: 1, offset: 7105},
+			pos:  position{line: 220, col: 1, offset: 7546},
 			expr: &actionExpr{
-				pos: position{line: 211, col: 14, offset: 7120},
+				pos: position{line: 220, col: 14, offset: 7561},
 				run: (*parser).callonIntegerVal1,
 				expr: &labeledExpr{
-					pos:   position{line: 211, col: 14, offset: 7120},
+					pos:   position{line: 220, col: 14, offset: 7561},
 					label: "itg",
 					expr: &choiceExpr{
-						pos: position{line: 211, col: 19, offset: 7125},
+						pos: position{line: 220, col: 19, offset: 7566},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 211, col: 19, offset: 7125},
+								pos:  position{line: 220, col: 19, offset: 7566},
 								name: "Integer",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 211, col: 29, offset: 7135},
+								pos:  position{line: 220, col: 29, offset: 7576},
 								name: "ZeroVal",
 							},
 						},
@@ -1030,24 +1063,24 @@ var g = &grammar{
 		},
 		{
 			name: "Number",
-			pos:  position{line: 217, col: 1, offset: 7287},
+			pos:  position{line: 226, col: 1, offset: 7728},
 			expr: &choiceExpr{
-				pos: position{line: 217, col: 10, offset: 7298},
+				pos: position{line: 226, col: 10, offset: 7739},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 217, col: 10, offset: 7298},
+						pos:  position{line: 226, col: 10, offset: 7739},
 						name: "ZeroErr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 217, col: 20, offset: 7308},
+						pos:  position{line: 226, col: 20, offset: 7749},
 						name: "Float",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 217, col: 28, offset: 7316},
+						pos:  position{line: 226, col: 28, offset: 7757},
 						name: "Integer",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 217, col: 38, offset: 7326},
+						pos:  position{line: 226, col: 38, offset: 7767},
 						name: "ZeroVal",
 					},
 				},
@@ -1055,22 +1088,22 @@ var g = &grammar{
 		},
 		{
 			name: "Float",
-			pos:  position{line: 218, col: 1, offset: 7335},
+			pos:  position{line: 227, col: 1, offset: 7776},
 			expr: &actionExpr{
-				pos: position{line: 218, col: 9, offset: 7345},
+				pos: position{line: 227, col: 9, offset: 7786},
 				run: (*parser).callonFloat1,
 				expr: &seqExpr{
-					pos: position{line: 218, col: 9, offset: 7345},
+					pos: position{line: 227, col: 9, offset: 7786},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 218, col: 9, offset: 7345},
+							pos: position{line: 227, col: 9, offset: 7786},
 							expr: &ruleRefExpr{
-								pos:  position{line: 218, col: 9, offset: 7345},
+								pos:  position{line: 227, col: 9, offset: 7786},
 								name: "Neg",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 218, col: 14, offset: 7350},
+							pos:  position{line: 227, col: 14, offset: 7791},
 							name: "Flt",
 						},
 					},
@@ -1079,22 +1112,22 @@ var g = &grammar{
 		},
 		{
 			name: "Integer",
-			pos:  position{line: 222, col: 1, offset: 7461},
+			pos:  position{line: 231, col: 1, offset: 7902},
 			expr: &actionExpr{
-				pos: position{line: 222, col: 11, offset: 7473},
+				pos: position{line: 231, col: 11, offset: 7914},
 				run: (*parser).callonInteger1,
 				expr: &seqExpr{
-					pos: position{line: 222, col: 11, offset: 7473},
+					pos: position{line: 231, col: 11, offset: 7914},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 222, col: 11, offset: 7473},
+							pos: position{line: 231, col: 11, offset: 7914},
 							expr: &ruleRefExpr{
-								pos:  position{line: 222, col: 11, offset: 7473},
+								pos:  position{line: 231, col: 11, offset: 7914},
 								name: "Neg",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 222, col: 16, offset: 7478},
+							pos:  position{line: 231, col: 16, offset: 7919},
 							name: "Int",
 						},
 					},
@@ -1103,86 +1136,86 @@ var g = &grammar{
 		},
 		{
 			name: "Flt",
-			pos:  position{line: 226, col: 1, offset: 7580},
+			pos:  position{line: 235, col: 1, offset: 8021},
 			expr: &choiceExpr{
-				pos: position{line: 226, col: 7, offset: 7588},
+				pos: position{line: 235, col: 7, offset: 8029},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 226, col: 7, offset: 7588},
+						pos: position{line: 235, col: 7, offset: 8029},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 226, col: 7, offset: 7588},
+								pos:  position{line: 235, col: 7, offset: 8029},
 								name: "Int",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 226, col: 11, offset: 7592},
+								pos:  position{line: 235, col: 11, offset: 8033},
 								name: "Dot",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 226, col: 15, offset: 7596},
+								pos:  position{line: 235, col: 15, offset: 8037},
 								name: "Int",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 226, col: 21, offset: 7602},
+						pos: position{line: 235, col: 21, offset: 8043},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 226, col: 21, offset: 7602},
+								pos:  position{line: 235, col: 21, offset: 8043},
 								name: "Int",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 226, col: 25, offset: 7606},
+								pos:  position{line: 235, col: 25, offset: 8047},
 								name: "Dot",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 226, col: 29, offset: 7610},
+								pos:  position{line: 235, col: 29, offset: 8051},
 								name: "ZeroStr",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 226, col: 39, offset: 7620},
+						pos: position{line: 235, col: 39, offset: 8061},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 226, col: 39, offset: 7620},
+								pos:  position{line: 235, col: 39, offset: 8061},
 								name: "ZeroStr",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 226, col: 47, offset: 7628},
+								pos:  position{line: 235, col: 47, offset: 8069},
 								name: "Dot",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 226, col: 51, offset: 7632},
+								pos:  position{line: 235, col: 51, offset: 8073},
 								name: "Int",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 226, col: 57, offset: 7638},
+						pos: position{line: 235, col: 57, offset: 8079},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 226, col: 57, offset: 7638},
+								pos:  position{line: 235, col: 57, offset: 8079},
 								name: "ZeroStr",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 226, col: 65, offset: 7646},
+								pos:  position{line: 235, col: 65, offset: 8087},
 								name: "Dot",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 226, col: 71, offset: 7652},
+						pos: position{line: 235, col: 71, offset: 8093},
 						run: (*parser).callonFlt17,
 						expr: &seqExpr{
-							pos: position{line: 226, col: 71, offset: 7652},
+							pos: position{line: 235, col: 71, offset: 8093},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 226, col: 71, offset: 7652},
+									pos:  position{line: 235, col: 71, offset: 8093},
 									name: "Int",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 226, col: 75, offset: 7656},
+									pos:  position{line: 235, col: 75, offset: 8097},
 									name: "Dot",
 								},
 							},
@@ -1193,24 +1226,24 @@ var g = &grammar{
 		},
 		{
 			name: "Int",
-			pos:  position{line: 230, col: 1, offset: 7752},
+			pos:  position{line: 239, col: 1, offset: 8193},
 			expr: &actionExpr{
-				pos: position{line: 230, col: 7, offset: 7760},
+				pos: position{line: 239, col: 7, offset: 8201},
 				run: (*parser).callonInt1,
 				expr: &seqExpr{
-					pos: position{line: 230, col: 7, offset: 7760},
+					pos: position{line: 239, col: 7, offset: 8201},
 					exprs: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 230, col: 7, offset: 7760},
+							pos:        position{line: 239, col: 7, offset: 8201},
 							val:        "[1-9]",
 							ranges:     []rune{'1', '9'},
 							ignoreCase: false,
 							inverted:   false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 230, col: 12, offset: 7765},
+							pos: position{line: 239, col: 12, offset: 8206},
 							expr: &charClassMatcher{
-								pos:        position{line: 230, col: 12, offset: 7765},
+								pos:        position{line: 239, col: 12, offset: 8206},
 								val:        "[0-9]",
 								ranges:     []rune{'0', '9'},
 								ignoreCase: false,
@@ -1223,12 +1256,12 @@ var g = &grammar{
 		},
 		{
 			name: "ZeroStr",
-			pos:  position{line: 234, col: 1, offset: 7837},
+			pos:  position{line: 243, col: 1, offset: 8278},
 			expr: &actionExpr{
-				pos: position{line: 234, col: 11, offset: 7849},
+				pos: position{line: 243, col: 11, offset: 8290},
 				run: (*parser).callonZeroStr1,
 				expr: &litMatcher{
-					pos:        position{line: 234, col: 11, offset: 7849},
+					pos:        position{line: 243, col: 11, offset: 8290},
 					val:        "0",
 					ignoreCase: false,
 				},
@@ -1236,12 +1269,12 @@ var g = &grammar{
 		},
 		{
 			name: "ZeroVal",
-			pos:  position{line: 237, col: 1, offset: 7880},
+			pos:  position{line: 246, col: 1, offset: 8321},
 			expr: &actionExpr{
-				pos: position{line: 237, col: 11, offset: 7892},
+				pos: position{line: 246, col: 11, offset: 8333},
 				run: (*parser).callonZeroVal1,
 				expr: &litMatcher{
-					pos:        position{line: 237, col: 11, offset: 7892},
+					pos:        position{line: 246, col: 11, offset: 8333},
 					val:        "0",
 					ignoreCase: false,
 				},
@@ -1249,22 +1282,22 @@ var g = &grammar{
 		},
 		{
 			name: "ZeroErr",
-			pos:  position{line: 240, col: 1, offset: 7928},
+			pos:  position{line: 249, col: 1, offset: 8369},
 			expr: &actionExpr{
-				pos: position{line: 240, col: 11, offset: 7940},
+				pos: position{line: 249, col: 11, offset: 8381},
 				run: (*parser).callonZeroErr1,
 				expr: &seqExpr{
-					pos: position{line: 240, col: 11, offset: 7940},
+					pos: position{line: 249, col: 11, offset: 8381},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 240, col: 11, offset: 7940},
+							pos: position{line: 249, col: 11, offset: 8381},
 							expr: &ruleRefExpr{
-								pos:  position{line: 240, col: 11, offset: 7940},
+								pos:  position{line: 249, col: 11, offset: 8381},
 								name: "Neg",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 240, col: 16, offset: 7945},
+							pos:        position{line: 249, col: 16, offset: 8386},
 							val:        "0.0",
 							ignoreCase: false,
 						},
@@ -1274,30 +1307,30 @@ var g = &grammar{
 		},
 		{
 			name: "Dot",
-			pos:  position{line: 243, col: 1, offset: 8000},
+			pos:  position{line: 252, col: 1, offset: 8441},
 			expr: &litMatcher{
-				pos:        position{line: 243, col: 7, offset: 8008},
+				pos:        position{line: 252, col: 7, offset: 8449},
 				val:        ".",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "Neg",
-			pos:  position{line: 244, col: 1, offset: 8013},
+			pos:  position{line: 253, col: 1, offset: 8454},
 			expr: &litMatcher{
-				pos:        position{line: 244, col: 7, offset: 8021},
+				pos:        position{line: 253, col: 7, offset: 8462},
 				val:        "-",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "EmptyString",
-			pos:  position{line: 246, col: 1, offset: 8028},
+			pos:  position{line: 255, col: 1, offset: 8469},
 			expr: &actionExpr{
-				pos: position{line: 246, col: 15, offset: 8044},
+				pos: position{line: 255, col: 15, offset: 8485},
 				run: (*parser).callonEmptyString1,
 				expr: &litMatcher{
-					pos:        position{line: 246, col: 15, offset: 8044},
+					pos:        position{line: 255, col: 15, offset: 8485},
 					val:        "",
 					ignoreCase: false,
 				},
@@ -1305,11 +1338,11 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 249, col: 1, offset: 8079},
+			pos:  position{line: 258, col: 1, offset: 8520},
 			expr: &notExpr{
-				pos: position{line: 249, col: 7, offset: 8087},
+				pos: position{line: 258, col: 7, offset: 8528},
 				expr: &anyMatcher{
-					line: 249, col: 8, offset: 8088,
+					line: 258, col: 8, offset: 8529,
 				},
 			},
 		},
@@ -1321,6 +1354,9 @@ func (c *current) onComparison1(left, op, right interface{}) (interface{}, error
 	fmt.Printf("cmp:left %s\n", left)
 	fmt.Printf("cmp:op %s\n", op)
 	fmt.Printf("cmp:right %s\n", right)
+	// level is the entry's level on the left, but still a plain string on
+	// the right, as it was before the keyword was added
+	left, right = resolveLevel(left, true), resolveLevel(right, false)
 	cmp := op.(string)
 	switch cmp {
 	case "==":
@@ -1561,6 +1597,17 @@ func (p *parser) callonLogLevel1() (interface{}, error) {
 	return p.cur.onLogLevel1()
 }
 
+func (c *current) onEntryLevel1() (interface{}, error) {
+
+	return levelKeyword{string(c.text)}, nil
+}
+
+func (p *parser) callonEntryLevel1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEntryLevel1()
+}
+
 func (c *current) onStringVal1(str interface{}) (interface{}, error) {
 
 	return Val{typ: ValTypeString, str: str.(string)}, nil
@@ -180,6 +180,9 @@ func TestParse_Value(t *testing.T) {
 		{"null", true, Val{typ: ValTypeNil}},
 		{"panic", true, LogLevel{int64(logrus.PanicLevel)}},
 		{"WARN", true, LogLevel{int64(logrus.WarnLevel)}},
+		{"level", true, levelKeyword{"level"}},
+		{"Level", true, levelKeyword{"Level"}},
+		{"levels", true, Val{typ: ValTypeString, str: "levels"}},
 	}
 
 	for _, s := range tests {
@@ -205,6 +208,14 @@ func TestParse_Comparison(t *testing.T) {
 		{"Field('hello') >= 'world'", true, OpOr{OpEquals{OpField{"hello"}, Val{typ: ValTypeString, str: "world"}},OpGreater{OpField{"hello"}, Val{typ: ValTypeString, str: "world"}}}},
 		{"Field('hello') <= 'world'", true, OpOr{OpEquals{OpField{"hello"}, Val{typ: ValTypeString, str: "world"}}, OpLess{OpField{"hello"}, Val{typ: ValTypeString, str: "world"}}}},
 		{"Field('hello')== 'world'", true, OpEquals{OpField{"hello"}, Val{typ: ValTypeString, str: "world"}}},
+		{"level >= error", true, OpOr{OpEquals{OpLevel{}, LogLevel{int64(logrus.ErrorLevel)}}, OpGreater{OpLevel{}, LogLevel{int64(logrus.ErrorLevel)}}}},
+		{"Level == warn", true, OpEquals{OpLevel{}, LogLevel{int64(logrus.WarnLevel)}}},
+		// On the right, level is still a string, as it was before the keyword
+		{"Field(kind) == level", true, OpEquals{OpField{"kind"}, Val{typ: ValTypeString, str: "level"}}},
+		{"Field(kind) != Level", true, OpNot{OpEquals{OpField{"kind"}, Val{typ: ValTypeString, str: "Level"}}}},
+		{"Field(level) == 'x'", true, OpEquals{OpField{"level"}, Val{typ: ValTypeString, str: "x"}}},
+		{"HasField(level) && Prefix(level)", true, OpAnd{OpHasField{"level"}, OpPrefix{"level"}}},
+		{"Field(levels) == levels", true, OpEquals{OpField{"levels"}, Val{typ: ValTypeString, str: "levels"}}},
 
 	}
 
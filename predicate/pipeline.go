@@ -0,0 +1,437 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * pipeline.go: Pipe stages following a predicate
+ */
+
+package predicate
+
+import (
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Query is a predicate, followed by the stages of its pipeline (if any), e.g.
+//
+//     level >= error | count by prefix
+type Query struct {
+	Predicate BoolOp
+	Stages []Stage
+}
+
+// Aggregate returns the aggregating stage of the query, or nil if the query
+// returns entries
+func (q Query) Aggregate() *Stage {
+	for i := range q.Stages {
+		if q.Stages[i].Kind.aggregates() {
+			return &q.Stages[i]
+		}
+	}
+	return nil
+}
+
+// Dedupe returns the dedupe stage of the query, or nil if it doesn't have one
+func (q Query) Dedupe() *Stage {
+	for i := range q.Stages {
+		if q.Stages[i].Kind == StageDedupe {
+			return &q.Stages[i]
+		}
+	}
+	return nil
+}
+
+type StageKind int
+const (
+	// StageCount counts matching entries: count [by key]
+	StageCount StageKind = iota
+	// StageTop counts the N most common values of a key: top N key
+	StageTop
+	// StageRate measures matching entries per second: rate 10s [by key]
+	StageRate
+	// StageFields keeps only the listed fields of each entry: fields a, b
+	StageFields
+	// StageDrop removes the listed fields from each entry: drop body
+	StageDrop
+	// StageDedupe folds repeats of an entry into it: dedupe [within 10s]
+	// [by key, ...]
+	StageDedupe
+)
+func (k StageKind) String() string {
+	switch k {
+	case StageCount:
+		return "count"
+	case StageTop:
+		return "top"
+	case StageRate:
+		return "rate"
+	case StageFields:
+		return "fields"
+	case StageDrop:
+		return "drop"
+	case StageDedupe:
+		return "dedupe"
+	default:
+		return "unknown"
+	}
+}
+func (k StageKind) aggregates() bool {
+	return k == StageCount || k == StageTop || k == StageRate
+}
+
+// Stage is one step of a pipeline. Only the fields relevant to Kind are set.
+type Stage struct {
+	Kind StageKind
+	// By is the key being grouped on, or nil to aggregate all entries together
+	By *Key
+	// N is the number of groups kept by top
+	N int
+	// Interval is the period over which rate is measured, or within which
+	// dedupe folds repeats. Without one, dedupe only folds consecutive
+	// repeats.
+	Interval time.Duration
+	// Keys are the keys which, with the message and level, make entries
+	// repeats of each other for dedupe
+	Keys []Key
+	// Fields are the field names, or glob patterns, kept by fields or
+	// removed by drop
+	Fields []string
+}
+
+// Key is something entries may be grouped by: prefix, level, message or
+// field(name)
+type Key struct {
+	name string
+	val Valueable
+}
+func (k Key) String() string {
+	return k.name
+}
+
+// Of returns the value of the key for an entry, or the empty string when the
+// entry has no such value
+func (k Key) Of(e *logrus.Entry) string {
+	if k.val == nil {
+		if e == nil {
+			return ""
+		}
+		return e.Message
+	}
+	switch k.val.(type) {
+	case OpLevel:
+		if e == nil {
+			return ""
+		}
+		return e.Level.String()
+	}
+	v := k.val.GetVal(e)
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// Project applies the query's fields and drop stages to the data of an
+// entry. The data is not modified; when fields are removed a new map is
+// returned.
+func (q Query) Project(data logrus.Fields) logrus.Fields {
+	for _, st := range q.Stages {
+		if st.Kind != StageFields && st.Kind != StageDrop {
+			continue
+		}
+		out := make(logrus.Fields, len(data))
+		for k, v := range data {
+			if st.matches(k) == (st.Kind == StageFields) {
+				out[k] = v
+			}
+		}
+		data = out
+	}
+	return data
+}
+
+// matches reports whether a field name is listed by a fields or drop stage
+func (st Stage) matches(name string) bool {
+	for _, f := range st.Fields {
+		if f == name {
+			return true
+		}
+		if ok, err := path.Match(f, name); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// CompileQuery parses an expression which may be followed by pipe stages.
+// The predicate is parsed with the given syntax. JSON predicates can not have
+// stages.
+func CompileQuery(syntax Syntax, expression string) (Query, error) {
+	q := Query{}
+	parts := []string{expression}
+	if syntax != SyntaxJSON {
+		parts = splitPipes(expression)
+	}
+	var err error
+	q.Predicate, err = Compile(syntax, parts[0])
+	if err != nil {
+		return q, err
+	}
+	for i, part := range parts[1:] {
+		st, err := parseStage(part)
+		if err != nil {
+			return q, fmt.Errorf("stage %d: %s", i + 1, err.Error())
+		}
+		if st.Kind.aggregates() && i != len(parts) - 2 {
+			return q, fmt.Errorf("stage %d: %s must be the last stage", i + 1, st.Kind)
+		}
+		if st.Kind == StageDedupe && q.Dedupe() != nil {
+			return q, fmt.Errorf("stage %d: only one dedupe stage is allowed", i + 1)
+		}
+		q.Stages = append(q.Stages, st)
+	}
+	if st := q.Aggregate(); st != nil && q.Dedupe() != nil {
+		return q, fmt.Errorf("dedupe can't be combined with %s", st.Kind)
+	}
+	return q, nil
+}
+
+// splitPipes splits an expression on each | which is not part of ||, and not
+// inside quotes or parentheses
+func splitPipes(expression string) []string {
+	parts := make([]string, 0, 1)
+	depth := 0
+	var quote byte
+	start := 0
+	for i := 0; i < len(expression); i += 1 {
+		c := expression[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i += 1
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '(':
+			depth += 1
+		case c == ')':
+			depth -= 1
+		case c == '|' && depth == 0:
+			if i + 1 < len(expression) && expression[i + 1] == '|' {
+				i += 1
+				continue
+			}
+			parts = append(parts, expression[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, expression[start:])
+}
+
+// stageWords splits a stage into words, keeping field(...) and quoted
+// strings together
+func stageWords(stage string) ([]string, error) {
+	words := make([]string, 0)
+	i := 0
+	for i < len(stage) {
+		if unicode.IsSpace(rune(stage[i])) || stage[i] == ',' {
+			i += 1
+			continue
+		}
+		start := i
+		depth := 0
+		var quote byte
+		for i < len(stage) {
+			c := stage[i]
+			if quote != 0 {
+				if c == '\\' {
+					i += 1
+				} else if c == quote {
+					quote = 0
+				}
+			} else if c == '"' || c == '\'' {
+				quote = c
+			} else if c == '(' {
+				depth += 1
+			} else if c == ')' {
+				depth -= 1
+			} else if depth == 0 && (unicode.IsSpace(rune(c)) || c == ',') {
+				break
+			}
+			i += 1
+		}
+		if quote != 0 || depth != 0 {
+			return nil, fmt.Errorf("unterminated %q", stage[start:])
+		}
+		words = append(words, stage[start:i])
+	}
+	return words, nil
+}
+
+func parseStage(stage string) (st Stage, err error) {
+	words, err := stageWords(stage)
+	if err != nil {
+		return
+	}
+	if len(words) == 0 {
+		err = fmt.Errorf("empty stage")
+		return
+	}
+	args := words[1:]
+	switch strings.ToLower(words[0]) {
+	case "count":
+		st.Kind = StageCount
+		st.By, err = parseBy(args)
+	case "top":
+		st.Kind = StageTop
+		if len(args) == 0 {
+			err = fmt.Errorf("top requires a number of groups")
+			return
+		}
+		st.N, err = strconv.Atoi(args[0])
+		if err != nil || st.N <= 0 {
+			err = fmt.Errorf("invalid number of groups %q", args[0])
+			return
+		}
+		args = args[1:]
+		if len(args) == 1 {
+			args = []string{"by", args[0]}
+		}
+		st.By, err = parseBy(args)
+		if err == nil && st.By == nil {
+			err = fmt.Errorf("top requires a key")
+		}
+	case "rate":
+		st.Kind = StageRate
+		if len(args) == 0 {
+			err = fmt.Errorf("rate requires an interval")
+			return
+		}
+		st.Interval, err = time.ParseDuration(args[0])
+		if err != nil || st.Interval <= 0 {
+			err = fmt.Errorf("invalid interval %q", args[0])
+			return
+		}
+		st.By, err = parseBy(args[1:])
+	case "dedupe":
+		st.Kind = StageDedupe
+		if len(args) > 0 && strings.ToLower(args[0]) == "within" {
+			if len(args) < 2 {
+				err = fmt.Errorf("within requires an interval")
+				return
+			}
+			st.Interval, err = time.ParseDuration(args[1])
+			if err != nil || st.Interval <= 0 {
+				err = fmt.Errorf("invalid interval %q", args[1])
+				return
+			}
+			args = args[2:]
+		}
+		if len(args) == 0 {
+			return
+		}
+		if strings.ToLower(args[0]) != "by" || len(args) < 2 {
+			err = fmt.Errorf("expected \"by key, ...\" but found %q", strings.Join(args, " "))
+			return
+		}
+		for _, a := range args[1:] {
+			var k Key
+			k, err = parseKey(a)
+			if err != nil {
+				return
+			}
+			st.Keys = append(st.Keys, k)
+		}
+	case "fields", "drop":
+		st.Kind = StageFields
+		if strings.ToLower(words[0]) == "drop" {
+			st.Kind = StageDrop
+		}
+		if len(args) == 0 {
+			err = fmt.Errorf("%s requires at least one field", st.Kind)
+			return
+		}
+		for _, a := range args {
+			if len(a) > 0 && (a[0] == '"' || a[0] == '\'') {
+				a, err = strunquote(a)
+				if err != nil {
+					err = fmt.Errorf("invalid field name %s", a)
+					return
+				}
+			}
+			if _, err = path.Match(a, ""); err != nil {
+				err = fmt.Errorf("invalid field pattern %q", a)
+				return
+			}
+			st.Fields = append(st.Fields, a)
+		}
+	default:
+		err = fmt.Errorf("unknown stage %q", words[0])
+	}
+	return
+}
+
+// parseBy parses an optional "by key" clause
+func parseBy(args []string) (*Key, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	if len(args) != 2 || strings.ToLower(args[0]) != "by" {
+		return nil, fmt.Errorf("expected \"by key\" but found %q", strings.Join(args, " "))
+	}
+	k, err := parseKey(args[1])
+	if err != nil {
+		return nil, err
+	}
+	return &k, nil
+}
+
+func parseKey(word string) (Key, error) {
+	lw := strings.ToLower(word)
+	switch {
+	case lw == "level":
+		return Key{"level", OpLevel{}}, nil
+	case lw == "message" || lw == "msg":
+		return Key{"message", nil}, nil
+	case strings.HasPrefix(lw, "field(") && strings.HasSuffix(lw, ")"):
+		name := strings.TrimSpace(word[len("field(") : len(word) - 1])
+		if len(name) > 0 && (name[0] == '"' || name[0] == '\'') {
+			var err error
+			name, err = strunquote(name)
+			if err != nil {
+				return Key{}, fmt.Errorf("invalid field name %s", word)
+			}
+		}
+		if name == "" {
+			return Key{}, fmt.Errorf("empty field name")
+		}
+		return Key{"field(" + name + ")", OpField{name}}, nil
+	case strings.ContainsAny(word, "()'\""):
+		return Key{}, fmt.Errorf("invalid key %q", word)
+	default:
+		// prefix, and any other bare name, is a field
+		return Key{word, OpField{word}}, nil
+	}
+}
@@ -0,0 +1,184 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * pipeline_test.go: Test of pipe stage parsing
+ */
+
+package predicate
+
+import (
+	"testing"
+	"fmt"
+	"reflect"
+	"time"
+	"github.com/sirupsen/logrus"
+)
+
+func TestSplitPipes(t *testing.T) {
+	var tests = []struct {
+		input string
+		output []string
+	}{
+		{"", []string{""}},
+		{"Prefix(a)", []string{"Prefix(a)"}},
+		{"Prefix(a) || Prefix(b)", []string{"Prefix(a) || Prefix(b)"}},
+		{"Prefix(a) | count", []string{"Prefix(a) ", " count"}},
+		{"Prefix('a|b') | count", []string{"Prefix('a|b') ", " count"}},
+		{"Prefix(\"a\\\"|b\") | count", []string{"Prefix(\"a\\\"|b\") ", " count"}},
+		{"a | b | c", []string{"a ", " b ", " c"}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.input, func(t *testing.T) {
+			out := splitPipes(test.input)
+			if !reflect.DeepEqual(out, test.output) {
+				fmt.Printf("Expected %#v but got %#v\n", test.output, out)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestCompileQuery(t *testing.T) {
+	errLevel := LogLevel{int64(logrus.ErrorLevel)}
+	prefix := &Key{"prefix", OpField{"prefix"}}
+	var tests = []struct {
+		syntax Syntax
+		input string
+		success bool
+		output Query
+	}{
+		{SyntaxSelect, "", true, Query{Predicate: OpTrue{}}},
+		{SyntaxSelect, "level >= error | count by prefix", true, Query{OpOr{OpEquals{OpLevel{}, errLevel}, OpGreater{OpLevel{}, errLevel}}, []Stage{{Kind: StageCount, By: prefix}}}},
+		{SyntaxSelect, "| count", true, Query{OpTrue{}, []Stage{{Kind: StageCount}}}},
+		{SyntaxSelect, "| COUNT BY level", true, Query{OpTrue{}, []Stage{{Kind: StageCount, By: &Key{"level", OpLevel{}}}}}},
+		{SyntaxSelect, "| top 10 field(path)", true, Query{OpTrue{}, []Stage{{Kind: StageTop, N: 10, By: &Key{"field(path)", OpField{"path"}}}}}},
+		{SyntaxSelect, "| top 3 by field('a b')", true, Query{OpTrue{}, []Stage{{Kind: StageTop, N: 3, By: &Key{"field(a b)", OpField{"a b"}}}}}},
+		{SyntaxSelect, "| rate 10s", true, Query{OpTrue{}, []Stage{{Kind: StageRate, Interval: 10 * time.Second}}}},
+		{SyntaxSelect, "| rate 1m by message", true, Query{OpTrue{}, []Stage{{Kind: StageRate, Interval: time.Minute, By: &Key{"message", nil}}}}},
+		{SyntaxShorthand, "level:error | count by prefix", true, Query{OpEquals{OpLevel{}, errLevel}, []Stage{{Kind: StageCount, By: prefix}}}},
+		{SyntaxSelect, "| fields prefix, user,status", true, Query{OpTrue{}, []Stage{{Kind: StageFields, Fields: []string{"prefix", "user", "status"}}}}},
+		{SyntaxSelect, "| drop body | count", true, Query{OpTrue{}, []Stage{{Kind: StageDrop, Fields: []string{"body"}}, {Kind: StageCount}}}},
+		{SyntaxSelect, "| drop 'a b' req.*", true, Query{OpTrue{}, []Stage{{Kind: StageDrop, Fields: []string{"a b", "req.*"}}}}},
+		{SyntaxSelect, "| dedupe", true, Query{OpTrue{}, []Stage{{Kind: StageDedupe}}}},
+		{SyntaxSelect, "| dedupe within 10s by prefix, field(code) | drop body", true, Query{OpTrue{}, []Stage{{Kind: StageDedupe, Interval: 10 * time.Second, Keys: []Key{*prefix, {"field(code)", OpField{"code"}}}}, {Kind: StageDrop, Fields: []string{"body"}}}}},
+		{SyntaxSelect, "| dedupe by level", true, Query{OpTrue{}, []Stage{{Kind: StageDedupe, Keys: []Key{{"level", OpLevel{}}}}}}},
+		{SyntaxSelect, "| fields", false, Query{}},
+		{SyntaxSelect, "| drop [", false, Query{}},
+		{SyntaxSelect, "| count | fields a", false, Query{}},
+		{SyntaxSelect, "| top", false, Query{}},
+		{SyntaxSelect, "| top 0 prefix", false, Query{}},
+		{SyntaxSelect, "| top 5", false, Query{}},
+		{SyntaxSelect, "| rate", false, Query{}},
+		{SyntaxSelect, "| rate -1s", false, Query{}},
+		{SyntaxSelect, "| count prefix", false, Query{}},
+		{SyntaxSelect, "| count by", false, Query{}},
+		{SyntaxSelect, "| count by field()", false, Query{}},
+		{SyntaxSelect, "| count | count", false, Query{}},
+		{SyntaxSelect, "| sum", false, Query{}},
+		{SyntaxSelect, "| dedupe within", false, Query{}},
+		{SyntaxSelect, "| dedupe within 0s", false, Query{}},
+		{SyntaxSelect, "| dedupe by", false, Query{}},
+		{SyntaxSelect, "| dedupe prefix", false, Query{}},
+		{SyntaxSelect, "| dedupe | dedupe", false, Query{}},
+		{SyntaxSelect, "| dedupe | count", false, Query{}},
+		{SyntaxSelect, "|", false, Query{}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.input, func(t *testing.T) {
+			q, err := CompileQuery(test.syntax, test.input)
+			if err != nil && test.success {
+				fmt.Printf("Error: %s\n", err.Error())
+				t.Fail()
+				return
+			} else if err == nil && !test.success {
+				fmt.Printf("Expecting an error and got none\n")
+				t.Fail()
+				return
+			} else if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(q, test.output) {
+				fmt.Printf("Expected %#v but got %#v\n", test.output, q)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestKey_Of(t *testing.T) {
+	e := (&logrus.Entry{Data: make(logrus.Fields)}).WithFields(logrus.Fields{"prefix": "db", "status": 500})
+	e.Level = logrus.WarnLevel
+	e.Message = "hello"
+
+	if k, _ := parseKey("prefix"); k.Of(e) != "db" {
+		t.Fail()
+	}
+	if k, _ := parseKey("field(status)"); k.Of(e) != "500" {
+		t.Fail()
+	}
+	if k, _ := parseKey("level"); k.Of(e) != "warning" {
+		t.Fail()
+	}
+	if k, _ := parseKey("message"); k.Of(e) != "hello" {
+		t.Fail()
+	}
+	if k, _ := parseKey("missing"); k.Of(e) != "" {
+		t.Fail()
+	}
+}
+
+func TestQuery_Project(t *testing.T) {
+	data := logrus.Fields{"prefix": "db", "user": "bob", "body": "...", "req.id": 1, "req.path": "/"}
+	var tests = []struct {
+		input string
+		output logrus.Fields
+	}{
+		{"", data},
+		{"| fields prefix, user", logrus.Fields{"prefix": "db", "user": "bob"}},
+		{"| fields prefix, missing", logrus.Fields{"prefix": "db"}},
+		{"| drop body", logrus.Fields{"prefix": "db", "user": "bob", "req.id": 1, "req.path": "/"}},
+		{"| drop body req.*", logrus.Fields{"prefix": "db", "user": "bob"}},
+		{"| fields req.* user | drop req.path", logrus.Fields{"user": "bob", "req.id": 1}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.input, func(t *testing.T) {
+			q, err := CompileQuery(SyntaxSelect, test.input)
+			if err != nil {
+				fmt.Printf("Error: %s\n", err.Error())
+				t.Fail()
+				return
+			}
+			out := q.Project(data)
+			if !reflect.DeepEqual(out, test.output) {
+				fmt.Printf("Expected %#v but got %#v\n", test.output, out)
+				t.Fail()
+			}
+		})
+	}
+	if len(data) != 5 {
+		fmt.Printf("Project modified its input\n")
+		t.Fail()
+	}
+}
@@ -0,0 +1,338 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * shorthand.go: Lucene/KQL style shorthand query parser
+ */
+
+package predicate
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ParseShorthand parses a Kibana style query, such as
+//
+//     level:error prefix:db.* status:>=500 "timeout"
+//
+// into the same BoolOp tree produced by the select grammar. Terms are joined
+// with an implicit AND, and may be combined with AND, OR, NOT (or a leading
+// -) and parentheses. A bare word or quoted string searches the message.
+func ParseShorthand(expression string) (BoolOp, error) {
+	p := &shorthandParser{input: expression}
+	p.next()
+	if p.tok.typ == shEOF {
+		return OpTrue{}, nil
+	}
+	op, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.typ != shEOF {
+		return nil, p.errorf("unexpected %s", p.tok)
+	}
+	return op, nil
+}
+
+type shTokenType int
+const (
+	shEOF shTokenType = iota
+	shWord
+	shQuoted
+	shColon
+	shOpen
+	shClose
+	shAnd
+	shOr
+	shNot
+	shCompare
+)
+
+type shToken struct {
+	typ shTokenType
+	text string
+	pos int
+}
+func (t shToken) String() string {
+	if t.typ == shEOF {
+		return "end of query"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+type shorthandParser struct {
+	input string
+	pos int
+	tok shToken
+}
+
+func (p *shorthandParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("shorthand:%d: %s", p.tok.pos + 1, fmt.Sprintf(format, args...))
+}
+
+// next advances p.tok to the following token in the input
+func (p *shorthandParser) next() {
+	// The value after a colon is never taken as a keyword (e.g. "type:and")
+	afterColon := p.tok.typ == shColon || p.tok.typ == shCompare
+	for p.pos < len(p.input) && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos += 1
+	}
+	start := p.pos
+	if p.pos >= len(p.input) {
+		p.tok = shToken{typ: shEOF, pos: start}
+		return
+	}
+	ch := p.input[p.pos]
+	switch {
+	case ch == '(':
+		p.pos += 1
+		p.tok = shToken{shOpen, "(", start}
+		return
+	case ch == ')':
+		p.pos += 1
+		p.tok = shToken{shClose, ")", start}
+		return
+	case ch == ':' && !afterColon:
+		p.pos += 1
+		p.tok = shToken{shColon, ":", start}
+		return
+	case ch == '"' || ch == '\'':
+		end := p.pos + 1
+		for end < len(p.input) && p.input[end] != ch {
+			if p.input[end] == '\\' {
+				end += 1
+			}
+			end += 1
+		}
+		if end >= len(p.input) {
+			p.tok = shToken{shQuoted, p.input[start:], start}
+			p.pos = len(p.input)
+			return
+		}
+		p.pos = end + 1
+		p.tok = shToken{shQuoted, p.input[start:p.pos], start}
+		return
+	case p.tok.typ == shColon:
+		for _, op := range []string{">=", "<=", "!=", ">", "<"} {
+			if strings.HasPrefix(p.input[p.pos:], op) {
+				p.pos += len(op)
+				p.tok = shToken{shCompare, op, start}
+				return
+			}
+		}
+	case (ch == '-' || ch == '!') && !afterColon:
+		p.pos += 1
+		p.tok = shToken{shNot, string(ch), start}
+		return
+	}
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if unicode.IsSpace(rune(c)) || c == '(' || c == ')' || (c == ':' && !afterColon) {
+			break
+		}
+		p.pos += 1
+	}
+	word := p.input[start:p.pos]
+	p.tok = shToken{shWord, word, start}
+	if afterColon || (p.pos < len(p.input) && p.input[p.pos] == ':') {
+		return
+	}
+	switch strings.ToUpper(word) {
+	case "AND", "&&":
+		p.tok.typ = shAnd
+	case "OR", "||":
+		p.tok.typ = shOr
+	case "NOT":
+		p.tok.typ = shNot
+	}
+}
+
+func (p *shorthandParser) parseOr() (BoolOp, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	ops := []BoolOp{left}
+	for p.tok.typ == shOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, right)
+	}
+	// Right associative, to match the tree the select grammar builds
+	curr := ops[len(ops) - 1]
+	for i := len(ops) - 2; i >= 0; i -= 1 {
+		curr = OpOr{ops[i], curr}
+	}
+	return curr, nil
+}
+
+func (p *shorthandParser) parseAnd() (BoolOp, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	ops := []BoolOp{left}
+	for {
+		if p.tok.typ == shAnd {
+			p.next()
+		} else if p.tok.typ == shEOF || p.tok.typ == shOr || p.tok.typ == shClose {
+			break
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, right)
+	}
+	curr := ops[len(ops) - 1]
+	for i := len(ops) - 2; i >= 0; i -= 1 {
+		curr = OpAnd{ops[i], curr}
+	}
+	return curr, nil
+}
+
+func (p *shorthandParser) parseUnary() (BoolOp, error) {
+	if p.tok.typ == shNot {
+		p.next()
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return OpNot{inner}, nil
+	}
+	if p.tok.typ == shOpen {
+		p.next()
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.typ != shClose {
+			return nil, p.errorf("expected \")\" but found %s", p.tok)
+		}
+		p.next()
+		return inner, nil
+	}
+	return p.parseTerm()
+}
+
+func (p *shorthandParser) parseTerm() (BoolOp, error) {
+	switch p.tok.typ {
+	case shQuoted:
+		text, err := strunquote(p.tok.text)
+		if err != nil {
+			return nil, p.errorf("invalid quoted string %s", p.tok)
+		}
+		p.next()
+		return OpContains{text}, nil
+	case shWord:
+	default:
+		return nil, p.errorf("unexpected %s", p.tok)
+	}
+	word := p.tok.text
+	p.next()
+	if p.tok.typ != shColon {
+		return OpContains{word}, nil
+	}
+	field := word
+	p.next()
+	cmp := "=="
+	if p.tok.typ == shCompare {
+		cmp = p.tok.text
+		p.next()
+	}
+	var raw string
+	quoted := false
+	switch p.tok.typ {
+	case shQuoted:
+		text, err := strunquote(p.tok.text)
+		if err != nil {
+			return nil, p.errorf("invalid quoted string %s", p.tok)
+		}
+		raw = text
+		quoted = true
+	case shWord:
+		raw = p.tok.text
+	default:
+		return nil, p.errorf("expected a value for %q but found %s", field, p.tok)
+	}
+	p.next()
+	return shorthandTerm(field, cmp, raw, quoted)
+}
+
+// shorthandTerm builds the BoolOp for a single field:value term
+func shorthandTerm(field, cmp, raw string, quoted bool) (BoolOp, error) {
+	name := strings.ToLower(field)
+	wild := !quoted && strings.ContainsAny(raw, "*?")
+
+	if name == "message" || name == "msg" {
+		if cmp != "==" {
+			return nil, fmt.Errorf("shorthand: the message can only be searched, not compared with %s", cmp)
+		}
+		// Message searches are always substring searches, so wildcards at
+		// either end are redundant
+		if !quoted {
+			raw = strings.Trim(raw, "*")
+		}
+		return OpContains{raw}, nil
+	}
+	if cmp == "==" && !quoted && raw == "*" {
+		return OpHasField{field}, nil
+	}
+	if cmp == "==" && wild {
+		return OpMatch{field, raw}, nil
+	}
+	if name == "prefix" && cmp == "==" {
+		return OpPrefix{raw}, nil
+	}
+
+	var left, right Valueable
+	if name == "level" {
+		lvl, err := newLogLevel(raw)
+		if err != nil {
+			return nil, fmt.Errorf("shorthand: invalid level %q", raw)
+		}
+		left, right = OpLevel{}, lvl.(Valueable)
+	} else if quoted {
+		left, right = OpField{field}, Val{typ: ValTypeString, str: raw}
+	} else {
+		left, right = OpField{field}, valFromString(raw)
+	}
+
+	// Mirror the tree built by the Comparison rule in logselect.peg
+	switch cmp {
+	case "==":
+		return OpEquals{left, right}, nil
+	case "!=":
+		return OpNot{OpEquals{left, right}}, nil
+	case ">=":
+		return OpOr{OpEquals{left, right}, OpGreater{left, right}}, nil
+	case "<=":
+		return OpOr{OpEquals{left, right}, OpLess{left, right}}, nil
+	case ">":
+		return OpGreater{left, right}, nil
+	case "<":
+		return OpLess{left, right}, nil
+	default:
+		return nil, fmt.Errorf("shorthand: invalid comparison operator %q", cmp)
+	}
+}
@@ -0,0 +1,127 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * shorthand_test.go: Test of the shorthand query parser
+ */
+
+package predicate
+
+import (
+	"testing"
+	"fmt"
+	"github.com/sirupsen/logrus"
+)
+
+func doShorthandTest(s tst) func(t *testing.T) {
+	return func(t *testing.T) {
+		op, err := ParseShorthand(s.input)
+		if err != nil && s.success {
+			fmt.Printf("Error: %s\n", err.Error())
+			t.Fail()
+			return
+		} else if err == nil && !s.success {
+			fmt.Printf("Expecting an error and got none, got %#v\n", op)
+			t.Fail()
+			return
+		} else if err != nil && !s.success {
+			return
+		}
+		if op != s.output {
+			fmt.Printf("Value mismatch expected %#v but got %#v\n", s.output, op)
+			t.Fail()
+		}
+	}
+}
+
+func TestParseShorthand(t *testing.T) {
+	errLevel := LogLevel{int64(logrus.ErrorLevel)}
+	var tests = []tst{
+		{"", true, OpTrue{}},
+		{"   ", true, OpTrue{}},
+		{"timeout", true, OpContains{"timeout"}},
+		{"\"connection reset\"", true, OpContains{"connection reset"}},
+		{"level:error", true, OpEquals{OpLevel{}, errLevel}},
+		{"level:>=error", true, OpOr{OpEquals{OpLevel{}, errLevel}, OpGreater{OpLevel{}, errLevel}}},
+		{"prefix:db", true, OpPrefix{"db"}},
+		{"prefix:db.*", true, OpMatch{"prefix", "db.*"}},
+		{"user:*", true, OpHasField{"user"}},
+		{"status:500", true, OpEquals{OpField{"status"}, Val{typ: ValTypeInt, itg: 500}}},
+		{"status:'500'", true, OpEquals{OpField{"status"}, Val{typ: ValTypeString, str: "500"}}},
+		{"status:!=500", true, OpNot{OpEquals{OpField{"status"}, Val{typ: ValTypeInt, itg: 500}}}},
+		{"status:<500", true, OpLess{OpField{"status"}, Val{typ: ValTypeInt, itg: 500}}},
+		{"time:12:30", true, OpEquals{OpField{"time"}, Val{typ: ValTypeString, str: "12:30"}}},
+		{"type:and", true, OpEquals{OpField{"type"}, Val{typ: ValTypeString, str: "and"}}},
+		{"message:*timeout*", true, OpContains{"timeout"}},
+		{"level:error prefix:db", true, OpAnd{OpEquals{OpLevel{}, errLevel}, OpPrefix{"db"}}},
+		{"level:error AND prefix:db", true, OpAnd{OpEquals{OpLevel{}, errLevel}, OpPrefix{"db"}}},
+		{"prefix:a or prefix:b or prefix:c", true, OpOr{OpPrefix{"a"}, OpOr{OpPrefix{"b"}, OpPrefix{"c"}}}},
+		{"prefix:a prefix:b OR prefix:c", true, OpOr{OpAnd{OpPrefix{"a"}, OpPrefix{"b"}}, OpPrefix{"c"}}},
+		{"prefix:a (prefix:b OR prefix:c)", true, OpAnd{OpPrefix{"a"}, OpOr{OpPrefix{"b"}, OpPrefix{"c"}}}},
+		{"NOT prefix:a", true, OpNot{OpPrefix{"a"}}},
+		{"-prefix:a", true, OpNot{OpPrefix{"a"}}},
+		{"!prefix:a", true, OpNot{OpPrefix{"a"}}},
+		{"level:error prefix:db.* status:>=500 \"timeout\"", true, OpAnd{OpEquals{OpLevel{}, errLevel}, OpAnd{OpMatch{"prefix", "db.*"}, OpAnd{OpOr{OpEquals{OpField{"status"}, Val{typ: ValTypeInt, itg: 500}}, OpGreater{OpField{"status"}, Val{typ: ValTypeInt, itg: 500}}}, OpContains{"timeout"}}}}},
+		{"level:loud", false, nil},
+		{"prefix:", false, nil},
+		{"(prefix:a", false, nil},
+		{"prefix:a)", false, nil},
+		{"message:>1", false, nil},
+		{"AND", false, nil},
+	}
+
+	for _, s := range tests {
+		t.Run(s.input, doShorthandTest(s))
+	}
+}
+
+func TestParseShorthand_True(t *testing.T) {
+	e := &logrus.Entry{Data: make(logrus.Fields), Level: logrus.ErrorLevel, Message: "Query Timeout after 5s"}
+	e = e.WithFields(logrus.Fields{"prefix": "db.pool", "status": 503})
+	e.Level = logrus.ErrorLevel
+	e.Message = "Query Timeout after 5s"
+
+	var tests = []tst{
+		{"level:error prefix:db.* status:>=500 \"timeout\"", true, nil},
+		{"level:>=warn", true, nil},
+		{"level:>error", false, nil},
+		{"status:503", true, nil},
+		{"status:<500", false, nil},
+		{"prefix:db", false, nil},
+		{"prefix:db.pool", true, nil},
+		{"missing:*", false, nil},
+		{"-missing:*", true, nil},
+		{"deadlock OR timeout", true, nil},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.input, func(t *testing.T) {
+			op, err := ParseShorthand(test.input)
+			if err != nil {
+				fmt.Printf("Error: %s\n", err.Error())
+				t.Fail()
+				return
+			}
+			if op.True(e) != test.success {
+				fmt.Printf("Expected %v for %#v\n", test.success, op)
+				t.Fail()
+			}
+		})
+	}
+}
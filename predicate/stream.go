@@ -0,0 +1,66 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * stream.go: The stream pseudo-field
+ */
+
+package predicate
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+)
+
+// StreamField is the name of the field holding the stream an entry was logged
+// to. When an entry's context carries a stream, see WithStream, the field is
+// that, rather than any field of the same name in the entry's data, so an
+// application's own stream field can't be mistaken for it.
+const StreamField = "stream"
+
+type streamKey struct{}
+
+// WithStream returns a context carrying the name of an entry's stream
+func WithStream(ctx context.Context, name string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, streamKey{}, name)
+}
+
+// Stream returns the name of the stream carried by an entry's context, if
+// there is one
+func Stream(e *logrus.Entry) (string, bool) {
+	if e == nil || e.Context == nil {
+		return "", false
+	}
+	name, ok := e.Context.Value(streamKey{}).(string)
+	return name, ok
+}
+
+// field returns the value of a field of an entry, resolving the stream
+// pseudo-field
+func field(e *logrus.Entry, name string) (interface{}, bool) {
+	if name == StreamField {
+		if stream, ok := Stream(e); ok {
+			return stream, true
+		}
+	}
+	v, ok := e.Data[name]
+	return v, ok
+}
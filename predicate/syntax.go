@@ -0,0 +1,62 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * syntax.go: Query front-end selection
+ */
+
+package predicate
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Syntax names one of the query languages which compile to a BoolOp
+type Syntax string
+const (
+	// SyntaxSelect is the select grammar in logselect.peg, and the default
+	SyntaxSelect Syntax = "select"
+	// SyntaxShorthand is the Lucene/KQL style shorthand, see ParseShorthand
+	SyntaxShorthand Syntax = "shorthand"
+	// SyntaxJSON is the JSON encoded predicate tree, see FromJSON
+	SyntaxJSON Syntax = "json"
+)
+
+// Compile parses expression using the given syntax. An empty syntax is taken
+// to mean SyntaxSelect.
+func Compile(syntax Syntax, expression string) (BoolOp, error) {
+	switch syntax {
+	case SyntaxSelect, "":
+		op, err := Parse("selector", []byte(expression))
+		if err != nil {
+			return nil, err
+		}
+		p, ok := op.(BoolOp)
+		if !ok {
+			return nil, errors.New("Invalid BoolOp from predicate")
+		}
+		return p, nil
+	case SyntaxShorthand:
+		return ParseShorthand(expression)
+	case SyntaxJSON:
+		return FromJSON([]byte(expression))
+	default:
+		return nil, fmt.Errorf("unknown query syntax %q", string(syntax))
+	}
+}
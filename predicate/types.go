@@ -23,10 +23,12 @@
 package predicate
 
 import (
+	"encoding/json"
 	"github.com/sirupsen/logrus"
 	"strings"
 	"strconv"
 	"fmt"
+	"path"
 )
 
 type BoolOp interface {
@@ -48,7 +50,7 @@ type OpHasField struct {
 	field string
 }
 func (h OpHasField) True(e *logrus.Entry) bool {
-	_, ok := e.Data[h.field]
+	_, ok := field(e, h.field)
 	return ok
 }
 
@@ -92,16 +94,16 @@ type OpGreater struct {
 	left, right Valueable
 }
 func (g OpGreater) True(e *logrus.Entry) bool {
-	// TODO: Complete this
-	return false
+	c, ok := compare(g.left, g.right, e)
+	return ok && c > 0
 }
 
 type OpLess struct {
 	left, right Valueable
 }
 func (l OpLess) True(e *logrus.Entry) bool {
-	// TODO: Complete this
-	return false
+	c, ok := compare(l.left, l.right, e)
+	return ok && c < 0
 }
 
 type OpTrue struct {}
@@ -113,6 +115,30 @@ func (o  OpFalse) True(e *logrus.Entry) bool {
 	return false
 }
 
+// OpContains matches entries whose message contains text, ignoring case
+type OpContains struct {
+	text string
+}
+func (c OpContains) True(e *logrus.Entry) bool {
+	if e == nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(e.Message), strings.ToLower(c.text))
+}
+
+// OpMatch matches a field against a glob pattern, as in path.Match
+type OpMatch struct {
+	field, pattern string
+}
+func (m OpMatch) True(e *logrus.Entry) bool {
+	v := OpField{m.field}.toVal(e)
+	if v.typ == ValTypeNil {
+		return false
+	}
+	ok, err := path.Match(m.pattern, fmt.Sprint(v.GetVal(e)))
+	return err == nil && ok
+}
+
 type ValType int
 const (
 	ValTypeString ValType = iota
@@ -140,7 +166,7 @@ func (v Val) Type(e *logrus.Entry) ValType {
 }
 func (v Val) Equals(o Valueable, e *logrus.Entry) bool {
 	if v.typ != o.Type(e) {
-		return false
+		return mixedEquals(v, o, e)
 	}
 	switch v.typ {
 	case ValTypeString:
@@ -149,6 +175,10 @@ func (v Val) Equals(o Valueable, e *logrus.Entry) bool {
 		return v.flt == o.GetVal(e).(float64)
 	case ValTypeInt:
 		return v.itg == o.GetVal(e).(int64)
+	case ValTypeBool:
+		return v.bl == o.GetVal(e).(bool)
+	case ValTypeNil:
+		return true
 	default:
 		panic("impossible value type")
 	}
@@ -161,6 +191,10 @@ func (v Val) GetVal(e *logrus.Entry) interface{} {
 		return v.flt
 	case ValTypeInt:
 		return v.itg
+	case ValTypeBool:
+		return v.bl
+	case ValTypeNil:
+		return nil
 	default:
 		panic("impossible value type")
 	}
@@ -181,7 +215,7 @@ func (l LogLevel) Type(e *logrus.Entry) ValType {
 }
 func (l LogLevel) Equals(o Valueable, e *logrus.Entry) bool {
 	if o.Type(e) != ValTypeInt {
-		return false
+		return mixedEquals(l, o, e)
 	}
 	return l.v == o.GetVal(e).(int64)
 }
@@ -189,36 +223,95 @@ func (l LogLevel) GetVal(e *logrus.Entry) interface{} {
 	return l.v
 }
 
-// TODO: Finish these for real
+// OpLevel is the level of the entry being tested, as opposed to LogLevel
+// which is a level constant from the query
+type OpLevel struct {}
+func (l OpLevel) Type(e *logrus.Entry) ValType {
+	if e == nil {
+		return ValTypeNil
+	}
+	return ValTypeInt
+}
+func (l OpLevel) Equals(o Valueable, e *logrus.Entry) bool {
+	if e == nil {
+		return false
+	}
+	if o.Type(e) != ValTypeInt {
+		return mixedEquals(l, o, e)
+	}
+	return int64(e.Level) == o.GetVal(e).(int64)
+}
+func (l OpLevel) GetVal(e *logrus.Entry) interface{} {
+	if e == nil {
+		return nil
+	}
+	return int64(e.Level)
+}
+
 type OpField struct {
 	name string
 }
 func (f OpField) Type(e *logrus.Entry) ValType {
-	return ValTypeString
+	return f.toVal(e).typ
 }
 func (f OpField) Equals(o Valueable, e *logrus.Entry) bool {
-	return false
+	return f.toVal(e).Equals(o, e)
 }
 func (f OpField) GetVal(e *logrus.Entry) interface{} {
-	return nil
+	return f.toVal(e).GetVal(e)
 }
 func (f OpField) toVal(e *logrus.Entry) Val {
-	var ok bool
-	var s interface{}
-	var str string
-	s, ok = e.Data[f.name]
-	if !ok {
+	if e == nil {
 		return Val{typ: ValTypeNil}
 	}
-	str, ok = s.(string)
+	s, ok := field(e, f.name)
 	if !ok {
-		var stg fmt.Stringer
-		stg, ok = s.(fmt.Stringer)
-		if !ok {
-			return Val{typ: ValTypeNil}
-		}
-		str = stg.String()
+		return Val{typ: ValTypeNil}
 	}
+	switch v := s.(type) {
+	case nil:
+		return Val{typ: ValTypeNil}
+	case string:
+		return valFromString(v)
+	case bool:
+		return Val{typ: ValTypeBool, bl: v}
+	case int:
+		return Val{typ: ValTypeInt, itg: int64(v)}
+	case int8:
+		return Val{typ: ValTypeInt, itg: int64(v)}
+	case int16:
+		return Val{typ: ValTypeInt, itg: int64(v)}
+	case int32:
+		return Val{typ: ValTypeInt, itg: int64(v)}
+	case int64:
+		return Val{typ: ValTypeInt, itg: v}
+	case uint:
+		return Val{typ: ValTypeInt, itg: int64(v)}
+	case uint8:
+		return Val{typ: ValTypeInt, itg: int64(v)}
+	case uint16:
+		return Val{typ: ValTypeInt, itg: int64(v)}
+	case uint32:
+		return Val{typ: ValTypeInt, itg: int64(v)}
+	case uint64:
+		return Val{typ: ValTypeInt, itg: int64(v)}
+	case float32:
+		return Val{typ: ValTypeFloat, flt: float64(v)}
+	case float64:
+		return Val{typ: ValTypeFloat, flt: v}
+	case fmt.Stringer:
+		return valFromString(v.String())
+	case json.RawMessage:
+		// Values encoded when the entry was logged compare as their JSON
+		return Val{typ: ValTypeString, str: string(v)}
+	default:
+		return Val{typ: ValTypeNil}
+	}
+}
+
+// valFromString interprets a string the same way the grammar interprets an
+// unquoted literal: numbers, booleans and null become typed values
+func valFromString(str string) Val {
 	itg, err := strconv.ParseInt(str, 10, 64)
 	if err == nil {
 		return Val{typ: ValTypeInt, itg: itg}
@@ -238,4 +331,85 @@ func (f OpField) toVal(e *logrus.Entry) Val {
 		return Val{typ: ValTypeNil}
 	}
 	return Val{typ: ValTypeString, str: str}
-}
\ No newline at end of file
+}
+
+// levelKeyword is the bare word level in a comparison, see resolveLevel
+type levelKeyword struct {
+	text string
+}
+
+// resolveLevel turns the level keyword into the entry's level when it's on
+// the left of a comparison. On the right it stays the string it was before
+// the keyword was added, so Field(kind) == level means what it always has.
+func resolveLevel(v interface{}, left bool) interface{} {
+	k, ok := v.(levelKeyword)
+	if !ok {
+		return v
+	}
+	if left {
+		return OpLevel{}
+	}
+	return Val{typ: ValTypeString, str: k.text}
+}
+
+// isLevel reports whether a value is a log level rather than a plain number
+func isLevel(v Valueable) bool {
+	switch v.(type) {
+	case LogLevel, OpLevel:
+		return true
+	}
+	return false
+}
+
+// compare orders two values, returning -1, 0 or 1 and whether the values were
+// comparable at all. Numbers compare with numbers and strings with strings.
+// Two levels compare by severity, so that "level >= error" also matches fatal
+// and panic, even though logrus numbers them the other way round. A level
+// compared with a plain number compares as logrus numbers it.
+func compare(left, right Valueable, e *logrus.Entry) (int, bool) {
+	lt, rt := left.Type(e), right.Type(e)
+	c := 0
+	switch {
+	case lt == ValTypeString && rt == ValTypeString:
+		c = strings.Compare(left.GetVal(e).(string), right.GetVal(e).(string))
+	case (lt == ValTypeInt || lt == ValTypeFloat) && (rt == ValTypeInt || rt == ValTypeFloat):
+		if lt == ValTypeInt && rt == ValTypeInt {
+			l, r := left.GetVal(e).(int64), right.GetVal(e).(int64)
+			if l < r {
+				c = -1
+			} else if l > r {
+				c = 1
+			}
+		} else {
+			l, r := toFloat(left.GetVal(e)), toFloat(right.GetVal(e))
+			if l < r {
+				c = -1
+			} else if l > r {
+				c = 1
+			}
+		}
+		if isLevel(left) && isLevel(right) {
+			c = -c
+		}
+	default:
+		return 0, false
+	}
+	return c, true
+}
+
+// mixedEquals compares values of different types, which are only equal if
+// one is an int and the other a float of the same value, as compare has them
+func mixedEquals(left, right Valueable, e *logrus.Entry) bool {
+	c, ok := compare(left, right, e)
+	return ok && c == 0
+}
+
+func toFloat(v interface{}) float64 {
+	switch n := v.(type) {
+	case int64:
+		return float64(n)
+	case float64:
+		return n
+	}
+	return 0
+}
@@ -25,6 +25,7 @@ package predicate
 import (
 	"testing"
 	"reflect"
+	"encoding/json"
 	"fmt"
 	"github.com/sirupsen/logrus"
 )
@@ -51,6 +52,8 @@ func TestBoolOp(t *testing.T) {
 	assertBoolOp(OpLess{}, "OpLess")
 	assertBoolOp(OpTrue{}, "OpTrue")
 	assertBoolOp(OpFalse{}, "OpFalse")
+	assertBoolOp(OpContains{}, "OpContains")
+	assertBoolOp(OpMatch{}, "OpMatch")
 }
 
 func TestValuable(t *testing.T) {
@@ -68,6 +71,7 @@ func TestValuable(t *testing.T) {
 	assertValuable(Val{}, "Val")
 	assertValuable(LogLevel{}, "LogLevel")
 	assertValuable(OpField{}, "OpField")
+	assertValuable(OpLevel{}, "OpLevel")
 }
 
 func TestOpAnd_True(t *testing.T) {
@@ -188,4 +192,132 @@ func TestOpField_toVal(t *testing.T) {
 	}
 }
 
+func TestOpField_toValTyped(t *testing.T) {
+	e := (&logrus.Entry{Data: make(logrus.Fields)}).WithFields(logrus.Fields{"int": 500, "uint": uint8(3), "float": 1.5, "bool": true, "nil": nil, "raw": json.RawMessage(`[1,2]`)})
+	if (OpField{"int"}).toVal(e) != (Val{typ: ValTypeInt, itg: 500}) {
+		t.Fail()
+	}
+	if (OpField{"uint"}).toVal(e) != (Val{typ: ValTypeInt, itg: 3}) {
+		t.Fail()
+	}
+	if (OpField{"float"}).toVal(e) != (Val{typ: ValTypeFloat, flt: 1.5}) {
+		t.Fail()
+	}
+	if (OpField{"bool"}).toVal(e) != (Val{typ: ValTypeBool, bl: true}) {
+		t.Fail()
+	}
+	if (OpField{"nil"}).toVal(e) != (Val{typ: ValTypeNil}) {
+		t.Fail()
+	}
+	if (OpField{"missing"}).toVal(e) != (Val{typ: ValTypeNil}) {
+		t.Fail()
+	}
+	if (OpField{"raw"}).toVal(e) != (Val{typ: ValTypeString, str: "[1,2]"}) {
+		t.Fail()
+	}
+}
+
+// The stream in an entry's context takes the place of a field of the same name
+func TestOpField_stream(t *testing.T) {
+	e := &logrus.Entry{Data: logrus.Fields{"stream": "app"}}
+	if (OpField{"stream"}).toVal(e) != (Val{typ: ValTypeString, str: "app"}) {
+		t.Fail()
+	}
+	e.Context = WithStream(nil, "jobs")
+	if (OpField{"stream"}).toVal(e) != (Val{typ: ValTypeString, str: "jobs"}) {
+		t.Fail()
+	}
+	delete(e.Data, "stream")
+	if !(OpHasField{"stream"}).True(e) {
+		t.Fail()
+	}
+}
+
+func TestOpGreaterLess_True(t *testing.T) {
+	e := (&logrus.Entry{Data: make(logrus.Fields)}).WithFields(logrus.Fields{"status": 503, "name": "bob", "ratio": 2.0})
+	e.Level = logrus.WarnLevel
+
+	tests := []struct {
+		name string
+		op BoolOp
+		want bool
+	}{
+		{"field > int", OpGreater{OpField{"status"}, Val{typ: ValTypeInt, itg: 500}}, true},
+		{"field < int", OpLess{OpField{"status"}, Val{typ: ValTypeInt, itg: 500}}, false},
+		{"field > float", OpGreater{OpField{"status"}, Val{typ: ValTypeFloat, flt: 502.5}}, true},
+		{"field > string", OpGreater{OpField{"name"}, Val{typ: ValTypeString, str: "alice"}}, true},
+		{"string > int", OpGreater{OpField{"name"}, Val{typ: ValTypeInt, itg: 1}}, false},
+		{"missing > int", OpGreater{OpField{"missing"}, Val{typ: ValTypeInt, itg: 1}}, false},
+		{"warn > error", OpGreater{OpLevel{}, LogLevel{int64(logrus.ErrorLevel)}}, false},
+		{"warn > info", OpGreater{OpLevel{}, LogLevel{int64(logrus.InfoLevel)}}, true},
+		{"warn < error", OpLess{OpLevel{}, LogLevel{int64(logrus.ErrorLevel)}}, true},
+		{"warn == warn", OpEquals{OpLevel{}, LogLevel{int64(logrus.WarnLevel)}}, true},
+		// Only two levels compare by severity
+		{"field > level", OpGreater{OpField{"status"}, LogLevel{int64(logrus.ErrorLevel)}}, true},
+		{"level < int", OpLess{LogLevel{int64(logrus.ErrorLevel)}, OpField{"status"}}, true},
+		{"field == int", OpEquals{OpField{"status"}, Val{typ: ValTypeInt, itg: 503}}, true},
+		// Ints and floats of the same value are equal, as they compare
+		{"field == float", OpEquals{OpField{"status"}, Val{typ: ValTypeFloat, flt: 503}}, true},
+		{"float == field", OpEquals{Val{typ: ValTypeFloat, flt: 503}, OpField{"status"}}, true},
+		{"field == other float", OpEquals{OpField{"status"}, Val{typ: ValTypeFloat, flt: 503.5}}, false},
+		{"float field == int", OpEquals{OpField{"ratio"}, Val{typ: ValTypeInt, itg: 2}}, true},
+		{"level == float", OpEquals{OpLevel{}, Val{typ: ValTypeFloat, flt: float64(logrus.WarnLevel)}}, true},
+		{"string == int", OpEquals{OpField{"name"}, Val{typ: ValTypeInt, itg: 503}}, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			if test.op.True(e) != test.want {
+				t.Fail()
+			}
+		})
+	}
+}
+
 // Private types for testing
+
+// TestNumericEquality checks that each syntax's >= and <= include a boundary
+// written as an int or a float, whichever the field was logged as
+func TestNumericEquality(t *testing.T) {
+	ints := (&logrus.Entry{Data: make(logrus.Fields)}).WithField("status", 500)
+	floats := (&logrus.Entry{Data: make(logrus.Fields)}).WithField("status", 500.0)
+
+	tests := []struct {
+		syntax Syntax
+		expression string
+	}{
+		{SyntaxSelect, "Field(status) == 500"},
+		{SyntaxSelect, "Field(status) == 500.0"},
+		{SyntaxSelect, "Field(status) >= 500.0"},
+		{SyntaxSelect, "Field(status) <= 500.0"},
+		{SyntaxSelect, "Field(status) >= 500"},
+		{SyntaxSelect, "Field(status) <= 500"},
+		{SyntaxShorthand, "status:500"},
+		{SyntaxShorthand, "status:500.0"},
+		{SyntaxShorthand, "status:>=500.0"},
+		{SyntaxShorthand, "status:<=500.0"},
+		{SyntaxShorthand, "status:>=500"},
+		{SyntaxShorthand, "status:<=500"},
+		{SyntaxJSON, `{"eq":[{"field":"status"},500]}`},
+		{SyntaxJSON, `{"eq":[{"field":"status"},500.0]}`},
+		{SyntaxJSON, `{"ge":[{"field":"status"},500.0]}`},
+		{SyntaxJSON, `{"le":[{"field":"status"},500.0]}`},
+		{SyntaxJSON, `{"ge":[{"field":"status"},500]}`},
+		{SyntaxJSON, `{"le":[{"field":"status"},500]}`},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(string(test.syntax) + " " + test.expression, func(t *testing.T) {
+			op, err := Compile(test.syntax, test.expression)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !op.True(ints) || !op.True(floats) {
+				fmt.Printf("Expected a match for %#v\n", op)
+				t.Fail()
+			}
+		})
+	}
+}
@@ -23,37 +23,303 @@
 package web
 
 import (
+	"encoding/json"
 	"github.com/gorilla/websocket"
 	"net/http"
+	"reflect"
+	"sort"
 	"github.com/jwriteclub/weblog/dispatcher"
+	"github.com/jwriteclub/weblog/predicate"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 )
 
+// request is a message sent by the panel
+type request struct {
+	Type string `json:"type"`
+	Selector json.RawMessage `json:"selector"`
+	Syntax predicate.Syntax `json:"syntax"`
+	// Streams names the streams of a registry to select from, or query. No
+	// streams selects all of them.
+	Streams []string `json:"streams"`
+	// After is the sequence number of the last entry seen, for resume, and
+	// Epoch the epoch it belongs to
+	After uint64 `json:"after"`
+	Epoch string `json:"epoch"`
+	// Rate is the rate limit asked for by a ratelimit request
+	Rate float64 `json:"rate"`
+	// The rest are for history queries. ID is returned with the results.
+	ID json.RawMessage `json:"id,omitempty"`
+	From int64 `json:"from"`
+	To int64 `json:"to"`
+	Limit int `json:"limit"`
+	Order string `json:"order"`
+	Cursor uint64 `json:"cursor"`
+}
+
+// expression returns the syntax and text of the requested selector. The
+// selector is normally a string, but may also be given as a JSON predicate
+// object, in which case the syntax is implied.
+func (r request) expression() (syntax predicate.Syntax, expression string, ok bool) {
+	if len(r.Selector) == 0 || string(r.Selector) == "null" {
+		return
+	}
+	if err := json.Unmarshal(r.Selector, &expression); err == nil {
+		return r.Syntax, expression, true
+	}
+	return predicate.SyntaxJSON, string(r.Selector), true
+}
+
+// selectorRequest is what a selector was opened with
+type selectorRequest struct {
+	streams []string
+	syntax predicate.Syntax
+	expression string
+}
+
+// historyQuery converts a query request, whose times are in milliseconds
+func (r request) historyQuery() dispatcher.HistoryQuery {
+	hq := dispatcher.HistoryQuery{Limit: r.Limit, Cursor: r.Cursor}
+	hq.Syntax, hq.Expression, _ = r.expression()
+	if r.From != 0 {
+		hq.From = time.Unix(0, r.From * 1000000)
+	}
+	if r.To != 0 {
+		hq.To = time.Unix(0, r.To * 1000000)
+	}
+	if hq.Limit > maxQueryLimit {
+		hq.Limit = maxQueryLimit
+	}
+	if r.Order == "newest" {
+		hq.Order = dispatcher.NewestFirst
+	}
+	return hq
+}
+
+// The most results a panel can request at once
+const maxQueryLimit = 1000
+
+// logPayload is the form an entry is sent to the panel in
+func logPayload(e *dispatcher.Entry) map[string]interface{} {
+	dat := map[string]interface{} {
+		"seq": e.Seq,
+		"time": int64(e.Time.UnixNano() / 1000000),
+		"level": strings.ToLower(e.Level.String()),
+		"fields": e.Data,
+		"message": fmt.Sprintf("%v\n", e.Message),
+	}
+	if e.Stream != "" {
+		dat["stream"] = e.Stream
+	}
+	return dat
+}
+
+// selection is what the handler reads entries from, a Selector, or a
+// MultiSelector spanning several streams
+type selection interface {
+	C() <-chan dispatcher.Entry
+	Aggregating() bool
+	Snapshot() *dispatcher.Snapshot
+	Stop()
+	BaseTime() time.Time
+	Epoch() string
+}
+
+// source is what a handler selects entries from, either one dispatcher or a
+// registry of them
+type source struct {
+	g *dispatcher.Registry
+	d *dispatcher.Dispatcher
+}
+
+// stream returns the dispatcher for a request, which must name a single
+// stream if there's a registry
+func (src source) stream(names []string) (*dispatcher.Dispatcher, error) {
+	if src.g == nil {
+		return src.d, nil
+	}
+	if len(names) != 1 {
+		return nil, fmt.Errorf("a single stream is needed")
+	}
+	d := src.g.Get(names[0])
+	if d == nil {
+		return nil, fmt.Errorf("unknown stream %q", names[0])
+	}
+	return d, nil
+}
+
+// open creates a selection, resuming the stream after the given sequence
+// number if resume is set. Only a single stream can be resumed, and only if
+// the number is from the dispatcher's epoch and it has reached it (it may have
+// restarted since), so resumed reports whether it was.
+func (src source) open(names []string, syntax predicate.Syntax, expression string, resume bool, epoch string, after uint64) (sel selection, resumed bool, err error) {
+	if src.g != nil && len(names) != 1 {
+		sel, err = src.g.NewSelector(syntax, expression, names...)
+		return
+	}
+	d, err := src.stream(names)
+	if err != nil {
+		return
+	}
+	if resume {
+		var s *dispatcher.Selector
+		s, err = dispatcher.NewSelectorAfter(syntax, expression, epoch, after, d)
+		if err != nil {
+			return s, false, err
+		}
+		return s, s.Resumed(), nil
+	}
+	sel, err = dispatcher.NewSelectorSyntax(syntax, expression, d)
+	return
+}
+
+// dispatchers returns every dispatcher of the source
+func (src source) dispatchers() []*dispatcher.Dispatcher {
+	if src.g == nil {
+		return []*dispatcher.Dispatcher{src.d}
+	}
+	ret := make([]*dispatcher.Dispatcher, 0)
+	for _, name := range src.g.Names() {
+		if d := src.g.Get(name); d != nil {
+			ret = append(ret, d)
+		}
+	}
+	return ret
+}
+
+// streams is the message listing a registry's streams
+func (src source) streams() map[string]interface{} {
+	names := make([]string, 0)
+	if src.g != nil {
+		names = src.g.Names()
+	}
+	return map[string]interface{}{"type": "streams", "streams": names}
+}
+
+// alerts is the message listing the alerts firing on every dispatcher, and
+// the sum of their versions, which changes whenever the list might
+func (src source) alerts() (map[string]interface{}, uint64) {
+	alerts := make([]map[string]interface{}, 0)
+	version := uint64(0)
+	for _, d := range src.dispatchers() {
+		version += d.AlertVersion()
+		for _, a := range d.Alerts() {
+			alerts = append(alerts, map[string]interface{}{
+				"rule": a.Rule,
+				"expression": a.Expression,
+				"stream": a.Stream,
+				"threshold": a.Threshold,
+				"window": a.Window.Seconds(),
+				"count": a.Count,
+				"since": int64(a.Since.UnixNano() / 1000000),
+			})
+		}
+	}
+	return map[string]interface{}{"type": "alerts", "alerts": alerts}, version
+}
+
+// alertChanges is what to wait on for the alerts to change, after done: each
+// dispatcher's AlertChanged, and the registry's Changed, as a stream added
+// later may have alerts of its own
+func (src source) alertChanges(done <-chan struct{}) []reflect.SelectCase {
+	ret := []reflect.SelectCase{{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(done)}}
+	if src.g != nil {
+		ret = append(ret, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(src.g.Changed())})
+	}
+	for _, d := range src.dispatchers() {
+		ret = append(ret, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(d.AlertChanged())})
+	}
+	return ret
+}
+
+// watchAlerts wakes the main loop each time the alerts might have changed,
+// until done is closed. The channels for the next change are taken before
+// waking it, so none is missed while it reads the alerts.
+func (src source) watchAlerts(changes []reflect.SelectCase, done <-chan struct{}, wake chan<- struct{}) {
+	for {
+		if i, _, _ := reflect.Select(changes); i == 0 {
+			return
+		}
+		changes = src.alertChanges(done)
+		select {
+		case wake <- struct{}{}:
+			break
+		default:
+			break
+		}
+	}
+}
+
+// How often aggregating selectors send a snapshot of their results, entries
+// suppressed by the rate limit are summarised, and repeats are sent
+const snapshotInterval = time.Second
+
 var upgrader = websocket.Upgrader {
 	ReadBufferSize: 1024,
 	WriteBufferSize: 1024,
 }
 
-func NewWeblogHandler(d *dispatcher.Dispatcher) func(http.ResponseWriter, *http.Request) {
+// NewWeblogHandler serves the websocket of a panel for d. Panels may be rate
+// limited, see RateLimit.
+func NewWeblogHandler(d *dispatcher.Dispatcher, opts ...HandlerOption) func(http.ResponseWriter, *http.Request) {
+	return newHandler(source{d: d}, opts)
+}
+
+// NewRegistryHandler is like NewWeblogHandler, but the panel can choose
+// between the streams of a registry, or select from several at once
+func NewRegistryHandler(g *dispatcher.Registry, opts ...HandlerOption) func(http.ResponseWriter, *http.Request) {
+	return newHandler(source{g: g}, opts)
+}
+
+func newHandler(src source, opts []HandlerOption) func(http.ResponseWriter, *http.Request) {
+	config := defaultConfig()
+	for _, opt := range opts {
+		opt(&config)
+	}
 	return func(w http.ResponseWriter, r *http.Request) {
 		conn, err := upgrader.Upgrade(w, r, nil)
 		if err != nil {
 			fmt.Printf("weblog: Got error %s\n", err.Error())
 			return
 		}
+		// Closing the connection also ends the reading goroutine
+		defer conn.Close()
 
-		run := true
+		// Closed when the reading goroutine stops, as the connection is gone
+		readerDone := make(chan struct{})
 
 		hello := make(map[string]string)
 		hello["hello"] = "world"
 		conn.WriteJSON(hello)
+		if src.g != nil {
+			conn.WriteJSON(src.streams())
+		}
+		// Only used by the main loop
+		lim := newLimiter(config)
+		conn.WriteJSON(lim.message())
+		pau := newPause(config)
+		// Taken before the alerts are read, so that a change in between
+		// still wakes the main loop
+		changes := src.alertChanges(readerDone)
+		alerts, alertVersion := src.alerts()
+		conn.WriteJSON(alerts)
 
-		s, err := dispatcher.NewSelector("", d)
+		// Taken before the selector is opened, so that a stream added in
+		// between is still included
+		var streamsChanged <-chan struct{}
+		if src.g != nil {
+			streamsChanged = src.g.Changed()
+		}
+		s, _, err := src.open(nil, predicate.SyntaxSelect, "", false, "", 0)
+		// What the selector was opened with, to open it again when streams
+		// are added to or removed from the registry, if it spans all of them
+		opened := selectorRequest{syntax: predicate.SyntaxSelect}
 		newSelector := true
-		defer s.Stop()
+		// Set when the new selector resumes a previous connection's stream,
+		// which the panel keeps rather than clearing
+		resumed := false
 		if err != nil {
 			fmt.Printf("weblog: error creating selector: %s\n", err.Error())
 			dat := make(map[string]string)
@@ -61,84 +327,380 @@ func NewWeblogHandler(d *dispatcher.Dispatcher) func(http.ResponseWriter, *http.
 			dat["message"] = "unable to parse selector"
 			dat["error"] = err.Error()
 			conn.WriteJSON(dat)
-			_ = conn.Close()
+			if s != nil {
+				s.Stop()
+			}
 			return
 		}
 
 		mutex := &sync.RWMutex{}
+		// Errors are written by the main loop, as the connection only supports
+		// one concurrent writer
+		var selectorErr error
+		// Other replies waiting to be written by the main loop
+		outbox := make([]interface{}, 0)
+		// A rate limit asked for by the panel, for the main loop to apply
+		var rateRequest *float64
+		// A pause, or unpause, asked for by the panel
+		var pauseRequest *bool
+		// Wakes the main loop when the reading goroutine leaves it something
+		// to write
+		wake := make(chan struct{}, 1)
+		notify := func() {
+			select {
+			case wake <- struct{}{}:
+				break
+			default:
+				break
+			}
+		}
+		// control writes the messages left by the reading goroutine. The mutex
+		// must be held.
+		control := func() error {
+			if rateRequest != nil {
+				lim.request(*rateRequest)
+				rateRequest = nil
+				err := conn.WriteJSON(lim.message())
+				if err != nil {
+					return err
+				}
+			}
+			if selectorErr != nil {
+				err := conn.WriteJSON(map[string]string{"type": "error", "message": "unable to parse selector", "error": selectorErr.Error()})
+				selectorErr = nil
+				if err != nil {
+					return err
+				}
+			}
+			for len(outbox) > 0 {
+				err := conn.WriteJSON(outbox[0])
+				outbox = outbox[1:]
+				if err != nil {
+					return err
+				}
+			}
+			if pauseRequest != nil {
+				var held []dispatcher.Entry
+				if *pauseRequest {
+					pau.paused = true
+				} else if pau.paused {
+					held = pau.unpause()
+				}
+				pauseRequest = nil
+				err := conn.WriteJSON(pau.message())
+				// Held entries go ahead of any new selector's clear, as they
+				// belong to the old one. They're rate limited like any others,
+				// but gaps are always sent.
+				now := time.Now()
+				for i := 0; err == nil && i < len(held); i += 1 {
+					if held[i].Gap != nil || lim.allow(&held[i], now) {
+						err = conn.WriteJSON(entryMessage(&held[i]))
+					}
+				}
+				if err != nil {
+					return err
+				}
+			}
+			if newSelector {
+				// A new selector ends a pause, and its entries aren't needed
+				if pau.paused {
+					pau = newPause(config)
+					err := conn.WriteJSON(pau.message())
+					if err != nil {
+						return err
+					}
+				}
+				// The epoch is sent with the sequence numbers' first use, as the
+				// panel needs it to resume the stream later
+				t := "clear"
+				if resumed {
+					t = "resumed"
+				}
+				err := conn.WriteJSON(map[string]interface{}{"type": t, "epoch": s.Epoch()})
+				if err != nil {
+					return err
+				}
+				err = conn.WriteJSON(map[string]interface{}{"type": "basetime", "basetime": int64(s.BaseTime().UnixNano() / 1000000), "epoch": s.Epoch()})
+				if err != nil {
+					return err
+				}
+				newSelector = false
+			}
+			return nil
+		}
+		defer func() {
+			mutex.Lock()
+			s.Stop()
+			mutex.Unlock()
+		}()
 
 		go func () {
-			for run {
-				mp := make(map[string]string)
-				err := conn.ReadJSON(&mp)
+			defer close(readerDone)
+			for {
+				req := request{}
+				err := conn.ReadJSON(&req)
 				if err != nil {
 					fmt.Printf("Got an error from the read channel: %s\n", err.Error())
-					run = false
+					return
+				}
+				fmt.Printf("%#v\n", req)
+				if req.Type == "ratelimit" {
+					rate := req.Rate
+					mutex.Lock()
+					rateRequest = &rate
+					mutex.Unlock()
+					notify()
+					continue
+				}
+				if req.Type == "pause" || req.Type == "unpause" {
+					paused := req.Type == "pause"
+					mutex.Lock()
+					pauseRequest = &paused
+					mutex.Unlock()
+					notify()
+					continue
+				}
+				if req.Type == "streams" {
+					mutex.Lock()
+					outbox = append(outbox, src.streams())
+					mutex.Unlock()
+					notify()
 					continue
 				}
-				fmt.Printf("%#v\n", mp)
-				if t, ok := mp["type"]; ok && t == "selector" {
-					if sel, ok := mp["selector"]; ok {
-						selector, err := dispatcher.NewSelector(sel, d)
+				if req.Type == "query" {
+					reply := map[string]interface{}{"type": "query", "id": req.ID}
+					var res dispatcher.HistoryResult
+					d, err := src.stream(req.Streams)
+					if err == nil {
+						res, err = d.Query(req.historyQuery())
+					}
+					if err != nil {
+						reply["error"] = err.Error()
+					} else {
+						logs := make([]map[string]interface{}, 0, len(res.Entries))
+						for i := range res.Entries {
+							logs = append(logs, logPayload(&res.Entries[i]))
+						}
+						reply["logs"] = logs
+						reply["cursor"] = res.Cursor
+					}
+					mutex.Lock()
+					outbox = append(outbox, reply)
+					mutex.Unlock()
+					notify()
+					continue
+				}
+				if req.Type == "selector" || req.Type == "resume" {
+					syntax, sel, ok := req.expression()
+					if !ok && req.Type == "resume" {
+						sel, ok = "", true
+					}
+					if ok {
+						selector, wasResumed, err := src.open(req.Streams, syntax, sel, req.Type == "resume", req.Epoch, req.After)
 						if err != nil {
 							fmt.Printf("weblog: error creating selector: %s\n", err.Error())
+							if selector != nil {
+								selector.Stop()
+							}
+							mutex.Lock()
+							selectorErr = err
+							mutex.Unlock()
+							notify()
 							continue
 						}
 						mutex.Lock()
 						s.Stop()
 						s = selector
+						opened = selectorRequest{streams: req.Streams, syntax: syntax, expression: sel}
 						newSelector = true
+						resumed = wasResumed
 						mutex.Unlock()
+						notify()
 					}
 				}
 			}
 		}()
 
-		for run {
-			didSomething := false
+		// reopen replaces a selector spanning every stream with a new one,
+		// which includes the streams added since, and not those removed
+		reopen := func() {
+			defer mutex.Unlock()
 			mutex.Lock()
-			if newSelector {
-				err := conn.WriteJSON(map[string]interface{}{"type": "clear"})
-				if err != nil {
-					fmt.Printf("weblog: Got error %s\n", err.Error())
-					goto nsdone
+			if len(opened.streams) != 0 {
+				return
+			}
+			selector, _, err := src.open(nil, opened.syntax, opened.expression, false, "", 0)
+			if err != nil {
+				fmt.Printf("weblog: error reopening selector: %s\n", err.Error())
+				if selector != nil {
+					selector.Stop()
 				}
-				err = conn.WriteJSON(map[string]interface{}{"type": "basetime", "basetime": int64(s.BaseTime().UnixNano() / 1000000)})
-				if err != nil {
-					fmt.Printf("weblog: Got error %s\n", err.Error())
-					goto nsdone
+				return
+			}
+			s.Stop()
+			s = selector
+			newSelector = true
+			resumed = false
+		}
+
+		ticker := time.NewTicker(snapshotInterval)
+		defer ticker.Stop()
+		// Wakes the main loop when the alerts might have changed
+		alertWake := make(chan struct{}, 1)
+		go src.watchAlerts(changes, readerDone, alertWake)
+		var sel selection
+		var entries <-chan dispatcher.Entry
+		// The latest repeat of each entry, waiting for the next tick
+		updates := make(map[updateKey]dispatcher.Entry)
+		for {
+			mutex.Lock()
+			err := control()
+			if err == nil && sel != s {
+				// Read from the selector the messages above were written for,
+				// even if it's replaced in the meantime
+				sel = s
+				entries = sel.C()
+				// Entries suppressed for the old selector aren't of interest
+				lim.sup = nil
+				updates = make(map[updateKey]dispatcher.Entry)
+				if sel.Aggregating() {
+					err = conn.WriteJSON(map[string]interface{}{"type": "aggregate", "aggregate": sel.Snapshot()})
 				}
-				newSelector = false
-				didSomething = true
 			}
-		nsdone:
 			mutex.Unlock()
-			if !run {
-				continue
+			if err != nil {
+				fmt.Printf("weblog: Got error %s\n", err.Error())
+				return
 			}
 
-			e := s.MaybeRead()
-			if e != nil {
-				dat := make(map[string]interface{})
-				dat["type"] = "log"
-				dat["log"] = map[string]interface{} {
-					"time": int64(e.Time.UnixNano() / 1000000),
-					"level": strings.ToLower(e.Level.String()),
-					"fields": e.Data,
-					"message": fmt.Sprintf("%v\n", e.Message),
-				}
-				err := conn.WriteJSON(dat)
-				if err != nil {
-					fmt.Printf("weblog: Got error %s\n", err.Error())
-					run = false
-					continue
+			var tick <-chan time.Time
+			if sel.Aggregating() || lim.pending() || len(updates) > 0 || pau.paused {
+				tick = ticker.C
+			}
+			select {
+			case <-readerDone:
+				return
+			case <-wake:
+				break
+			case e, ok := <-entries:
+				if !ok {
+					mutex.Lock()
+					current := sel == s
+					mutex.Unlock()
+					if current {
+						// The dispatcher has been closed
+						return
+					}
+					// Replaced, wait for the new selector
+					entries = nil
+					break
+				}
+				if e.Repeat != nil {
+					updates[repeatOf(&e)] = e
+					break
+				}
+				if pau.paused {
+					pau.hold(e)
+					break
+				}
+				// Gaps are always sent, but entries only within the rate limit
+				if e.Gap != nil || lim.allow(&e, time.Now()) {
+					err = conn.WriteJSON(entryMessage(&e))
+				}
+			case <-streamsChanged:
+				streamsChanged = src.g.Changed()
+				err = conn.WriteJSON(src.streams())
+				if err == nil {
+					reopen()
+				}
+			case <-alertWake:
+				if alerts, version := src.alerts(); version != alertVersion {
+					alertVersion = version
+					err = conn.WriteJSON(alerts)
+				}
+			case <-tick:
+				if pau.paused {
+					// Only the number held changes until the panel resumes
+					err = conn.WriteJSON(pau.message())
+					break
+				}
+				if sel.Aggregating() {
+					err = conn.WriteJSON(map[string]interface{}{"type": "aggregate", "aggregate": sel.Snapshot()})
+				}
+				if err == nil && lim.pending() {
+					err = conn.WriteJSON(lim.summary())
+				}
+				if err == nil && len(updates) > 0 {
+					err = sendUpdates(conn, updates)
+					updates = make(map[updateKey]dispatcher.Entry)
 				}
-				didSomething = false
 			}
-
-			if run && !didSomething {
-				time.Sleep(time.Millisecond*10)
+			if err != nil {
+				fmt.Printf("weblog: Got error %s\n", err.Error())
+				return
 			}
 		}
 	}
-}
\ No newline at end of file
+}
+
+// updateKey identifies the entry a repeat is folded into. Sequence numbers
+// are only unique within a stream, and a panel may watch several.
+type updateKey struct {
+	stream string
+	seq uint64
+}
+
+func repeatOf(e *dispatcher.Entry) updateKey {
+	return updateKey{stream: e.Stream, seq: e.Repeat.Of}
+}
+
+// sendUpdates sends the repeats waiting, in order
+func sendUpdates(conn *websocket.Conn, updates map[updateKey]dispatcher.Entry) error {
+	pending := make([]dispatcher.Entry, 0, len(updates))
+	for _, e := range updates {
+		pending = append(pending, e)
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].Seq < pending[j].Seq
+	})
+	for i := range pending {
+		err := conn.WriteJSON(entryMessage(&pending[i]))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// entryMessage is the message sent to the panel for an entry, or a gap
+func entryMessage(e *dispatcher.Entry) map[string]interface{} {
+	if e.Repeat != nil {
+		dat := map[string]interface{}{
+			"type": "update",
+			"seq": e.Repeat.Of,
+			"last_seq": e.Seq,
+			"repeat_count": e.Repeat.Count,
+			"first_time": int64(e.Repeat.FirstTime.UnixNano() / 1000000),
+			"last_time": int64(e.Repeat.LastTime.UnixNano() / 1000000),
+		}
+		if e.Stream != "" {
+			dat["stream"] = e.Stream
+		}
+		return dat
+	}
+	if e.Gap == nil {
+		return map[string]interface{}{"type": "log", "log": logPayload(e)}
+	}
+	dat := map[string]interface{}{
+		"type": "gap",
+		"dropped": e.Gap.Dropped,
+		"first_seq": e.Gap.FirstSeq,
+		"last_seq": e.Gap.LastSeq,
+	}
+	// Gaps from before a resume don't know when the entries were
+	if !e.Gap.From.IsZero() {
+		dat["from"] = int64(e.Gap.From.UnixNano() / 1000000)
+		dat["to"] = int64(e.Gap.To.UnixNano() / 1000000)
+	}
+	return dat
+}
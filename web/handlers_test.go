@@ -0,0 +1,413 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * handlers_test.go: Test of the websocket handler
+ */
+
+package web
+
+import (
+	"testing"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+	"github.com/gorilla/websocket"
+	"github.com/jwriteclub/weblog/dispatcher"
+	"github.com/sirupsen/logrus"
+)
+
+type discard struct{}
+func (discard) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+// dial connects a websocket client to a handler
+func dial(t *testing.T, handler func(http.ResponseWriter, *http.Request)) (*httptest.Server, *websocket.Conn) {
+	srv := httptest.NewServer(http.HandlerFunc(handler))
+	conn, _, err := websocket.DefaultDialer.Dial("ws" + strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	return srv, conn
+}
+
+// TestHandler_ConcurrentMutation is meant to be run with -race. The
+// application keeps modifying the values it logged while the handler encodes
+// them for the panel.
+func TestHandler_ConcurrentMutation(t *testing.T) {
+	d := dispatcher.NewDispatcher()
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	srv, conn := dial(t, NewWeblogHandler(d, RateLimit(0, 0)))
+	defer srv.Close()
+	defer conn.Close()
+
+	writers := &sync.WaitGroup{}
+	for w := 0; w < 4; w += 1 {
+		writers.Add(1)
+		go func(w int) {
+			defer writers.Done()
+			m := map[string]int{"w": w}
+			sl := []int{w}
+			for i := 0; i < 200; i += 1 {
+				log.WithFields(logrus.Fields{"prefix": "test", "n": i, "m": m, "s": sl}).Info("mutating")
+				m["i"] = i
+				sl = append(sl, i)
+				time.Sleep(time.Millisecond / 10)
+			}
+		}(w)
+	}
+	// The last entry is logged once the writers have finished
+	go func() {
+		writers.Wait()
+		log.WithFields(logrus.Fields{"prefix": "test"}).Info("finished")
+	}()
+	_ = conn.WriteJSON(map[string]interface{}{"type": "selector", "selector": "Prefix(test)"})
+	_ = conn.WriteJSON(map[string]interface{}{"type": "query", "id": 1, "selector": "Prefix(test)", "order": "newest"})
+
+	finished := false
+	replies := 0
+	_ = conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	for {
+		msg := make(map[string]interface{})
+		if err := conn.ReadJSON(&msg); err != nil {
+			fmt.Printf("Error: %s\n", err.Error())
+			t.Fail()
+			break
+		}
+		switch msg["type"] {
+		case "log":
+			if l, ok := msg["log"].(map[string]interface{}); ok && l["message"] == "finished\n" {
+				finished = true
+			}
+		case "query":
+			replies += 1
+		}
+		if finished && replies > 0 {
+			return
+		}
+	}
+}
+
+// expect reads messages until one of the given type arrives
+func expect(t *testing.T, conn *websocket.Conn, typ string) map[string]interface{} {
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		msg := make(map[string]interface{})
+		if err := conn.ReadJSON(&msg); err != nil {
+			t.Fatalf("waiting for %s: %s", typ, err.Error())
+		}
+		if msg["type"] == typ {
+			return msg
+		}
+	}
+}
+
+// A panel which reconnects after the dispatcher has restarted, and numbers
+// entries from the start again, mustn't be told its stream was resumed, or it
+// would ignore the new entries until they passed its old sequence numbers
+func TestHandler_ResumeRestarted(t *testing.T) {
+	d := dispatcher.NewDispatcher()
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	srv, conn := dial(t, NewWeblogHandler(d))
+	defer srv.Close()
+	defer conn.Close()
+
+	epoch := expect(t, conn, "clear")["epoch"]
+	if epoch != d.Epoch() {
+		fmt.Printf("Got epoch %v, expected %s\n", epoch, d.Epoch())
+		t.Fail()
+	}
+	log.WithField("prefix", "test").Info("before")
+	expect(t, conn, "log")
+	// The panel saw the entry before, so resuming after it is honoured
+	_ = conn.WriteJSON(map[string]interface{}{"type": "resume", "selector": "Prefix(test)", "epoch": epoch, "after": 2})
+	expect(t, conn, "resumed")
+
+	var tests = []struct {
+		name string
+		epoch interface{}
+		after uint64
+	}{
+		{"not reached", epoch, 500},
+		{"other epoch", "restarted", 2},
+		{"no epoch", nil, 2},
+	}
+	for _, test := range tests {
+		_ = conn.WriteJSON(map[string]interface{}{"type": "resume", "selector": "Prefix(test)", "epoch": test.epoch, "after": test.after})
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		for {
+			msg := make(map[string]interface{})
+			if err := conn.ReadJSON(&msg); err != nil {
+				t.Fatal(err)
+			}
+			if msg["type"] == "resumed" {
+				t.Fatalf("%s: resumed a stream which can't be", test.name)
+			}
+			if msg["type"] == "clear" {
+				break
+			}
+		}
+		// Replayed, as it would be for a new selector
+		l := expect(t, conn, "log")["log"].(map[string]interface{})
+		if l["message"] != "before\n" || l["seq"] != float64(2) {
+			fmt.Printf("%s: got %v\n", test.name, l)
+			t.Fail()
+		}
+	}
+}
+
+func TestHandler_Registry(t *testing.T) {
+	g := dispatcher.NewRegistry()
+	defer g.Close()
+	logs := make(map[string]*logrus.Logger)
+	for _, name := range []string{"jobs", "access"} {
+		d := dispatcher.NewDispatcher(dispatcher.Name(name))
+		if err := g.Add(d); err != nil {
+			t.Fatal(err)
+		}
+		logs[name] = logrus.New()
+		logs[name].Out = discard{}
+		logs[name].AddHook(d.Hook())
+	}
+	srv, conn := dial(t, NewRegistryHandler(g))
+	defer srv.Close()
+	defer conn.Close()
+
+	msg := expect(t, conn, "streams")
+	// The connection's first selector is for every stream
+	expect(t, conn, "clear")
+	if fmt.Sprint(msg["streams"]) != "[access jobs]" {
+		fmt.Printf("Got streams %v\n", msg["streams"])
+		t.Fail()
+	}
+
+	_ = conn.WriteJSON(map[string]interface{}{"type": "selector", "selector": "Prefix(test)", "streams": []string{"jobs"}})
+	expect(t, conn, "clear")
+	logs["access"].WithField("prefix", "test").Info("access")
+	logs["jobs"].WithField("prefix", "test").Info("job")
+	msg = expect(t, conn, "log")
+	l := msg["log"].(map[string]interface{})
+	if l["message"] != "job\n" || l["stream"] != "jobs" {
+		fmt.Printf("Got %v\n", l)
+		t.Fail()
+	}
+
+	_ = conn.WriteJSON(map[string]interface{}{"type": "query", "id": 1, "selector": "Prefix(test)"})
+	msg = expect(t, conn, "query")
+	if msg["error"] == nil {
+		fmt.Printf("Queried several streams at once: %v\n", msg)
+		t.Fail()
+	}
+	_ = conn.WriteJSON(map[string]interface{}{"type": "query", "id": 2, "selector": "Prefix(test)", "streams": []string{"access"}})
+	msg = expect(t, conn, "query")
+	if logs, ok := msg["logs"].([]interface{}); !ok || len(logs) != 1 {
+		fmt.Printf("Got %v\n", msg)
+		t.Fail()
+	}
+
+	// Resuming several streams starts afresh
+	_ = conn.WriteJSON(map[string]interface{}{"type": "resume", "selector": "Prefix(test)", "after": 1})
+	expect(t, conn, "clear")
+}
+
+// TestHandler_RegistryEmpty checks that a connection to a registry without
+// any streams stays open, and selects from the streams added later
+func TestHandler_RegistryEmpty(t *testing.T) {
+	g := dispatcher.NewRegistry()
+	defer g.Close()
+	srv, conn := dial(t, NewRegistryHandler(g))
+	defer srv.Close()
+	defer conn.Close()
+
+	expect(t, conn, "clear")
+	_ = conn.WriteJSON(map[string]interface{}{"type": "selector", "selector": "Prefix(test)"})
+	expect(t, conn, "clear")
+
+	d := dispatcher.NewDispatcher(dispatcher.Name("jobs"))
+	if err := g.Add(d); err != nil {
+		t.Fatal(err)
+	}
+	if msg := expect(t, conn, "streams"); fmt.Sprint(msg["streams"]) != "[jobs]" {
+		fmt.Printf("Got streams %v\n", msg["streams"])
+		t.Fail()
+	}
+	expect(t, conn, "basetime")
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	log.WithField("prefix", "other").Info("skipped")
+	log.WithField("prefix", "test").Info("job")
+	l := expect(t, conn, "log")["log"].(map[string]interface{})
+	if l["message"] != "job\n" || l["stream"] != "jobs" {
+		fmt.Printf("Got %v\n", l)
+		t.Fail()
+	}
+}
+
+func TestHandler_Dedupe(t *testing.T) {
+	d := dispatcher.NewDispatcher()
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	srv, conn := dial(t, NewWeblogHandler(d))
+	defer srv.Close()
+	defer conn.Close()
+
+	expect(t, conn, "clear")
+	_ = conn.WriteJSON(map[string]interface{}{"type": "selector", "selector": "Prefix(test) | dedupe"})
+	expect(t, conn, "clear")
+	for i := 0; i < 3; i += 1 {
+		log.WithField("prefix", "test").Warn("retrying")
+	}
+	l := expect(t, conn, "log")["log"].(map[string]interface{})
+	// Repeats are coalesced, so there may be one update or two
+	for {
+		msg := expect(t, conn, "update")
+		if msg["seq"] != l["seq"] {
+			fmt.Printf("Got %v for %v\n", msg, l)
+			t.Fail()
+		}
+		if msg["repeat_count"] == float64(3) {
+			if msg["last_seq"].(float64) != l["seq"].(float64) + 2 || msg["first_time"] != l["time"] {
+				fmt.Printf("Got %v for %v\n", msg, l)
+				t.Fail()
+			}
+			break
+		}
+	}
+}
+
+// Streams number their entries separately, so the repeats of entries with
+// the same sequence number in different streams mustn't be confused
+func TestHandler_DedupeStreams(t *testing.T) {
+	g := dispatcher.NewRegistry()
+	defer g.Close()
+	logs := make(map[string]*logrus.Logger)
+	for _, name := range []string{"jobs", "access"} {
+		d := dispatcher.NewDispatcher(dispatcher.Name(name))
+		if err := g.Add(d); err != nil {
+			t.Fatal(err)
+		}
+		logs[name] = logrus.New()
+		logs[name].Out = discard{}
+		logs[name].AddHook(d.Hook())
+	}
+	srv, conn := dial(t, NewRegistryHandler(g))
+	defer srv.Close()
+	defer conn.Close()
+
+	expect(t, conn, "clear")
+	_ = conn.WriteJSON(map[string]interface{}{"type": "selector", "selector": "Prefix(test) | dedupe"})
+	expect(t, conn, "clear")
+	for i := 0; i < 3; i += 1 {
+		logs["jobs"].WithField("prefix", "test").Warn("retrying")
+		logs["access"].WithField("prefix", "test").Warn("retrying")
+	}
+	counts := make(map[string]float64)
+	for counts["jobs"] != 3 || counts["access"] != 3 {
+		msg := expect(t, conn, "update")
+		stream, _ := msg["stream"].(string)
+		if msg["seq"] != float64(2) || (stream != "jobs" && stream != "access") {
+			fmt.Printf("Got %v\n", msg)
+			t.FailNow()
+		}
+		counts[stream] = msg["repeat_count"].(float64)
+	}
+}
+
+func TestHandler_Alerts(t *testing.T) {
+	d := dispatcher.NewDispatcher(dispatcher.Name("payments"))
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	err := d.AddAlert(dispatcher.AlertRule{Name: "errors", Expression: "level >= error", Threshold: 1, Window: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv, conn := dial(t, NewWeblogHandler(d))
+	defer srv.Close()
+	defer conn.Close()
+
+	if alerts := expect(t, conn, "alerts")["alerts"].([]interface{}); len(alerts) != 0 {
+		fmt.Printf("Got alerts %v\n", alerts)
+		t.Fail()
+	}
+	log.Error("declined")
+	log.Error("declined")
+	alerts := expect(t, conn, "alerts")["alerts"].([]interface{})
+	if len(alerts) != 1 {
+		t.Fatalf("Got alerts %v", alerts)
+	}
+	a := alerts[0].(map[string]interface{})
+	if a["rule"] != "errors" || a["stream"] != "payments" || a["count"] != float64(2) || a["window"] != float64(60) {
+		fmt.Printf("Got alert %v\n", a)
+		t.Fail()
+	}
+	d.RemoveAlert("errors")
+	if alerts := expect(t, conn, "alerts")["alerts"].([]interface{}); len(alerts) != 0 {
+		fmt.Printf("Got alerts %v\n", alerts)
+		t.Fail()
+	}
+}
+
+// TestHandler_RegistryAlerts checks that alerts are sent for a stream added
+// after the connection was opened
+func TestHandler_RegistryAlerts(t *testing.T) {
+	g := dispatcher.NewRegistry()
+	defer g.Close()
+	srv, conn := dial(t, NewRegistryHandler(g))
+	defer srv.Close()
+	defer conn.Close()
+	expect(t, conn, "alerts")
+
+	d := dispatcher.NewDispatcher(dispatcher.Name("payments"))
+	if err := g.Add(d); err != nil {
+		t.Fatal(err)
+	}
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	err := d.AddAlert(dispatcher.AlertRule{Name: "errors", Expression: "level >= error", Threshold: 1, Window: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	log.Error("declined")
+	log.Error("declined")
+	for {
+		alerts := expect(t, conn, "alerts")["alerts"].([]interface{})
+		if len(alerts) == 1 && alerts[0].(map[string]interface{})["stream"] == "payments" {
+			break
+		}
+		if len(alerts) != 0 {
+			t.Fatalf("Got alerts %v", alerts)
+		}
+	}
+}
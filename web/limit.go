@@ -0,0 +1,173 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * limit.go: Rate limiting of the entries sent to a panel
+ */
+
+package web
+
+import (
+	"github.com/jwriteclub/weblog/dispatcher"
+	"math"
+	"strings"
+	"time"
+)
+
+// HandlerOption configures a websocket handler, see NewWeblogHandler
+type HandlerOption func(*handlerConfig)
+
+type handlerConfig struct {
+	rate float64
+	burst int
+	ceiling float64
+	pauseBuffer int
+}
+
+// The burst allowed when a panel asks for a limit, and none is configured,
+// see RateLimit
+const defaultBurst = 400
+
+func defaultConfig() handlerConfig {
+	return handlerConfig{burst: defaultBurst, pauseBuffer: defaultPauseBuffer}
+}
+
+// RateLimit limits each panel to perSecond entries a second, after an initial
+// burst. Entries over the limit aren't sent, but summarised every second. Zero
+// is unlimited, which is the default, though a panel may still ask for a
+// limit of its own.
+func RateLimit(perSecond float64, burst int) HandlerOption {
+	return func(c *handlerConfig) {
+		if perSecond >= 0 {
+			c.rate = perSecond
+		}
+		if burst >= 0 {
+			c.burst = burst
+		}
+	}
+}
+
+// RateCeiling is the highest rate limit a panel may ask for. Zero, the
+// default, allows it to ask for no limit at all.
+func RateCeiling(perSecond float64) HandlerOption {
+	return func(c *handlerConfig) {
+		if perSecond >= 0 {
+			c.ceiling = perSecond
+		}
+	}
+}
+
+// limiter is a token bucket limiting the entries sent to a panel. Entries
+// over the limit are counted in a summary instead. It's only used by the
+// handler's main loop.
+type limiter struct {
+	rate float64
+	burst float64
+	ceiling float64
+	// The burst configured for the handler
+	baseBurst int
+	tokens float64
+	last time.Time
+	sup *suppressed
+}
+
+// suppressed summarises the entries which weren't sent
+type suppressed struct {
+	count uint64
+	levels map[string]uint64
+	from time.Time
+	to time.Time
+}
+
+func newLimiter(c handlerConfig) *limiter {
+	l := &limiter{ceiling: c.ceiling, baseBurst: c.burst}
+	l.set(c.rate, c.burst)
+	return l
+}
+
+// set changes the limit, filling the bucket. The burst is at least a second's
+// worth of entries.
+func (l *limiter) set(rate float64, burst int) {
+	l.rate = rate
+	l.burst = math.Max(float64(burst), math.Ceil(rate))
+	l.tokens = l.burst
+	l.last = time.Time{}
+}
+
+// request sets the limit a panel asked for, within the ceiling. Zero asks for
+// no limit.
+func (l *limiter) request(rate float64) {
+	if l.ceiling > 0 && (rate <= 0 || rate > l.ceiling) {
+		rate = l.ceiling
+	}
+	if rate < 0 {
+		rate = 0
+	}
+	l.set(rate, l.baseBurst)
+}
+
+// allow reports whether an entry may be sent, counting it in the summary if
+// not
+func (l *limiter) allow(e *dispatcher.Entry, now time.Time) bool {
+	if l.rate <= 0 {
+		return true
+	}
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens + now.Sub(l.last).Seconds() * l.rate)
+	}
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens -= 1
+		return true
+	}
+	if l.sup == nil {
+		l.sup = &suppressed{levels: make(map[string]uint64), from: e.Time, to: e.Time}
+	}
+	l.sup.count += 1
+	l.sup.levels[strings.ToLower(e.Level.String())] += 1
+	if e.Time.Before(l.sup.from) {
+		l.sup.from = e.Time
+	}
+	if e.Time.After(l.sup.to) {
+		l.sup.to = e.Time
+	}
+	return false
+}
+
+func (l *limiter) pending() bool {
+	return l.sup != nil
+}
+
+// summary returns the message for the entries suppressed since the last one,
+// and starts a new summary
+func (l *limiter) summary() map[string]interface{} {
+	sup := l.sup
+	l.sup = nil
+	return map[string]interface{}{
+		"type": "suppressed",
+		"count": sup.count,
+		"levels": sup.levels,
+		"from": int64(sup.from.UnixNano() / 1000000),
+		"to": int64(sup.to.UnixNano() / 1000000),
+	}
+}
+
+// message describes the limit to the panel
+func (l *limiter) message() map[string]interface{} {
+	return map[string]interface{}{"type": "ratelimit", "rate": l.rate, "burst": l.burst, "ceiling": l.ceiling}
+}
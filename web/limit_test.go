@@ -0,0 +1,140 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * limit_test.go: Test of the panel rate limit
+ */
+
+package web
+
+import (
+	"testing"
+	"fmt"
+	"time"
+	"github.com/jwriteclub/weblog/dispatcher"
+	"github.com/sirupsen/logrus"
+)
+
+func TestLimiter(t *testing.T) {
+	base := time.Now()
+	entry := func(level logrus.Level, s int) *dispatcher.Entry {
+		return &dispatcher.Entry{Entry: logrus.Entry{Level: level, Time: base.Add(time.Duration(s) * time.Second)}}
+	}
+	l := newLimiter(handlerConfig{rate: 1, burst: 2, ceiling: 10})
+	var tests = []struct {
+		e *dispatcher.Entry
+		allowed bool
+	}{
+		{entry(logrus.InfoLevel, 0), true},
+		{entry(logrus.InfoLevel, 0), true},
+		{entry(logrus.InfoLevel, 0), false},
+		{entry(logrus.ErrorLevel, 0), false},
+		{entry(logrus.InfoLevel, 1), true},
+		{entry(logrus.InfoLevel, 1), false},
+	}
+	for i, test := range tests {
+		if l.allow(test.e, test.e.Time) != test.allowed {
+			fmt.Printf("Entry %d: expected allowed %v\n", i, test.allowed)
+			t.Fail()
+		}
+	}
+	if !l.pending() {
+		t.Fatal("nothing suppressed")
+	}
+	sum := l.summary()
+	levels := sum["levels"].(map[string]uint64)
+	if sum["count"] != uint64(3) || levels["info"] != 2 || levels["error"] != 1 || sum["to"].(int64) - sum["from"].(int64) != 1000 || l.pending() {
+		fmt.Printf("Summary %v\n", sum)
+		t.Fail()
+	}
+
+	var requests = []struct {
+		rate float64
+		applied float64
+	}{
+		{5, 5},
+		{50, 10},
+		{0, 10},
+		{0.5, 0.5},
+	}
+	for _, req := range requests {
+		l.request(req.rate)
+		if l.rate != req.applied || l.burst < 2 {
+			fmt.Printf("Asked for %v, got %v (burst %v)\n", req.rate, l.rate, l.burst)
+			t.Fail()
+		}
+	}
+	unlimited := newLimiter(handlerConfig{rate: 1, ceiling: 0})
+	unlimited.request(0)
+	for i := 0; i < 100; i += 1 {
+		if !unlimited.allow(entry(logrus.InfoLevel, 0), base) {
+			t.Fatal("limited without a ceiling")
+		}
+	}
+
+	// Without options, panels aren't limited unless they ask to be
+	def := newLimiter(defaultConfig())
+	for i := 0; i < 1000; i += 1 {
+		if !def.allow(entry(logrus.InfoLevel, 0), base) {
+			t.Fatal("limited by default")
+		}
+	}
+	def.request(500)
+	if def.rate != 500 || def.burst != 500 {
+		fmt.Printf("Asked for 500, got %v (burst %v)\n", def.rate, def.burst)
+		t.Fail()
+	}
+}
+
+func TestHandler_RateLimit(t *testing.T) {
+	d := dispatcher.NewDispatcher()
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	srv, conn := dial(t, NewWeblogHandler(d, RateLimit(0.01, 2), RateCeiling(100)))
+	defer srv.Close()
+	defer conn.Close()
+
+	msg := expect(t, conn, "ratelimit")
+	if msg["rate"] != float64(0.01) || msg["ceiling"] != float64(100) {
+		fmt.Printf("Got %v\n", msg)
+		t.Fail()
+	}
+	// The first selector is sent the dispatcher's start, using one of the
+	// burst of two
+	expect(t, conn, "clear")
+	expect(t, conn, "log")
+	_ = conn.WriteJSON(map[string]interface{}{"type": "selector", "selector": "Prefix(test)"})
+	expect(t, conn, "clear")
+	for i := 0; i < 10; i += 1 {
+		log.WithField("prefix", "test").Warn("flood")
+	}
+	msg = expect(t, conn, "suppressed")
+	if msg["count"] != float64(9) || msg["levels"].(map[string]interface{})["warning"] != float64(9) {
+		fmt.Printf("Got %v\n", msg)
+		t.Fail()
+	}
+
+	_ = conn.WriteJSON(map[string]interface{}{"type": "ratelimit", "rate": 1000})
+	msg = expect(t, conn, "ratelimit")
+	if msg["rate"] != float64(100) {
+		fmt.Printf("Got %v\n", msg)
+		t.Fail()
+	}
+}
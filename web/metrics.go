@@ -0,0 +1,157 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * metrics.go: Prometheus and expvar statistics
+ */
+
+package web
+
+import (
+	"bytes"
+	"expvar"
+	"fmt"
+	"github.com/jwriteclub/weblog/dispatcher"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// NewMetricsHandler serves the statistics of d in the Prometheus text format
+func NewMetricsHandler(d *dispatcher.Dispatcher) func(http.ResponseWriter, *http.Request) {
+	return newMetricsHandler(source{d: d})
+}
+
+// NewRegistryMetricsHandler is like NewMetricsHandler, for every stream of a
+// registry, which are labelled with their name
+func NewRegistryMetricsHandler(g *dispatcher.Registry) func(http.ResponseWriter, *http.Request) {
+	return newMetricsHandler(source{g: g})
+}
+
+// StatsVar returns the statistics of d as an expvar.Var, to be published with
+// expvar.Publish
+func StatsVar(d *dispatcher.Dispatcher) expvar.Var {
+	return statsVar(source{d: d})
+}
+
+// RegistryStatsVar is like StatsVar, for every stream of a registry
+func RegistryStatsVar(g *dispatcher.Registry) expvar.Var {
+	return statsVar(source{g: g})
+}
+
+func statsVar(src source) expvar.Var {
+	return expvar.Func(func() interface{} {
+		return src.stats()
+	})
+}
+
+func (src source) stats() []dispatcher.Stats {
+	ret := make([]dispatcher.Stats, 0)
+	for _, d := range src.dispatchers() {
+		ret = append(ret, d.Stats())
+	}
+	return ret
+}
+
+// metric is a Prometheus metric of a dispatcher
+type metric struct {
+	name string
+	typ string
+	help string
+	value func(st *dispatcher.Stats) float64
+}
+
+var metrics = []metric{
+	{"weblog_ingested_total", "counter", "Entries dispatched.", func(st *dispatcher.Stats) float64 { return float64(st.Ingested) }},
+	{"weblog_ingest_rate", "gauge", "Entries dispatched per second, recently.", func(st *dispatcher.Stats) float64 { return st.IngestRate }},
+	{"weblog_ingest_queue_length", "gauge", "Entries waiting to be dispatched.", func(st *dispatcher.Stats) float64 { return float64(st.IngestQueue) }},
+	{"weblog_ingest_queue_capacity", "gauge", "Entries which can wait to be dispatched.", func(st *dispatcher.Stats) float64 { return float64(st.IngestCapacity) }},
+	{"weblog_ingest_dropped_total", "counter", "Entries discarded by the hook.", func(st *dispatcher.Stats) float64 { return float64(st.Dropped) }},
+	{"weblog_history_entries", "gauge", "Entries held in the history.", func(st *dispatcher.Stats) float64 { return float64(st.History.Entries) }},
+	{"weblog_history_bytes", "gauge", "Estimated size of the history.", func(st *dispatcher.Stats) float64 { return float64(st.History.Bytes) }},
+	{"weblog_selectors", "gauge", "Registered selectors.", func(st *dispatcher.Stats) float64 { return float64(len(st.Selectors)) }},
+	{"weblog_selector_queue_length", "gauge", "Entries waiting to be read by every selector.", func(st *dispatcher.Stats) float64 {
+		return sumSelectors(st, func(sel *dispatcher.SelectorStats) int { return sel.Queue })
+	}},
+	{"weblog_selector_queue_capacity", "gauge", "Entries which can wait to be read by every selector.", func(st *dispatcher.Stats) float64 {
+		return sumSelectors(st, func(sel *dispatcher.SelectorStats) int { return sel.Capacity })
+	}},
+	{"weblog_selector_queue_fill_max", "gauge", "The largest fraction of a selector's queue in use.", maxFill},
+	{"weblog_selector_delivered_total", "counter", "Matching entries delivered to selectors.", func(st *dispatcher.Stats) float64 { return float64(st.Delivered) }},
+	{"weblog_selector_dropped_total", "counter", "Matching entries dropped as a selector's queue was full.", func(st *dispatcher.Stats) float64 { return float64(st.SelectorDropped) }},
+}
+
+// sumSelectors adds up a statistic of every selector. Selectors come and go
+// with each panel and query, so they aren't given series of their own.
+func sumSelectors(st *dispatcher.Stats, value func(sel *dispatcher.SelectorStats) int) (ret float64) {
+	for i := range st.Selectors {
+		ret += float64(value(&st.Selectors[i]))
+	}
+	return
+}
+
+// maxFill is the fullest selector queue, as a fraction of its capacity
+func maxFill(st *dispatcher.Stats) (ret float64) {
+	for _, sel := range st.Selectors {
+		if sel.Capacity > 0 && float64(sel.Queue) / float64(sel.Capacity) > ret {
+			ret = float64(sel.Queue) / float64(sel.Capacity)
+		}
+	}
+	return
+}
+
+func newMetricsHandler(src source) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_, _ = w.Write(prometheus(src.stats()))
+	}
+}
+
+// prometheus renders statistics in the Prometheus text exposition format
+func prometheus(stats []dispatcher.Stats) []byte {
+	buf := &bytes.Buffer{}
+	for _, m := range metrics {
+		fmt.Fprintf(buf, "# HELP %s %s\n# TYPE %s %s\n", m.name, m.help, m.name, m.typ)
+		for i := range stats {
+			fmt.Fprintf(buf, "%s%s %s\n", m.name, labels("stream", stats[i].Stream), number(m.value(&stats[i])))
+		}
+	}
+	return buf.Bytes()
+}
+
+// labelEscaper escapes a label value
+var labelEscaper = strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n")
+
+// labels formats pairs of label names and values, leaving out empty values
+func labels(pairs ...string) string {
+	parts := make([]string, 0)
+	for i := 0; i + 1 < len(pairs); i += 2 {
+		if pairs[i + 1] == "" {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s=\"%s\"", pairs[i], labelEscaper.Replace(pairs[i + 1])))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func number(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,87 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * metrics_test.go: Test of the metrics handler
+ */
+
+package web
+
+import (
+	"testing"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"time"
+	"github.com/jwriteclub/weblog/dispatcher"
+	"github.com/sirupsen/logrus"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	d := dispatcher.NewDispatcher(dispatcher.Name("jobs"))
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	s, err := dispatcher.NewSelector(`Prefix("te\"st")`, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	log.WithField("prefix", "te\"st").Info("one")
+	deadline := time.Now().Add(time.Second)
+	for d.Stats().Ingested < 1 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	rec := httptest.NewRecorder()
+	NewMetricsHandler(d)(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := ioutil.ReadAll(rec.Body)
+	if !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain; version=0.0.4") {
+		fmt.Printf("Content type %s\n", rec.Header().Get("Content-Type"))
+		t.Fail()
+	}
+	for _, line := range []string{
+		"# TYPE weblog_ingested_total counter",
+		`weblog_ingested_total{stream="jobs"} 1`,
+		`weblog_selectors{stream="jobs"} 1`,
+		`weblog_history_entries{stream="jobs"} 2`,
+		`weblog_selector_delivered_total{stream="jobs"} 1`,
+		`weblog_selector_queue_length{stream="jobs"} 1`,
+		`weblog_selector_queue_fill_max{stream="jobs"} 0.015625`,
+	} {
+		if !strings.Contains(string(body), line + "\n") {
+			fmt.Printf("Missing %s in\n%s", line, body)
+			t.Fail()
+		}
+	}
+	// Queries and selectors would make series for every one a panel tries
+	if strings.Contains(string(body), "query=") || strings.Contains(string(body), "selector=") {
+		fmt.Printf("Labelled by query or selector:\n%s", body)
+		t.Fail()
+	}
+
+	stats := make([]dispatcher.Stats, 0)
+	err = json.Unmarshal([]byte(StatsVar(d).String()), &stats)
+	if err != nil || len(stats) != 1 || stats[0].Stream != "jobs" || stats[0].Ingested != 1 {
+		fmt.Printf("Got %v %#v\n", err, stats)
+		t.Fail()
+	}
+}
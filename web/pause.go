@@ -0,0 +1,97 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * pause.go: Holding entries while a panel is paused
+ */
+
+package web
+
+import (
+	"github.com/jwriteclub/weblog/dispatcher"
+)
+
+// The default number of entries held for a paused panel, see PauseBuffer
+const defaultPauseBuffer = 1000
+
+// PauseBuffer sets the number of entries held for a paused panel. Entries
+// arriving once it's full are only counted, and reported as a gap when the
+// panel unpauses. The default is 1000.
+func PauseBuffer(n int) HandlerOption {
+	return func(c *handlerConfig) {
+		if n >= 0 {
+			c.pauseBuffer = n
+		}
+	}
+}
+
+// pause holds the entries for a paused panel, up to a cap, and counts those
+// over it. It's only used by the handler's main loop.
+type pause struct {
+	cap int
+	paused bool
+	held []dispatcher.Entry
+	// The entries dropped once the buffer was full
+	gap dispatcher.Gap
+}
+
+func newPause(c handlerConfig) *pause {
+	return &pause{cap: c.pauseBuffer}
+}
+
+// hold keeps an entry, or gap, to send on unpausing, or counts it if the
+// buffer is full
+func (p *pause) hold(e dispatcher.Entry) {
+	if len(p.held) < p.cap {
+		p.held = append(p.held, e)
+		return
+	}
+	dropped, first, last, from, to := uint64(1), e.Seq, e.Seq, e.Time, e.Time
+	if e.Gap != nil {
+		dropped, first, last, from, to = e.Gap.Dropped, e.Gap.FirstSeq, e.Gap.LastSeq, e.Gap.From, e.Gap.To
+	}
+	if p.gap.Dropped == 0 {
+		p.gap.FirstSeq = first
+		p.gap.From = from
+	}
+	p.gap.Dropped += dropped
+	p.gap.LastSeq = last
+	if !to.IsZero() {
+		p.gap.To = to
+	}
+}
+
+// unpause ends the pause, returning the entries held, followed by a gap for
+// those dropped, if any were
+func (p *pause) unpause() []dispatcher.Entry {
+	held := p.held
+	if p.gap.Dropped > 0 {
+		gap := p.gap
+		held = append(held, dispatcher.Entry{Seq: gap.LastSeq, Gap: &gap})
+	}
+	p.paused = false
+	p.held = nil
+	p.gap = dispatcher.Gap{}
+	return held
+}
+
+// message tells the panel whether it's paused, and how many entries are held
+// and dropped
+func (p *pause) message() map[string]interface{} {
+	return map[string]interface{}{"type": "paused", "paused": p.paused, "held": len(p.held), "dropped": p.gap.Dropped}
+}
@@ -0,0 +1,165 @@
+/**
+ * Weblog
+ *
+ *    Copyright 2019 Christopher O'Connell
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * For any questions, please contact jwriteclub@gmail.com
+ *
+ * pause_test.go: Test of holding entries while a panel is paused
+ */
+
+package web
+
+import (
+	"testing"
+	"fmt"
+	"time"
+	"github.com/jwriteclub/weblog/dispatcher"
+	"github.com/sirupsen/logrus"
+)
+
+func TestPause(t *testing.T) {
+	base := time.Now()
+	entry := func(seq uint64) dispatcher.Entry {
+		return dispatcher.Entry{Entry: logrus.Entry{Time: base.Add(time.Duration(seq) * time.Second)}, Seq: seq}
+	}
+	p := newPause(handlerConfig{pauseBuffer: 2})
+	p.paused = true
+	for seq := uint64(1); seq <= 3; seq += 1 {
+		p.hold(entry(seq))
+	}
+	// A gap from the selector's queue, once the buffer is full
+	p.hold(dispatcher.Entry{Seq: 6, Gap: &dispatcher.Gap{Dropped: 2, FirstSeq: 5, LastSeq: 6, From: base.Add(5 * time.Second), To: base.Add(6 * time.Second)}})
+	if msg := p.message(); msg["held"] != 2 || msg["dropped"] != uint64(3) || msg["paused"] != true {
+		fmt.Printf("Got %v\n", msg)
+		t.Fail()
+	}
+
+	held := p.unpause()
+	if len(held) != 3 || held[0].Seq != 1 || held[1].Seq != 2 || held[2].Gap == nil {
+		t.Fatalf("Got %#v", held)
+	}
+	gap := held[2].Gap
+	if gap.Dropped != 3 || gap.FirstSeq != 3 || gap.LastSeq != 6 || !gap.From.Equal(base.Add(3 * time.Second)) || !gap.To.Equal(base.Add(6 * time.Second)) {
+		fmt.Printf("Got %#v\n", gap)
+		t.Fail()
+	}
+	if p.paused || len(p.unpause()) != 0 {
+		fmt.Printf("Still paused\n")
+		t.Fail()
+	}
+}
+
+func TestHandler_Pause(t *testing.T) {
+	d := dispatcher.NewDispatcher()
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	srv, conn := dial(t, NewWeblogHandler(d, PauseBuffer(2)))
+	defer srv.Close()
+	defer conn.Close()
+
+	_ = conn.WriteJSON(map[string]interface{}{"type": "selector", "selector": "Prefix(test)"})
+	expect(t, conn, "clear")
+	expect(t, conn, "clear")
+	_ = conn.WriteJSON(map[string]interface{}{"type": "pause"})
+	if msg := expect(t, conn, "paused"); msg["paused"] != true {
+		t.Fatalf("Got %v", msg)
+	}
+	for i := 0; i < 5; i += 1 {
+		log.WithField("prefix", "test").Info(fmt.Sprint(i))
+	}
+	// The panel is told how many are held while it's paused
+	for {
+		msg := expect(t, conn, "paused")
+		if msg["paused"] != true {
+			t.Fatalf("Got %v", msg)
+		}
+		if msg["held"] == float64(2) && msg["dropped"] == float64(3) {
+			break
+		}
+	}
+
+	_ = conn.WriteJSON(map[string]interface{}{"type": "unpause"})
+	if msg := expect(t, conn, "paused"); msg["paused"] != false {
+		t.Fatalf("Got %v", msg)
+	}
+	for i := 0; i < 2; i += 1 {
+		msg := expect(t, conn, "log")
+		if l := msg["log"].(map[string]interface{}); l["message"] != fmt.Sprintf("%d\n", i) {
+			fmt.Printf("Got %v\n", l)
+			t.Fail()
+		}
+	}
+	if msg := expect(t, conn, "gap"); msg["dropped"] != float64(3) {
+		fmt.Printf("Got %v\n", msg)
+		t.Fail()
+	}
+	log.WithField("prefix", "test").Info("live")
+	if msg := expect(t, conn, "log"); msg["log"].(map[string]interface{})["message"] != "live\n" {
+		fmt.Printf("Got %v\n", msg)
+		t.Fail()
+	}
+}
+
+// Entries held while paused are rate limited when they're sent, like any
+// others
+func TestHandler_PauseRateLimit(t *testing.T) {
+	d := dispatcher.NewDispatcher()
+	defer d.Stop()
+	log := logrus.New()
+	log.Out = discard{}
+	log.AddHook(d.Hook())
+	srv, conn := dial(t, NewWeblogHandler(d, PauseBuffer(10), RateLimit(0.01, 2)))
+	defer srv.Close()
+	defer conn.Close()
+
+	_ = conn.WriteJSON(map[string]interface{}{"type": "selector", "selector": "Prefix(test)"})
+	expect(t, conn, "clear")
+	expect(t, conn, "clear")
+	_ = conn.WriteJSON(map[string]interface{}{"type": "pause"})
+	if msg := expect(t, conn, "paused"); msg["paused"] != true {
+		t.Fatalf("Got %v", msg)
+	}
+	for i := 0; i < 5; i += 1 {
+		log.WithField("prefix", "test").Info(fmt.Sprint(i))
+	}
+	for {
+		if msg := expect(t, conn, "paused"); msg["held"] == float64(5) {
+			break
+		}
+	}
+	_ = conn.WriteJSON(map[string]interface{}{"type": "unpause"})
+	logs := 0
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		msg := make(map[string]interface{})
+		if err := conn.ReadJSON(&msg); err != nil {
+			t.Fatal(err)
+		}
+		if msg["type"] == "log" {
+			logs += 1
+		}
+		if msg["type"] == "suppressed" {
+			// The connection's first selector may have used some of the burst
+			if logs > 2 || float64(logs) + msg["count"].(float64) != 5 {
+				fmt.Printf("Sent %d, then %v\n", logs, msg)
+				t.Fail()
+			}
+			break
+		}
+	}
+}
@@ -8,6 +8,4 @@ import (
         "github.com/markbates/pkger/pkging/mem"
 )
 
-var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecbd69b3a3389330fa57dea8af4fcf332c769d727ff3728ced63f01c6f2cb76fbc61c0658cc1f694170c13f3df6f4808104262f171f53c71633e54741f03522a95ca4ce5fa5fdff6c79fa7cbb73fffebdb6e7f756ee63fad93ffef6ef06b7fdd5adecdfcf7606b7aa71d783ed8fffaf6e7b7fe9f7ffdb5ba6c7f5dfefaabeffcda5faea7b3b3fdf5d75f839375f3b7c72bf8fd646ffffa0b7df8c7b7b17f3efdbafec7e6ea7cfbb37c923fbe291b7ffbedcf6fe9df8393f5edcf6fdffef8b6dcfcda6daff1ffcf4fa7eb5390c89babe57cfbf3fff9f6cf6fffef1fdf16d78db7fdf6e7f5d76d8bfe986f3797d3f1db9fdfccdbdeb3ffcf78f07ffcfdc5871ffdf14d3a0df7def6023e8f07fce7ee0486899777f9f6e7f1e6797f7c1b6ccfe9ff2fb7976bfa59f613f1857cb26f0090fffa560f47f2667f4cc07e764fa4937cb29b7ff9d75fbbd33ffd930d47586f7f5df6105dfc3f79f1db7ffff77ffff1ed67bcd67262fa13fc17fdffbf3957df031f003204ffb5b7d7cdde83631c7162885ffce3db651f6dbffdd9e23adffff8e69fecedb73f05bef5d6fad1e2db1df8cbffbdeee16702c777fe8d17fe4de0965ce7cf76e74ff1fb3f7ff05c877b13fec1097f72dcb73fbeed2fffd706188c917909e1b460bfbad7ebafbd79bb82c5f0dffff8d6ffb5dd5cf7a7e3128efd5fdfa6a760b0b96ee33fdfda3f3a6fed1f3f7efcf16db4df39d903917bfb210ac2db7ffff16dbab95cbb96b5bd5ca843f03ff81fc28f1f7cab451fa325a2315480cbe787006b5beca32d78e1db9f5cf6c3f414c45885dbe86c7ffdffecc49742f1bfcce0f5cce08f6f67b880fffaf61f875d6d32287086fffee39bbdb96e12f49c37bfb6c76b366af6259cb211d7f977eb7229e73ce08584e370cdd8cd8f3f5bad7ffef8c18bedf66fe0363fc48ef0f6056e23747e743ac27741789edbd419a29cdb70ffcb6afe97d5fcebb01ac80e7e33bbf977f3e6f99b7fe3fef9f64ffe9ffefef8cf4a1644fb2061497cfbc7db5b27e14b2df1079321fdf89313ffe47ffcb3238a3fb81f45fde7e7c6bbb0599228d460491df147877b9e25d51d82cd91ea8c50ce90627c7e992bc1ff267fc0adffebaf78db72cc2aa3a5f8611937cad84b4c978885a06dcbf3109c65c46fff2fa7783da7a09ee4947b7c9b8627672c4d7853e29da9efddc7233d98bad64d5ef47e8cdff9c9badf9337aae29947c51b8f829d2178dc66b4de4f7de56e2e3aff6988f393a9c977fdb8be98eafbee63dfe94e35dbd3fdebd9eef3bf0ccdf32d7f783534796789bdb3791886a6b8be19a3ab6ff99deb36e445439dfcda689f577b3471cca3e29be2e43a3b4eeeb634e48cc52ef8585e39cb1fde2cc1b85b3ef7ddf23b9caecdbd8f41f7fc539bdf6b8c11294bef60a8d67926ce435d6d4786df09cde53934c5b9634bab931cadda86b43e7e687ce753ba7a5bd5087595f7acb017599277fbd426775b6d1fb682119a02ff86ad91091b73aebed7b1a54e8d31647ea6ce3d233cbc55adeba7c6ddf4e3c4d3d5b96b1dd7577d1fdcf4e39ab3a5ce6dda6f1f0cf5e1d9d2ea32f58db309ffeb45536d7db384f5c550154e5727176311dc4cb573d0d5e0aa0b8fbb25ac2ed363cf3104efa66bf3b329b4ae86fab86cb45eb4d1e4c23353f26e9b30b859d2f0b851db8e2d797773cfdf0ccde1a6fd76a0abf6d9f487dc46eddca65a2f3434e36c8aeb68da6f83f9a3dc3e4a1d5e1776575d185e4ca9c3db83f3cd14dade0c7f47533c6bcf47606dbaf8f9ddf43b376379b99a6ae7fb545b478636be26eb9bf9edbbe9afdea62a1fb19e51c7d3b89be90f05dd1f8653f57136fdcbf7993ff46d691d4efb6dfc5deea3df3e5b217fb5d507b7d17a176371c07fbf98c2f0b051dbc78fe5e9bbae1a9ca14dde74bf131ad23a9cb94ab0edf72253789c8d7e2fa607b1e758fefa660fae7b539c1c20ccfef0b0d1d6d1cc9d04dbdcefde7133fafc2e63bfd9522798b9dd375de8dc6c098c73da8ffbe33743f2224b7a38dbe5796f4a1d77135d4f861a7f3f75f55051d7d799c03b966f9fcd3dcf9962f7fb74d1e2e4c8e20d957bb3a4ced93cce01ae3c1bc0379211eeaf81292a67b8efeed9d18fcadd1cad396379856328cb6e7effe8347d5506ebd2b33a5d7ef2f0ac2e2f575b58ef3782c74d35ecdd057f87f8505bdf75610dd61ee6c6a23eb7cfc6687e9aed5b82ecae3d139c27ff71d7854bbc8783f345d7146eaa2aa78dfa387c2c829bee775a8076ecfeeebb290d23bbcfbb1bc9bb18fdc365ea4f42431d1e7471c29bea983d46ba37e79b297e9e66fec3b1c4cfab2e386753fa3c4ffbedd346b54fa614ef0f6b1c535a0b861ab09fabebc812864763c99ce7668af3b3e17b2e3acfccb1acd1e46e8813cf1265f63be27a6f4b1e674a2be63bb634dc9bd2ea1ae3607836fd75c8c4e771eee8fec32b7d7e64c3530a8b76bdeada04d0c355f73b80675e67eefb4dd126900e0c697d31b4b937edb7afa63077cc3eef9a52273234c46bd46160496b079c595d58a57c28a54deaf3eb5e57955f8618cb95a9aadc4da913ce8e93a3eebf9fe465f792fc9bbae3f34c98dc2d7fee592ee283c9df7b3ed4d5f9d9d6e4efb27b0894c14eb046bb375d5c879600c6ebdd376a9b33b4dcb93f9b3ee065ab2ba2f137c37f78db3e7fb4fc8e087806fa3bda8c2690e7c983ab6f0a6d6eaa29e7adbf027fa73c43767b80175d756d77dd48ebb32138dccc1d07000e531db6a61a3a5be96fde6daa3a1ea06b7b700ae551af351378c06bc48d3ae736e96f1dde96209c603e9cb78486fa00f245309657c1d026dc4655c0be38e09c98ee19f09b375bf00eb6b48be11b65fb3873cf8e01f95780ffb63754207f816ce4ef8634bc4c55e5628a4aee7d423e7e9ff9130ff0144363ece75ef10d5fcff360f77ad8684a604ac3f6cc9f5c4c41f935131e9e71fc8c79a4d8bb5b40cf523bb7993fdc5b42e7620318c5f9dd1a9c78b9bf62ea0e88a75e80fea0f6775730dec702c91301e06c6d7cf43930c6793c4a792ea750f58c98679ae09df5ee345dae82c9e2b0cbcdb148e748f973cabf19bc7eaa76c20dd005dcb36bc4b4b9abcba7c712d045e79e75e4eaee1d7ab67ecb6813cace84d678b9bfee4cfdb9674a405625b279cca6836346fbf17e25f43dc9d3996fdcf558bf49f4afef1ba92350c7cedecdf398e5f9648a0698b301bf90cf3f17d99cbaaa701bcd28f2afe5d9d1c5f9d9f6d76fba3f7437821debe6e039a06f70ce8489f331780fa6c9bf7e8bfbd0b8db4693af9600ce5b80f347a0e744a63abc98055e89f18ba541e117ef42915f24bfe5f8c5433916f845fc9b887fe774a08eabf29e2179bcb9c075e0dcef6c38dd398daf7114bec6d1f89a42e16bf03711ff2e81f301f42d2fa5c34500716c4ac3d0105604dfc169590e5378b2b92339c691b3eda77327bf0178b23546f02c5c81ec4bf965943b1ff0bb9f8bf6ded6e69c29b4105c1dc790bc9b11e1e745b99bc779680a41c93b8fbbaec6bc34d1bfd1fac1ef67d3b752fd1abf0fe86afb6caaf1dd617654828ddaee597e8737fdcfddd45d4763308fefdd36dadc1b4399b1762cf7ea98be774df499dcfdb3dfbd299abcdba86d7fa3b6cfb6b4da81fb8ba10ec34cd69e006e7780ff6ed4cfdd5898ef0d6972d05d9a3e3e6ecb83cf564c9b996c86fb919d27a65e0f74f5ec4cc73cd0027bafb6b99900f1ea99c7cfefe37db9fe2e03dd1d971fc78907f83738ff19dd0da15eb391d6a119f2c2469d7b6618dc362aef005a037b272f8dfd7679d86d54fe08ceb5a90e8fc6029c6feea11f0f8fb1e45d0df21b75d296f7bdb3a95ae08c5d7515d0b3174d97c3fd7629d3c60a9963f993b61cd2c652f6dbe53b6dac483f1e42ea58c2a4adf45963ad686371faf110d1c652a4495b59d0c65ab3d6c8ebc703471b6b06f0b5d429dff091a5017d3183cd0af9bbedaf43a033647c1cd34770fe3d027405ce2a07f4a68ba11a1cd0e92ca9136dd05900f76d5378f0b6b47ac3ee8ef09e268f38dc76e06fd4873755154e5f04e08c5d8c057fd3551ef27bf3d8e3ed7ee1ceef99d2e36c8d94b355b4075c366afb57c11ea0b6bdede8b36053b04613cff6bdbb0ded0d0ae08daeddef25f76ea0f77bdbd11c9c27671bf257533c5d75ad178073acab6d17de7708bb01f5b930bc6c54fb36553dce50f968e6233d233bebdf65add71a8fc019f50ee31179468bfa78ccff2777a8474bc383295a89ee8fe97fde0df29d05ef9bd2ba35138cbb79fcbc5a82f7dd589e1e960feee2994c99ba637807df1cd7912d79fe7601784b9b8330f8c6c5d076574b9c3bd6f1f30d8d9bf186458b9f893dc790209fcdee3abee298927c9abab208eefee33eb7036bfd585e035d9d439bc5548df5535d185ef4707753623876d345f7618d76677cdc849eeabc0b6d1425f337d3f97b9da93fe1edd13cb63f40dd06c8fbf437df14141ed024a263d796de71fd28a3f565e15d48f3337fe8421816e4efa9fe169f89013ebf77b3466b0e7fc70af9e4397ed672bf6330229d25993b81b3ed98ea8a7c969e49c063d2b595d9f1f2df13e712dabc2ade4167b7ec9dbcbd2fb74e5b5ab76c788e9275c67832d44f1237f19e08eb03b2635e7571eeb09e99fef09ae85186df716d4df18c7e1e3fd8efc49ad61753f2024bf0a2c2f80cbb67f978388eb2f511bc31b0fcb5b0513b90ee88f1384373385d9b78800f33f96d84d11d7bcfbfc7e3326cb82563a0e7e9d9a0d97af133427f9e9e03268d948c91e1088703e992e3512223723446931d047e2963e067b8f83d86c34c06e17063748dde55de4c71cd41bebc3c05994edd36814e076df0eadccef1a1359415d0f6650cb94b8eb60bcf623ea30af3b3250cf7a6b43e6865b4c213dfd3f6baea1d7fddb287e5ef64325c21d799e07297e09e843dc3e130f7ed16ecfb51b9437b9808ee078f8b29da9e31e2ee1ffbf71db88b6db48967096b6eea2b9115f604b9df0aa6aebcfbd9ef298bf5e76e2979aea1b62363d1fb3196ecb32d39bcbe6fbba6c0dd37becd6d243e9a82fb92ef8586a67876bfed5aa2bcfbd87762bd5b1cf2a638f7a6d2e46e8c0e1743fabc18527031469f17c3f73c539a478606ffff686b136f2aed1e5369174ea55d349576dc54daf15369274c25279c4a0e67aac16583fc4e53e9e11902b8a73c2e9bc5e36ef61fc174d40b8dc503e3030fde1ca5b64b68234be4f5cced753683f7cb6630be6c06f26533f8bc6c06abcb6660bc197ee766039d443b7b33f73d90fbabe43731b56b0cbac14f6d7dd90a8fb3257e029da28deca73743e393bb20d0e5025bfbbca477b911a68f253e04f714fc54c1fa1e6fbadf69c53644702f3ca7f71ff0fbcfc5e982d9da72b63553dc419d08ae0ddcf3a475b8d1e61d5d5b1f36ea8f0bd49347c6d990d6f76daa2f9d1d5b9bdf897bf0431e74bd9f6ae297c4f4bb111ffbc1b2dfaebad0b998d230b084d577ecff53bfcd5453025d55e0bd157c6ff41fdc6684ef43f70dd270a6a7407d3cc307cdee72720ddff0495d10de2d918e6b8a4a0bc891f8397f37c5f929c68d1dea9a1701dd2b6f83a6bd3bbcd99207e072805c236c15913c80765d78ef30fcc7dd0ef9d6cc77ce8634f74c37f77b7b76545ccbef5cccc115d2e7549b7826d087e37dbe5ba3f9d954bdefc60ad0b5b7dfa88fb33d3ae4ec98f177d0be7cd5d5f97fda9ac225f43955f9fb765ff94e34d500fd219c2d78c790cebc257e7e9797dd60b2bc967cbfeee8b14e7931160fc712bc83b178f81bd5e62d7f7531fcce1dfafc24c78bed178f13b4c5695d40fb2ee4adc7a25df7a71af386548f91621e3d4de514769e356463570dc754bdcb7679ee431eccf18ece2b6d4b9c7be60aeee325f6293cbca9867e5f04fb95b03e9ac26ab75e1df6d3e1e40ef47b337cfcc776e41d0db57559ab13de3ccef9e9bbe2984778462f663fd8cf7d2fd417bd25b8cf8cf7c17e3e9adc37eae76e250c6f5638be8c0f8e678e0ccf963c575ff46686b6f6c6fbc7d8501f82a1cdcfbaf07ef9d426675d0d2e16fc868f0c6d72368e809ee71ee45ba9afa4136dfb7ce24bb89a4287db401b113aabd83d2de153a60a70efc033a66b6bce8c0a78ba9a42fb6e893d4717d61dddef1cb6da353d4b8af4cee9cbb943bf5bc5bfd942624ffb0cc09d79a3b63ddce63875017d6463cad1589485b93bcbe450600a5e62c3863468486bd714270ebabfc07be64ff57d6789f3d014dac7ad80ec3d3e186302ee9b0338fe917766be03cf57face5e8e145716e4c8e8e842e750e66f50549d37dcd51be457e2f8fb58307c39da09a40f67eaeec4d8d74bc541c6c3f6ad30be97b5e27b1cbccff19dcd68c2e65fee1ac251f45bcdcf96d8bbe8dae17be27b22ecf2993c5bb478b047e341af03f87b4dbe1eeb40e2bc6d49ab8e2e389e2e007eeed8c9d94b9e41db9ddab1b7d0be0ee87808ce15b403ccfc09e089900e7e6a0ad42fb679fab9cd0616c403a03bf398e296dbaa0f2f93519f05d9ba51db27703eec111fd9a3c9ddf4ed6caf22b0c77aa4b8c978764283a23ce875802e85db45a16fc0372ea6685d2d41094de171817b769cdf6d5539cd8489a30bd723b2955e339a0034b2f69565e53941ebe4def0b9b6c91e647779b05f21f4992ebae0bc84e01b5b70ceb6b4bac66773f51dc03f13edbbe57f5e6d71e258d0ef3d01f8856b1b4bcadd9098fe08d27e9afceedada04da613ee3f5113aced0339779dd08f99e77b6f479c9fedfa1caea198d3e8ef3bb95e80b8b1e90f9186fd0e159fd19dbf25c13fa7e8df37680c57d080f4fd7c6df018fdec075201bce7ebccbf90791df1b8d15d8eae362a89f57535afbf6e8ea9b52c7b107319c63d5bb5a5227b4a5e1cd1e211fcd08dc63d78729e0c782c36d05e3620a436e768cffa67da3039d6fd4f38c1126e3a3f369a3ce0f86da72362a1f98e284d3d53687e082789e2e8704de15400325ef4f88f7c7b1cf7d313c9b5aef6e1de7404ec5fe1124b36591f0431fcbdf5746c4fb094fa1e10aea0c1c4f9cf307e07715df08e43715ef8b242f51a2751157abded550e7677dd18b7471e2196a6ba7abedc3b8ef409f54e2af5122bd658d76e76d88e3f61dc85ba06779c45c51056c21d0b574df037a701e77fb4adc45cc6fabf6a9cf5f4d617236a535793fa9a40965c1fe56762978cdd1a0cec253e51eb2d63a752d0a9ef8cea7047d8ece780474e5b567ee7b8ee97fee3ea49cde2d2aae176cfb878b359a9ccde3fc2d47ff8be48e326f78d6c6d798cfad39629de1b4124732f3db0a1c71532de65ff613e7b0e4dbb09226f62c3c0d2bd66ab1d64a3fa31adf355570077ddf01f96ba8ebdb581ade8c7ef744f8b283d9c00eb67dafb09fa6b87637e41ac50a3eb8677c57e78c6ab16ef0c45ed2be7b54d3cf0ac5733a4f9d4ffab775f691f2ddbe25d268a78cd7e67ce3cb6e6b36eab53eb40b8e9bc714dc09852b678abdc6e7d290d6d14604fac433fc9635efa462de4ff6bc75ce2673de7a7bca9cbbea5c87fcc1d0945fb6d409be7e3ebd1b763ec3d9a0473b9f802f7bc9b78d6529185f5a239a6a37d777c2d2f96b9cd9b2f96bc9d6b2f96be946e530d438c78207f63c9699c797cada8732b0429aee641c81deec1c9aeb4e066f4a8f93f1d459667c5bad3bf9e05eb0914859576b7f99dfd63ac7743cd5d09de86b65ede7d4771c2b44f63f15f9e6fdf5618b6c2dbafa381b02162748ce2d0d53db60e23bdfa8c5ef33bf3a89337cfe87671ce7d4bb62d9bcb15fbcf01d8a11cacf5742bfb8cd43545c07f12c3a7e301d153d4bec1be89dfd73725089f4363837d3512fdca86d6e1bb64fba06f444b83614d739f18c7ea68711eb4e623f9fe4d79630d37aad8f05152f0f79b013d1b9ae80ebf36a0aedcbf605f8c1ef64404f5044a427603490d027b8c327fac96bf102f4cc2a9ac864f7ef99fbc0c0c958847b568293542fe8f377d37fb4eb9deffafc5e767741055de4e5dd6f9a9f7147e0946858be773959da7a19ede675dc4f513e56ec53c2f7bf8e9f1afceccc5b716e0ad89ff4ae4fd02ef4e56c54a3fdba7b371306164f7b150c8d780a2ecfd23b33e3fcb0e4db57f91a01437c4f7cc1dcccbb176b6e366ffbeade0099d22a39bba1127955b49bde5da66ae7668ebcd7c348e76fc9b8672877f2fa34c13b10bcc7c317ef512c1afe14658a0cc8d34f1d1ef7653839259a54ed57aa1bbf609f6af039186399d92389f3036dfefddfa2af41dd15b3b711b88670fd9d7a5a1e1e062f6b8a8f3a7c2c1e33b5adbd100f2cbd2cde73cc1ef21be66ca68f211c54f12aca7de7cb7c0aed7bde5ef03be66da67fc57b94e39bfbd7d0641dbd0bed078b1735c547351f42be42e6dd91ea3b7c919e95cccde245af99bb114f40f1eca95f80711ea8f7f8aff2a36c6eea5db1f19c75f4a9644e264ffada1e3ca747a53499e8775dba5ef055d8e87c29c9e32abd1b22bcfdad7a534a1f3578d317e1a3eb4be9bea4f6bc5afb11c7c12b8e25791c806f8bd9f27239c83efece8a668b8471bf16784f48ef84b99c6853ea88863671754df16836495beb05c9732a0cc7756049eb5017869141b3a5aa317cba5888018a7f0f4b6c899a7dda80bd1472f9e2f975aaefb93d47313569fcb9bec8d1aab8913c0ecb1bce62b29686a7f82b816aa354f37142f11cac389c383eb5ccd6a9fb0f301e16d7d47364e93dd009fc11b0ef4d69e866f9050f272f8727175d557e5162e30279d02569038e4bc01f8f2fb262e7e4405f4238a963253a0ae40558bc4f12eb46d03801fb238e714b721fa47742c6a3d830981f3ec162f750cee99e4a43c43a1e28c6a722e6abd4b63d772cff5a8c47db97eedb41d726bf586b33a461b8898af428abf2438f866e1d7a8463b0f70dc546ea65fb1658301778d2ced18df4f9d0dd71e979837a8a3a0cb74b065d6ae04ec53bd6f150dcbfa81bccd4558bc41f75ffd2713ce6b99307bd83ac8e4bfd1b3066582cc6aa56d1a7e95f583c05e66c52ce5d280fd67bdd97eb9c3d3806933623395422e550ca2b55ef66f81d0cb7e360a62a1ec99309d8cfa66fdc59b4b951dbbe49a34d496fc9aa528b36e1186cda0ce581e71a6a4176e13046b6aab886a64439dc0a9f0fc5ed96d3a6b87675611d592c9ea929bc2e28b0564f913687aebed43906ce09da4cc651d8b4e92a0779a90765b4690bc3d0f4bd9b81d1a7e11b0763b0124be953b31dcb6fc3385396dcd3b5c92dce6b20e914c8d4cf48763febd0693a0e9b8f1abe217df24a29adce1dd3b7f3f2d7d7a3d980c40f790ed1772e4b06a6cf8bf2ddd70365298b35f9e9cd10008ed86b8431e3fdb235c6f278aa4ece16ce6f96e387ecbed379140147ac275caebaef456cdef01ec231e93c340f8b36cf9f63f733540663ba5f96010bac3dc18465ccc1314bf162878676886596408be1a7cb29820e8f96bf6e2379ccaeff524ff6e16361e77feec0986fba3e40cc87ad89cdeb60fcb852ae3f4158309c88fad2761481fe0d0d27ecbd81e3888cf5e4f626d6b7e6989e35e67577bd67e846545ca46330f8211c4f1df365fcd01027de7681744a29c7b30eba0f74bf71035a89f5bfb8ae20436f07baa43fdf97f3e838669f8027c92ba9c543e11d64c197c342d59be9b0c4fc218345570dc7f06bd14c02cb7ea3b16597e10fe198e5b0acd15d37d1b3c9bc193928e597492e479f4f721ea87997b560d014cf54bdbd293c0e4538f4ba7030beb728df837b6e9cd3b62de6f0bc226e3dcea90db1586eb2ce4df832df073e5f1627fce2f96ac501239c167c1d55387e36ee389b0fc5f27e651e967f039b078f137d117e53bb25cba7e45a6d0a5d15fc19af8367dc92b198608447d2cf5ac80dfdfd3e0c7cbfa93ed597c144c4512638cf620bab70bd403536a4750ba7c9b80ee55763e360cd0d581b27cbf5289fe38bbc05ae03f36160788670fc6e5f6a11060a5f69ba6ea6bf02d6d180f54213bfe98bd64bf294780f31bfc48be7a9e127cdd65ac64f20ee5fe083c0f69188a17ef15cf578498c7faaafe145e729e703cd704de323d475237b7c5c534ba3d5d97a052f496b76d17495a4b6ee17cff823b07cef668f623c6cd4769c934ee529f4b5fd1e7d255d3b193ffb2578ca780bb6f682ce923cfbaa2f129db5b42e329dc77c0dcf0df596222c141efee5f5e7794d3a275b77f92a0e90aec4c08132b01e95f05078ce57f140e82f19eed3fc86eab988f3816a04516bfd5175a02ff2a2b2fb1359effbf7f026f22e433d3bf4b5ff665e45dcf5ca785673f86af1ae927b17f9ceab7959721f29e7695fdb9767795b01b6121ef765fc30785df57ded55387a8ef751e12be1815fc5138b17a67b55c213597313e7b170cfcbbea7e8f1cfd993321e1eeb90a57cf137dc01c9f96976a697cedf902756de0f4bf7e469fb5361fe127ef8cabb23312fdd2ef592fd785acfabbe57be08bebcbd8ac0771d3ef837de39497aa9b263bd0646a63ec8be8f96ef4d6cdf42357472f77758178b80b3e6d9b204bc16c146f20e86b4be6171bb185e608fabd7ea7dc97c547def15f3d5b2732575890af1b91538aeab6f97cc87ea4b3c3f4f99fe96ce43d7dbbe82df66f6ae8cae0ab1b7af82275f7b41453526f764ed814c46c03aa47fcf1d34dbef0afdeb6b30e5ed5e19ced3b8da0a5c73092f17369a7206e7712b1a672babb5f93d19f325bc069b07e337e837efb2d1e6edb456e8eb72cc635e7c8cd765421f35aad5536fadafcf13207151c859fa325c65bc88c005ca11f0bd68a3025dd7e3b64b46ddb62fe85168fc8bb1c06ab1bc16ff0d7204d830a5791aafc507c1ab727313f9012fc64943bd8905d76fc20b961f50983fcb5daa3567cccb4c75181a02d0e7d6912562754169b1095a5287d2bec0384abc2e25f5fd8963481e6f090f14d398d47346bf47a7621c06ac5fe23886d411114ca82e75fc1b952f2fda7b53742aea3c537a871c8bbd43e47e17f62392c55e6b3ca2f5fd7a3f4f07dd9ddcef06e301a0851ead7fd7e3835af3b35897d5928607d4bf29adf3f3537d477d2fb378daf8ef24e6f2ab3077d378e56c2e54535f23f1eddd2c61cd8dc1f75aaf351e4c586b0e3f1641308ee701b27d97c61b8b59af9bd2784f97a8098ec7e663cf5219a181fd2bc4e851fb32d27bb9a4fd67b01e2c933bec63b23cbbbafa703ffaad4889d69eb9e85e63dc1db23d0be35c4a58ef2cee0955e8cf3275937eaee937b0ff4fd2b705f57bc5fab6d06b3dccc4388705d5428f7b748a13c7c2fa088d470a6701fd288d1305f725c7b37c8fc37a8beda6bee79a422bebd9b2efde36aa7237f771ae0fe001c622eb6d3ceeb7cfbad0b9e57bba319ea7757c73759d3d7399f63a8bffd6b8c2f733df385bc7ac6728bd0fa9f796f02ca25fce95d2db26ed399aedc77b0bee2775fe5cbfd6a48fa9bfd126a5f3a76352e663c2566bfd383cf32fadbb0e1ce85e10d7a3553ff19e3e697fc4e2f944319d4b6a6dde5c3f3bbc0f483267c2e3b6197fa6d5ff2ed4e7ce6837df3726a199a427cfc7a23857e11d92e7b98857f65b0fd8a77891f0bb15e0750f651184b20b7bc6f245fc65bd49b27971de4ae02f890fae813f18db3b62f5a864f6caaaa8eb8cc9277a4d753eee3100fbfd91788f631df3fd762eec7728b485bd83f5ac29ae7b50d1a39356933dc9ab3a127b14c779e67b37d1e7ace8dd79728da5e3cda415450e156814ae31d77b85555fbef95e5274a4c25ec531ba553d74131dadac4f622e8f6f489f07d9e2e27ae6eb4301bf28bf32cd9761c62f7bfe4cd5db4de819e6402e7b4ee1fcc4733279514c1bb19c65f3a517cd9bf6224ff8c5813c13498e69ccdb22f27d2fe9f372d2d5f9dd06ba74ac7bdde465dc0bcef2edbdbed885ca727591dd153f1d8c796511dc6477752e83917926d09e25cf4b722043c39df832459f4339bf81ae4d3cf3f859cc5d25f929da8f5ae7a6360d53fa0532e6cdee59ebd096d607765e04827f99cbb9f2cbf0bc51dbb0c79ea17e569e0379d00dea8d35277a3855c25bbd47393a4b7a4ce0382ff03a9c075c4c61887a02a4bd9dabe6dc917f67b9cdddf49c917747f6fe253dced9fb4759739e86b21ee3d97da52057e87332e51ab67f69cfb21ab440dc6789df1bf1e7bbadcda15fcae8bf70af006ef65d6afe7a1d7cbd46466678a2f4067de68c12fbc4ca4962f71f2ee3157564071b16ac4f1e7bafcfe611f6d848f63adbfb4add2acdd96f40ff19cffc5dfb9be0ac6a8f59b84deb27b0782e367e5d1d80464f28e711f91ce0d92eea1d85ba0b2cbedde5e4c167a050e88a295b697b95e47296ebeef95a11e26f9933b967277d20cf249f44f84b68bcf03ec51e0675a1a9fb1ef754a2dac46877371cbee23d2ebf4fd99d8e7566e441b7813e4d95a331fe73fd21f957d06b9dfb423c7748f00ed6fd93948f2c7d2e87e38c4754d27da25396d25436de6fd7176bf3970ba34fffa4725eb2fff5b80fe145f295b4b733f72ee3f34fdb0e70daaad42fe8730fb23eb2ecfdc375a62a3ec8d65febeae2b9fdc964e90bf72b959b3b566d9e5ab81bd639ff8df40e9a4fe5e9b3fbb7ead8356160e9dc39595ca24fd5d3c11b9d05b6fef5aafd4d7d28257b4bc569cd9a4675ee8a55b6373457853df0827a1056d9fc3c435d35b159b16c73f17c640f72827692fa51499fe9df356f990d1bc7e1d7edd9634e5904a132585d64f7939ff65ba1b2f0ca602cf6fbcfef57037b6903bb1ac31e8e6a43d4b37734a1618abd833ef727697b61eb66680db342ad8782de85e33be319d577b470b61cb765b774ffb2f16af11c1cee67744a9cef20fc17640ace0f1e775d8de30966fed0473562cae7edf788bfbb29cce33eb36e0b732f5f72bfa7f4fd4cea70d49b9b799fc7f7b2c1fd1df38d50646e6dbf496eaf3259fbcabd4beb95f499b550eaec5d2d9e50471fc1f0566a036d7496c9fdfb8a5e5ee02bb5644d992d88a1a7e37b5fa67755e9ed69efdd4667a3c40ef7a2bdc6e2474af79a895f589fb1c6ba1bd850583c73ee587e953d09be53ed57f0e37b75231b1763bf60ddaab05c8742752a9fb02755e3a2816f2d574bf30bf100dc34f947d79de03ce5f6a4789f2a7d6a1190ed8770d644072fc5d9b5a69fa801ddd6d09b301aa96553aa2f9bb033d1c0af96e8a3b5e0aca93315fab23fab33d558ebabec4a08cf3b76fd5a165dbfe2de8ff1de266bafd697a870369051141b4a7df985d1d0eff1b3a57583319db7ccdf46c5ddbf96fcfc9db6a5022fa9235f9bfbdc6aada386bdbb708760c6d5d178d56fb331e1f7e827ee7b0dea2f7fd5ef96d694ae8845c26a4f57fa416549e6f4a5f555bf4e5a27bbc2ff96bef74c3c520d9cd4b73961f5b7bfec87eb07a13c385ce4a5d562e84d047e18ba130653b5fed40d7477d7d6a32ffa3033faada93f75036330dcd78a85aaa13f11fbf7845f0ec1c35cd7a1b90ee54e7c708fa80d6b5d3d8a1ae7d25487aab7debfd93f47d0f74b74a8a4ce7ef3b557cb3426bc35e21598f48f3d6bc2b37e932e856001ba14a3fe7f3d1cbe4c9fcaf6b35c9f42ef4d2a68ec7fc85fc7e62b4fd2de57742ae81f2be819b5f9d6bfa0dfcef44be297d2fc89afe90cb0d74558ae43c177a26a1f851219ae21bd7f557f827d2e2a7427d44fe3357a138683467e3aacdfc7d3fa92026396def9e9601cca7d7afc36065f898fae5d276e2994235d3006afd9a37a71387224bb566448f26b62b763bcd7f6cd11be7c048b524273af885ba2c258d39749cf956c16b75d679d2ff2e9f8868f74a32a5fceeda5314b94fcb1ea3557c79050e1acf6cfb2691c7f56c9ef7f6bbc5202c78edd2ba81a6f2f8befc5e45769fc5d92cbcca6a7dfe27bcbf38d2a39d53c56a916fc357cbff9fb5f31279bcd8f7e9f5f15c55d36cd2b817da64a7c2471afaa463e12da39f36e86ffa3c2bf06dfa9f6af2d3fdb0ad8e32fea1fb0ff55856f0df5d97a51ac768683067eb55c1fb0e7fd6a72381dbcf3ca3e08e568c788d5cee063e5ab217c54c624c9cbf796e1af1ae5cc32f7a86eacb6db6debc2da7b51ac36c47bed5c35f21e846029a1b90671488a8f7ccab560fc5be3b4abd7f9f7e63f6134fc9abca294ff3559738dd80f1a9c35721699348e3fabe6f7bf33de288163dc67f610acc6dbcbe2b371f95566d344ef95f0c4df92b746f08d0a39f5448c511df81bc766a37dadc733ffd57211d3de9365bd2fe31e965fbdafa7fd312b62b3d3f7aa6dbfe3508f745e6f14f344b321a6fd352b72f7b33e9ccd63b4ebe0a47ece5aa167e8f3f9fb11ac3732385c664b9dae0b11f861e943184cd53a91dbdbebd1aa4edc6f3dfaadad17c5fd4d5fa41711fbf78c6e14c3c35cd7ebf42326ac7faf8e546bbd7f739e789ebe5fa42b45c6e05d90078dd75e2dc798f0d6d19958f48f3f6bc2b37e97de84fa10f7193d94ebe2f075ba53b29f55ba53fc5e058dfd4fe94f4cbe525787aabd8e27f428b8cfbdda7ceb5f4d97c2fa6297f0d0f9416912df734c635a82e9a07b99ba16a5b60e9ab7c28796be57e9478370ee95267eb4e67032ed4c189c8d6d4d48a78130c8fde0a68485d89fb43778539dab00d7976b268d1fd3c14e64c4281170b26294b29eead575930cdf18ec22a3416d8726f8acaf47d43d03b5ea2825ebafe98f3b231b205c4f30edb7c48f65b18f7d05edd6d7c79a9f8b86714cd4f5bca0e612d823bb82265f21eb7b45f817b4df4afd44045caf8a3d41748a9f1fe9935748df06418375eae6b0e0ad15630260a0c41962cfd8b451717e7e8fdf2fc5e52e85b1dcefc7c4cf0b653c3ce735e47c337ef032ff5f199f6abeafcfd476caefc1f37151451972687ca67ebbcf90c2a7cb69a3c61ea873c7f4ed723dcb955b2fb0ad1d74b57d34b47165ce1e84c7ada1ffb993bdb26c52afb31a2ea65d2d83eb45be45345e83dabd5438bea8df294b9d6d53cbc3c88aa94af0574bb793559dfbb22d3481cbab1b43ad47b3c1cbec69793a6e1c5b959ca7c21d9231eef3b1e78634bc19c23afc9fb4a525b82f87edef8db1cacffd223d2ce19138ad47bba28d257fa66af88398efd7e08f0cbecd80b78247fdb63a99104f70ff625aa9cadfa3c2f4c23864084f652d8f5afbfb3f1573c5e225f5f3f76a7edfcc7ed698f7fdabc55e45a63abc98a38c6e2c7f78d868eb68e64e82ede0ea9b429b9b6aca79ebaf60df0bd8cb848cc953d7e09beb5630eee6f1f36a09de776389f53e29c4f00d434358bf197ee766035eab9dbd99fb7e53c0fa3099fd25bebe570eba3fdf1b122bd7a213e8aa02fb0b4d8b353e60df9db8278953e89d3f13b31e4f72bf581f24e1235b817157c3f9c1e01a988207fb814cd5b5009e5bccfa44c57ba12575a28d363f9b42ebbbae4e225378f0b6b47a33a5b56f0f50bf93c12a982cbad7717ff7807d49c26ef8b138bcd9522798f98a634af2495976bd719fdb7d0c869eb9e8dec783f1f963790d4c5139a39e018e7e54eee668cd91bdda0ab66369c86d54e5895cfa930bfbc2f8f33d66a316f5a5ed28c23b7d1fc5b41fccd5d00ccf3cce23869e13c311fb090b7d8364f71028839d608d76c55e30eed03397583f196d12d7ea02bf63f46a8957c794bca3b9e7cfb6b4be5ad1d93550af9a020d09a867a83871acc159b4fc613013cebc25cecfc6f100ce3ea0899b3d38a3fe9d0aa76b134cf6b7a3716a2b6d637d44925e37a7c279b2c22ed6bfa2a20f4bb2c67d8b38c3ed88ddc3249dfb3a75d7a1a1f2d91e1cd35c898bae65fd503eb4acaf89ee77006cd7993b24bfbd6da4613455c1bed0d761f85e88f57939637bbc37450037778567a28fed39262fe3be67b0070bc6037b8d60207199f1248497051fc6f57a4a604078c7fb69cd04deb17cfb6ceed1f783d355d67a2d7c4fa6bee3803b8d21415877589f971cbeb7c5b1825ae3ec597e8ed2b9139dee3b8c0302748ffbc84b70cab2bf5b61fba4c37888f9217f06d8fd68aabeb184f5c550152e171753badf8de04ae356b67bfe6cfa736f3b38450d709edc53916e32ae0523e96769fcada678a6b476f17c989a7bfe14bc597c31b26b8927a0371d0c6df75d1971f5f75f73021d7ca7cedf50cf61a04f89337798a73b3006eac305de2ded2576cccd0f65093c3ba3eb7fda9ac26d54a30df58ee31ce867dfd1bc9ee903d9087f77808e6c1df15e7c3dc7d6e67720bf2cc1e3366ae7363b4e3c13c84ecdf01af7ccd62eb758660cc1dd2f04e71ceb7fd956dc1ed0e7f27f03bc62df209ab9d8aa87f3092403750ec8c6827cc87ecf7a2aa2bb4502a3aead39536cd0ab33ebf30df74207bc03e9d2448f6da2bfe83b2f1f7b997ee60f795bea94f64dcd7fff29ca5a1197082f493f59e21b995346cde664f5142fd9bf87bc5c8500cfe45a9feb3349d264c97adcf74819cc83ed884f6819de734de9f0cb94707d617eb706a79b9cf4814bf4977e6f6f4a1d77230e79539c7b745979b8e0631b5240d71500bfcdc6bd9b69ee42aec7db79da6fa767783cead6192bb0fc559df73878575bd699777da9ee7597ea4b8f58dfc970b019bc5ff27f8f89bf65e2ef4fe2ef15f1b751acc3e426f73b3be9eb27c883ee1bea037f32d4b836aebc80b91c047c43e2fe36c9f5ca4b75b97d8ba7acadba0f5f4c4f14bc4c8af746a24f1f3eb712bd73c531c6b5e79fbaef9cec3a244d9f64317f3796172dd81392aa87bbbaa0c43a74461fd22e62ebae29ef857d25e5452b9223392aae635ec085ec3271d12a7e5fbc83239b3a751db3c1ae35930aeb10c87b3fe3fbc25ec6fd52af0f5bed94f6302bd6398d7b252a5aaf351e81f7bcc3b89fddf7c9feabe0ce1bef0fc1c38407a075b0ff913df2c0b93e18aa7236d5e1652c75787ba49c0d7097267a34cafefa3a23f74825f0325a5fb6c2e30cf6d512e76d535a7d87b69eb077b7b579b451e7deac2063f36708decf933b66467bbc09fe8dae970de0539ac26d55d83fbb6d49abefbae085baf0f048f8a645be1c8f533296250e795d9b78f9fd9e5374830939b6bf516d706facc25d72070901cdc0fe7c7d3ebe43bbc9dd7992db33c3f78eb636f16a9f9dc2b933ce86b80e593c1cfb3eb937b2c758f4cea66ae377fbb3097b2aaeae88b6a9b0c3ef05c5b146f333b8bb276710d1e0d99686978daae4beb546136f1bf2a2a14e7e6db4cf6b7c0f332ea6685d81dc3785c705daae8ff3bbad2aa79900ed98be2975c4a98674b028b361a4bc62d1cd7ab9c2be427c644943d7589e814c7cb3854e68f4f9d83ee1b7ef96cf3be628b7d791ade6659325ae03822788ca222f3f50ff794c9fe906933c0dc5b276d1e3e05d8ef2fb26b35bc2bec1345e90f5a74ef499b49731ce276e71ffd4a4e72fb4719f75617899aa602fec1bc0ab35a2c3b71915ebb9913d7fa1ed81f5bdb4768c7ec93ad1736cbd99be06eb4b405b2dada61cc68fe2fefae3d1dc37850ef8fb90d7570acf4f980d25d143e39ed4fd6e40affd47eeef6a674b93bb313aececd198d083d8ebd5fdce61bbe8711661bb20604aed3a292e72f8cdd9b75976ec74fdb18e8ffa206b05d91ea6b6b4fcf8715fe5e2fbc9f867f3d8e3ed7e7083bc0dda7f87cd7bc0156da2acba8c67f3a87876d6131cee17f28f3fe47e90fcbbc98bc31bd1073f90fbabc279dca8edc0d63ebfcff6fcd5144f57c0e774617d3285c701deed8f80cee78ee5af9d997bba9a6ae7fb54eb5d7455f13652e76240df673ede43597e5e927f53573ea7781a9cbe035d54f7af67bbcf23dcf18109f891eadc4d09e005c7e53b802be589085602c6091957c92bfd20f97793616ca577b3466baed61aa9ef349b23f6a355af6daaf2d0cf0dbe7b1aa7fd82dd0ee11ad9e6f273166259707832df58c1d7b25754d8333a5d2769bf437b53b0d125f485e53725f0a5fe63123eec5dd87f17e828faa210df9c3d5b9eb3580f7c2f6bf5a9fe523d9f782d395f2e9783a1ae8fab56adfff2da30c88f324cf72837f7f204e8edbedd97d1e430e7afac7bdee5e5389c0ede43791f3c64779ca7cf3c6e6a9ce7311b7fcb1afce348c69265704138fbade823e57b79ff6c1dfe40c0f372fc94f1841ccdafeb7fd7101e40070753685fb66b8296c5890768cff41f6d8dac0b91fae430fe90f4962c9ee3b4af3535def6c8e5f809a3cf31cefff09e99388f28be83f30a0aac585d173acc0b22b60cc34f6ecd649d33c67bb8af81e48349ef662c2ff62bbda3715ca01e5714de9bf6d72cd636ccfa65e13280de7f11df3fbc7f174536e0ef14e5040e6f542233b0394ae407dedb8b2e4b16b9f9d8fb9cf694cc64ccb3fd2c713ca0fe1994f9d27e5f45fd31ab658ee38cd1030adf3bbc3f085e93aff80e4ea71478b3b81c06dc247d90fb87f70dc16984f1def7923d4efa5a6171af5fe9ab85e302d618a7ac11f66628a9af6ed0c629e367583d72d6bee47b50d0be2fe165d41e1724ae19bd41a86395f1b162ff9067fb74e0fc3aad514ae1ad78bd72568dd6096bbcb27d29a9014e1d8bb53f851ae114bc32eb6993f865d5072f1bb36cbfb0daea0df7ac7b91dd6e7b3a781767a48edcc76aab51cf6cfb57999e4e1da744e6d06a7f127b90afad49fbbe44de506b7752789f49f6b9a1f3b45bb9acc16b92d6d60378680b887322033a3f83b55b28f381df3bb4b383d565298c53766eb0ba2d2c7e16d7662bfbbee4ac60e3b3e99a518f8d3a56d9f928d66c7bbe3e1a4e73591e39e54c6235659879f423d678253a40499d16ea58114b7f26ebb850740176cd13720f58355ccac62cd309b0fa370df5b5688578da41a0cba134a78aa6e3a36776492ea4cd1aaff4bec3c8b726ef34853c66d63865f71e56ce74f1bec2cc45678e597effa1e5acd7d2e578587fc77dbfcc0616f5aca53915347d06c67653ed60583c785e17a3e71d31df61c9a4426e12eb395bdf66c052fe5eb97e578489f9ce33775532a72bc77704de31a58076eeafbafab850637d8fbdd62cef9fba295112af8aef09f207d0ee23d531ef395d02fa09a8f79af258f81c4d1ed7177314dbeb69b64f46cc7a1c7f135db358a241d723e04bfc9654590fe34134684f0a4c69d89ef9e8b76532e619c6b21163feb678ebbcdc993bb6e4b9cfe7a0d6caffca6049fcd8031a7cbd0e8ebfad303f5b62efa26b87efb13ff842f5b910715af0b79c6f328e6178b3fc754c1fc7cc3ff97391b373439fb62a4eeeb6a8d8716cf5eeba91d649ac5e208f00edf367738fc5f72cc78135723a842d0f8eb1151c141b743e9bbec76d5462ce451c8f69f85ecb50d139d0aea1817c6169fd08c1f17401f2e7cbf408639bef5bc0538ecadd72cf690ec40cc3d9466dc3dca2a93ab99882f26b26c4bef56c3defe00e91cb81b0fcf545d7601db4ced4574e86aafc02bc662ca173dd6f431a34c35e4caba3ab6b6b9308fa6ea4ce398df7cce0cde25811dc59ee35d5079ace99b3052f821b8c2b573b44ce0f25cf415c8796d009e31807c0df1e775b581f7e2e12d82fc95885f88a24ce238969de96e29388311c743d563c6a994f3c990bc564c47c4d5b5f6cc90b4c6975ce68e814c6f1387c677a44f1c528860ff15bde1ccdcf96f4f03e96f8ef693ee5b92c372fad831d5d93b89247a2afcd8878e204e65856c4fe1643fddc65787b826761781f8fd0faaa7a3895e52d51fa131668d5c5f972b2afd478ac22df49f2d09630072032d487a78b190ebee0cfa58e650bebfd46f0b82ff8348be33eeb8f4de8afdf4bc6fcaabfb5005ba1e62665ce5a3ed5e277a4eecf981bbf1715e72efbfe6ff08d16e6fc1ff18516f0327fa58fb37c8d2ff45716f84ed16f58f4edbe6eecaffa16e9bc07cbe7c0e558a25354c15572b6b2deee947301638387d7abad3eb88dd6bb186beebc157b8e21411974b57ca43725b1cd141acae4df9aaa43cdb2f160dc12652df8fc3b53ec41998cc111cbfa45f7f125798a74990ab99af6edcdcbcdc44719c762223da95c4623ff2d6d1c5c663ef55d31d625c72f91bf14e371690d1d8acf2cad41513a0669f3a8011f7e97ae80afe6b8cd7d59d0fe91fa8113bbd5310767e2e34471b231ae3e962535a9701f306dac303d1f5ff8f6b310a394df8bd8ef8ae126ad2b41eb552893ba20f93de91fad011b6e23ad80adeeb84ded26f958bfbcbccdf949511e048a053b94d42bc9f9916963d174e7c6dfb27526dc675d4b7f823de08da543d21339064b972a818fa657d1e1ab3b6e63bbb3fb09f4a9d4279dd8c6f270c63ee0267b8cc50ee7691afa7a4bcf6f7607cee304fa7febf2e8fc3c65fb2b87b3e5b82dbb05be459b8f75ce68ef32f933091b6b6fc9f79afae5727715cad9c5fcaf8d7873ead7cee320f5f996ca5facef6c5e16a5beecba67b8385f058f863d9ec5baf3b26426eb7de659a6c1c9a223dabbaff08d933ce3d6501e67bdf10a7caafdab7cbfb39e62797c415f73037eddae298bc791e21a07b9c0c368f331f698fa6e19af6ed791c5e47b4de35482e9e0fd11fb9bba0c3e1dfbb61bf1e9ac07505e2f823eec523e9df9e8f3eb847eedba7c3a3f4f299f7ec84bc73796f33af3b1f680f62e934f93b0b1f834f95e433e1d813bacb2d42fb22bb7183a34e6576e7047c2fcf5791e96fab24b752dace7415e6ea4fefe9aba3465beb23b53d25fa4eebcac7d61bdcfd4aba970b2e400eddd17f8fcf3fb8ef9af1bde8d515d56e2be97f8e9cb75ecac6e2e71af4cfcee75f7bd385fe95dd9370eb38172a83b2f6bdf59efb3f79d0627f3ce4c79b7a9cebd8a6d988ba0a58474199dc40d34ddf7a4f61ea9bfc73ef8327e8ec51c90ba6dec73af2bab0bef97efb9fe30dcf99eb5fedafa371546a6cca6c0c8d20548dc35e6efd4d80382973c1763905f13ac1362ab3c2d979ff67e55ce21b11f892dfbb3549f50847768132f7c8fc51c6cc9ba7779b8b0f8835dc6a7cabfa1d5dc24f2dfbbcc1a77891d76eaeac8c717d72049fce5986d3dae93291877cbe7129f7ef1b7a2ee99d849f1589354dfceedd7be2526311b6563247123d95e30e34708dd1fd5fe0b311a58a63863d182bf511fde5688fdb553d5e30c958f664785b3fc35a70b3b4a5dbaea1a74cc9a4568be9c8db28f7e53c1b75fadcd4bcb3531ce26cafdc1f38032d9d6bd19be773116bc6b4bef17cafb098fdea167575d9c3b25bddfdb86ebf9b326fd882300eb7ba06b1c82859d9394c3d72228be9fc45c87f8ba868de35ad05da5322fa809ce13b95e630d893ca88bf3b8df7e933adb749c23bb2a893b46df2ef0fdb2e73cd12f2f3b07b9fca754f6e6d63ded17df4f646fbdf32307caa0cbc9833af14d643dd86c2fe97958ecf393e464a15843722f9bd5268fefcfb7d25ca8622f28069e139da01a762acd96e0591e3cd337209b37b1fbd6a47b1edacd1bf19acc5787d13d9eeb95dee94bce699223856c11f5ce8ba1d6ea7d4db33563fb48cd392b392f49fe59fc2eb98fcd7af29c90de5f95f77568826f2aafa3af8146b725f85e3ad0c7f1759c433f413d9acc62911af43c40749ce7c5588e591dbe18fb321ac9ea6882fa72be12d63a3419c3fabbf8623ef688e08b999f8d84a7ae8c26f2f56aca4af700fd318d64250d56cc4e5f6f8f915fe2891e1cd89a895cba1af488e5f035a3c96ea0477387d64bea79786bd02406ef6f93d7fdb8a7b1bcb45a053e59b9866a998df9bd9ae98f890fe96b388ffd00f5f404e47791bfaa2b243984356576bba9de182a91e11a4d7a4e96c359e71e83e0fc5df29ae26f29d51bb31ccc1abc91c809adcb1b6541f13f1f5fbb47401f514d7a1f073355f18c267a6391deb19cc93af239f66135e385efdc6c69784d7a6396c3594736c770fe361e188d2ff272254c07e328f1e3969d1d2ce7b4869e98cf81ad79c691ffed6b77c3cc275397e66bf77c67d33cd9f7b8864d07cb436d747791dd89abb8ebfd2be1ad7387c6e0fd5d769d08f2c2c121b36397f143460e6e094fc47c9c4df962ec2ffc1ace33bf4ebd3b35bb9767b37ebcb80d8cde0bbacc0e96f9079bc8eb97f588a6e1af36cd16f26e7f9b2d12efd57c78763faaf92a250fbafe7e7cb664a1016fadda8fd46f55d30650bf1760896e91cbf5ad43bf693e6f33dbb9e1cbfe986f663b28c87b467f4b36bd663ed9df6623e29545c02bfba0adecab657ebe5f5ff5bd2783bfa17d08f968bf28d3908f93952f8ddd03aa73a2319a4b72abd3b59c75a173037ba393be4d77087b55e07a78850f14d7ef1dcbb7b1f3ecb9a6d002f4ea107086b311eca983be85fd4d6ebaca1778414a2fa8b63abd674f8a73ce14bb49ce08d15b08e10ef644693ba69af128d31f5e0dda5c851e45983d5a9bdfad7e9c8f88c3425b538c9bf5d51acddbacf56573cc71fcc37c5f085fbc179ea9f5b86db68f707c5abe4ece0f233af899bde8eac483757aa5756baacecf96bf7651df31409bbca9b6095f45a745eaf95bd453cb90bcd0007c5ceddc663eec597635dd6b9aef5cac850d7b9e45243d9373907b82d5607ec0bac81a4e3fd02771df4a381f05b4d77ec3f39763bf75ea23877f578f41a75fd6fcf839ae31ce4319958cd3e8dc3ae4b945fb8ed744a8314eb4668d83d1224307c9ce0107f608ac0dc67bd41f0fd13325c603c609cd836dbf0b7be0506b8d6b60be02ff4a6adb7b06a38750d29b28bf0fb0074d9caf5d3c3b647d87aaefd0bad09901ba4d747ae473c2d33e84983c98a03a83257102eafa66e4d78bfa9e55ee118e4f728fc83198fb92ee31735f606deca44636ac059fd4919f2e53fff6d594867b437dbca11aeed78d36f74c4dfe6e89f3d0d0e6ee6680e75e433c66b9fbc7b42f21a3b7548c774b9c3bd6f1f30dabe7ff7d237907435adf2ae32cd487a3fbeb4bc33b8467a8ab12ff15a2a7a2ef8fc477a6330cd60745fde48b703debcfa2d555c0f724a0e8e9407eae69f171aee12bbe2e6463a0dabe191fdeb70219f06121aded91f6b52d8b3f4ae29312393075512e26ed9ec1868fd2ab20c7b388b830a2364a528f04ca114a8e6a2c5fe29ab39a12e8aa12e76b8a13c74acf57c5bea4fd3ad37de1f4e5dc5124ecac90fa4aaabf83b54d0e788ff4a41f8a25742e1bf593753e53993a1e7469f56c88be5506c04b6ca7f41f773b3a9f36eafc60a82db2af485caf01f567c0cf9da13e2e1bad176d3405a315c0939bf4f54c6b5dc0ba0ba57de5dc2cd62d8b6543bd38102fc7e909c202cf23d97be99d83f55bfcf8dc15eb92e834fce565ac067bcf90fda04479d04bc76dd0cf2ad163625e52af1703fe6e754c248209d7f18bf78ee45dc73347bd4a1c24b0147be7a4f79793a13e023cbe6d5bb8e781b9baf95ac99498a48db4be5878cee088ea17cde64b6ac852f8363656ea53c36800ab679ac1067d36e2955647139b13d57924fd0a79f853fb1f3667280b9f8fb80e5a36676a9711afb498f5dc5aa13de248edbbef6fd4f5c560f47ea3f5efc1682cfeb69fef2189df5560efc641695fc7c89686a11df34ecf943e8b3d2d813e349a30fbd3517ab05e33ddbd08df18f569b1fcce85d5f788d1570feb251a3480d7385b47ac071ea357d2b89ff537f90d63176a425075f07afb40f479c37a9df6ebe03c0f73c37517e6a6d5087aa54dc1501f87627f5648c7a12904048d34c5456e9f7669bdbc67be2dd6cda0f54c2ed833483c14fa3ad7a1893ed92b17cb8ba8c661b1c7719df3d5efe66ad7e1f9370dbec5faead68093513ba8e9dc059a1db07a3b37c05da11e66e36ff11cc04afac37b326339488dbe4beb6ad68295de0ba7299de0f94f4de8a4e11a0b7d779ea28b423f81e769b4e9ba2934fa553858bdbb9bd04cce8f8af5d26e3c468a8f41339e53e85bbe7cf6fb7c8fee467495a78b663c2c778e683dcdeb8ff12c0e923385ceff4b78f093345155ebedabb07d7f155c553dddabf11efb17b6a39e03ce9e91fa07ec10fa8d35d6187938a72aba6ffbeb03c36e89f51aa5e28c18bf49fff75ae3e572e7b2fd79103decdf93bfa3cd68026bfee7741dc023c4c9ddd67a005705db64b51d128717d014b4050586faf90f0a4cd5300c5a4dee4259bfd501cd2e52b8c301da803e664b5a1ffe639fd122f26db52c6988ea0f93366eb466c109613c9f3b64ad3bbf9e7e53bb7d114e627cb4be79e9de186afb50773c54770eda2e9375435bdfe00c7bb83e330e8e5b62ff2e35f116d7b283b196ab7fe4ee22b49eeb1ab57f759a5f49a535723da8f65d5cdf343f3ed546b0f8d2f8f5697b54b75fbf25ccb45eeb63f1082cdfbbd9a34b0eae8dd40937da19f6f1370b67dde964fb8cecf9fdee2df167e46c6abe77db48eb70a3cd3b9f12f4ed39e391e25afeda33f780ef7eee3ea45c9d6051719d60dbf7ded2bd5713bb23cdf612dbe07eae7ad0a6a72f7a912e4e3c436ded74b57d18f79d2bccf545765c25d2dbd668779e8e7ae06c72db9058472a03268e2578d0ff5dc92329fc24891520fbf4a2f3d821d7561d0bc1e1358191dd5016337f2ebe1790966ea63801e335fdaec236da188ed2580adca700fd1dfb5604fbed6b3c86a3b8c76ff2f738d117469473ecf6cae80cd00e46675eb0ed1f2e30eeff387fcbcfe7516cef31bfd3fd61b4d164b0ef397d41595e933ce61338539be8f4c070b237852416820d3bb1e67f247f6fcb640af16eae4eb63f0c01dd7e684cdde60afb212f5bc9df953cb3203393bec5400e8fa876ffc01495338ab7080df5e1c43af215d6794feb7dfb0fcf803a42cabf911e93c412c4f10d14df4f566b7edf42b9faa97ff46a6806d0cfa2b847e4aab6bf2d1b2baf5762fa23d40313bf09a1c3ff2ac01bc1faeba2ae558e81d70348c761d503a83356123bf511b6611c44490c15a6e7409d32899ba0f49bb8dc90ff8a1527451d2ba5cf6c6f139a8236df183ee47764e1db4deacd66e32636cd8f45b2f639a58efa38ee4bcfc41bfeff594c005a67624f0f586ba3c193e26e44ebe95001cf5146f64792be947fc474d1bad0cf0bb897401ceeb0dabda8b6582b5ecf3e60ce89f603bbd7c677ad9ffb787fa67dfafaf1380af29e967c5bec4930649c796c0df95e4f504ed68005eef998acff04cfd5a31a7fe4fee5fc8c0d7048d4ecca7d7f2cef1d5fb2261ca6446637a789a6dfd371f2ccf7f9fa23883f6534d28c3f31f082748feabd4ace18515b05fa7d9bd07cbe871fecbdf33c4e72dfb7993d7caaf946edb3feaf2b5f8b7cb0018d67fe9fc6f2a080c798aec0b795328ac2479bf04dcc7ff794dcc8ef6b6ce34dec01e93d250f3bab6612aebf39fa11c6bc72c6627836b5dedd0277c4e36765bd24ccc6cde5cff1f3b59058b0e76249d9758cc27ae3c47c805d5329eded159f1f229e2fb1d9d07b0f95d9ced2389eecfca5f7262ca637ede595d267120b937d17ebe169dc4fd2432cd659305c69d837c7dc3761f19b242e268d498af2b029095eaeba6a7bb47e55ecde4af13763c9bb1a22ad074ddaf300f6069b9175c0f0efc326fdc29cf45bb8c6e5bba01f0fed789ce0b65179c710e2f86d599db4e545ef6caad625ffcd70bf5d8e771b953fc2fe79eaf018c798720ffd7888a863f9acb126fbed52a68d15e9c7c3833a963069cb7bda58ca7ebbfc648dc5d3c652a4495b0e6963cdf7dbe58a3616cf5aa3a24eda4a9f3696b7df2edf05da379638e4756d92d001d653ac1b4c96949e73795c3ff2b0f191a5ad1dcb5f176c18703c7886087873bc17f2b3164e5f192e94c2984ab4226102780eb7a4cdc3d585a9ab0b8aab93ef435c52de2fc02a2f8dfd76e9156158ea37d92dc01102dc14c75d15c705b4a4157010c9612b9223392ae04c9ab495e2fb0f0a0c00175c118677e6d8f08c88c5b1956561ef213d53c616947d0be05920c79e81b3bc342874618953d71295c8a2d117652fc741913e8654fa90a322cdc94ba305e0c07238603f302c0e15c6fae7be71e72dd9c5e28ddd3197fb06e5d7e4be89262d399a60dfc861ee9b6852f84619382d65e060df7cb6f06f948153fcc69db7941c6c7a0e368502db6c69b466391c1c723898d170b01cb7b683f77086cbbce5b895c71df81bf0f7f61dc8da8daa3850a73936e80545c44e637d14d33bca78308e65f2a28bfd86e434cb16a67137d3ef701bd538ebc210c6e48fa5f7d81697f9e9cfe9ddd957780bc8e7e37c10c73026b6b4b9a70b9d50473215f69dd32650d7f8b968df4c717e367ccf45cf779608f4e7f69139ee9106977237a4d5a580c77d2f00bc3517e73d4873fe8a738f005f076315be09c6302e9818df6d01f97d31b4b9b7c57317fce1de123a175b9b7b337192c4dc27f6f75807453a52612d481f07fad6b45f84717a5478fd383fdbd2c3a33f8f9f35c15f3ebeb7248fdcbd166a0552f0988f05aeac3548c613937b988b19ae3e17947861ea7a93f862167ca86e180e5fda438b0e23168b5c59bb89cc3fa5e210c52c57d7dc217287e9eb4d7ad8b36a13c4f5f56bee071603cdacc912d7742fe6da52d78ac54a97d50238284bab222f9e0a2fd6c3ba2aaf9c58ffb1179a821dc2b58671ef455deb05863a0c75b5ed425fa8d0f666212f1aeae4d746fba43f4f747c97f89bd16f9312bb4fde9da8b91f18ff0f0a77106c2db9fe945af63bf433b2e50fcadd99d41b4b331c73b466f39468ee28d23ba76bdccd1a4dee8638817debd31e97d96f57dd1f86cf8c6385bcbf896b56977f9bd50eca6a236763e5eae7cef6d95ee330dafef062abeb121a83f41f587ee768f9ebc80af11ab1a7b46761f69cdfeba5345b395e34558db3293d9a8e817aa1e5fa35e27009bafae08d92baa1596f338c1ef07aaf599fc99ab45773ccb837f3c564ca8ab271505dca67e919aba548d24ed27fac316dd71cb392ce4bc681fd9b9edf6bac0f1689cfb82e64f3f38cf55f64c15a83b6b1da9f247da7b2fa79de85f5872260cceaf6353fd3f2a07790d5715805731d3acffa5f15e9dc8cf7e5293e86e54e9130c6f5e29a9f6dac6f1313d61abc1cebe144ec09ac3bf63c8d633d8448f8e21a65cd693ccb2d63c25a87c6b3be48e45e6475aa9ea671ac2f0f49e3691daf27683cebd7530a730d1acff2eec87dc16a1c7d415e27b97a05199bd6b36a4ceb891e6b57c15c83a763fd738a7c32aea1f3b42cc37ab4948cdd78ed69ef96a2ac8039906e5dbd25cd8f2cd010e96bc07cd8e4bb696c195ea324eb4352e045a47f21f3b51ce7b00f73edfb73269b38d8af64d1e38c7e70b3a5e1de94563b5b72521b4f9c1fde8b4ce17136fa5d17fa40fd5c9da3c4ee1cf776d17aad59b16ff20dc6b12daf82a14d38703f32b31c71ce147be91ac6a3f901b7094cfb198c9b3ea66336ed6f91fc4ec90fced6fd89d7abbf60309d705d8fcdb3d098945aa0d83352ffc5f600af678eefc70ed3b9e6356a07b07bc252eb3d90b88f7527cafaebda2dd8bd4ea9368dc2dea7f5938b30a4cfbc4a3d860a47fa8cbc6b933880b29f8203f07b190eb27efdec5efe557343b94e99bbae1d85ddc3b072eeac262505f7c933e5895aa4f8b32a185259448121796657ca4eea39489f95d60824cf6452078f7a2650fe7c85dca1f4b2c09f117634922725b1ab147e78d3b549688af61bb2115c2db1e7e8c2eabbe977601e02668bf1286b4be2413c83c27392f80b4365f39dda674a43311cd2fa3096de2fa5cf470a67f99d9b1196c1f4b9d317e5cfebd8f47372afd87b811e979bc11e92b03f61834df704d585c4f6a4e7e07b4e791ec1580d701ed03cb02e92bfbed9eff19a2bc6cfcbfed1b880435c1f60c9d3b23937d2da29cc23399e4e8e8dd7f2ea77a10f8e762fcfc630eea608c6f8c469c937850e07cee7b6a8a384b0ce4dbf1b54c8c1383e7d340fc93c7a62ae92f71c5a7e7daa2b65f8496aa2017d6142ee47521b0f8e53d80bbc86cf88637f331a1375082ae6a0bcbfa1d64d60cf5fdecb2ec61f5e0b20a115187f0fe8348d69ce9fad8f65d6d798c56f75d5700c7f58320fbaef8d26670bf21a7c1fb37c01ec0c9cb1fe8c95362e1c0f09df863cae9fdb73c4b72db8a798ed3c8e655b7493ba3edcf330b7b94dba878e67fbebdb4789de5cc05b9c6be76cd4b6671d2fd95d4e85f50c7c53ea88534d712dbf7381b95911d0e5d7ee6690f30fc4314902fecd21abbb96ab8197c6ae70ba6a47855e7a590c5821df12e521c5f2ee6887bad6ad9783799c3b8628efe2ff5273e192fb1b84690cf7d06a901792ddd10c51ce9f2b5adecb9e4bfdb3b6343c5a51837c37fcbbb0903f0ce0cfea47a5b193bd04b6426e301887550307e3adc9fcc5da2058af015bea04c57aaaf5e6266b6051f82a8a172d999b52e325d9d3983f34c85b4e6006f845f18cb076294143190d8cc95a5771bced3e8bb9447b766e94ef8cc191c5d7cf1d2362d4ffc1e813ee15160b5098bf5fbe074c3a4273c77cdc2b89ffacdf872d571b17f3e9c6beb3b953164b4a8d955d62fe4cb82f65fb3d7e83bc6dc1e7e24ef15cbd1497b18da3189f0ce6ada80d98e1da817cfd63d1cde23b5874b3a4d0da02e60c663c27b71f07cc9758ee2ba4f6a2648cf774bc0563bcfa760eba0e471b13fa6e4ae21992da7d0c794eeccd97632e321922c17cc57333db423d3c425b3e7bcdc8afb0a2dd3d69ebfd4adc057dbd8deef255f7751a3e1bdccdebe180910f3c2c3d6f2877a5a4e774e1bb244f8194bb957a07e245d7240f7956ac7f7793973b31d32df3784b720e097d239997a8af86d7a38cf33fa6ee7b0bf141166f4ae50dc9ef502e637e8e01b39e00e285dc4d8e2c9853519049e933f6de30792cb8d30cf0bae55d467cfe2a8ba9f4e93845b408ee30303622cee3886d496530cddcf3de508d7b218e303d1f67d7d626b0e7c5674cbf6f99fc3aedc72e2d5720de2765d9f566ccb8411827c8cbfd15b3af37eadb0070e0a9fddd158cf701ee6df09d87634b6be3a3cf8131cee3d1e46e4b43ce58ec38459d7b46787843dffba638b926fd3b4cf0ce7a779a2e57c16471d8e5e658a47384a638776c69755206eb83a15a673cfe091f77aa7600cd9f4d3795e5bb54ff10e3390dbf139a0bfe0e69546da5fac95842fa209b171c0cf5e1c1981c7fe281710c3c5f04fa41927b6f1c4b5e3d4e925393fa78e01ea4f415d7adaca0afaca658d57ca82e5d658de81a73a25a53253a05f21bb16541cc9b633b6321be389c2dbbdc6c6004db11efa07393d801eac4fa46b63ae136dafc62407ba1c799d2facd163aa1d1e7f796bf7636d139fe6fc88b40efcc7fd3f3cc4570b3c4f53efe76b5b3c49e63ee93b1563b43e52e49fceb7804f4bb3447514c6862a3b64f863639db238e186bbd9ff6dbc95c60dea2de1af36b1ca6c8d6baf89cbc45a9f71bf3be2c6e17dd5fb3efd0bd10dcd76dc973753548eb73982ab8a33fe07d1ccea9157d8833a22f427ccf897fb38534ce3d900757a497e3fecbf730d6af123c90b6daa45eca67292ed1f7bfa6be03e64be27553f913d7d2e06e985e0cd79eb39b409bf9faf0b1b8607bb3c6640ae489573c4f0ecd1bc7dc93353d44ca3b64ce1d665b88f734ced7207b4d50dee1987977497c36ccb528d539b0f70c963e83bd6333f30e717c51621be97986c5fad79c3ce8d2ee5a9046f2e785a013bcf63641278adb0d72e76699b32bc27b98d13ffcca62d7897ad44b1c6758fc7b9ccb42e04d61bc479c67777c2be20ed18848e0eb487f4f216b8b647526301e11e74d95e48f93ef0ae4bb8cf744768f22c78b7357edea3ae84ba863015d878b6bd5833318e78fc03e11c55ae73ccc61896bcce7f511ad1718dac4d53505f0ab13a0a9e41e59a0894137a0d91d711be50cf01269ee992eae03c21adb198fe9c7391e785d7bd4ef86a8971fe73699c7f555873664a65df399dc9b4c36e67a6478377496927a3d541ac06ae887cfd4d04f7370855c3da110d5d6cf7097d82c16dd24f787f0aba573b063447ca0eb0e0fba38e14d5246429ec5317da03f176d28df0d49096d95df8fa561896daa98e750ac7f546e33c263e1c7fd547e1330bc673a18c5cfa51f27e02cb916a09b7defb2d1eaf74628cef538176a6b4b434a0d0012cfe39d291df2fd2901ee8a7e52701789c06f86b4f6756d7db107d7c0143c88d3a9ba1600acd09e1df7b929c0f7213cceb93b4f7a271ff3babbde2b307606d1e47e62c9836e20fbfabe3856e732ed13bf8dd697e2b9cbf78561d5b8c26bfa31f63da975c5e9da842bc093dea9f3f8a7d4a6bf51e9847a279f53eedea806428e6e881e48fddeddec0755eff06675cdc51c8c78bf221afe73cfe15e54f734a2c058d5d7a2386796cbcfae77509ca7aae71d314f5c6fd43a4eee76c84786d6732c7fc899223c3bbf4ce980df11091a1e3f66cb718186d1987b5b7d5c0c0df0ed75a06b13c7963a21e3aca4f77e6dd00de5c1381c177848ec1332a40e5893674943705fba5b80b72cae97cdf2f45df7d7e0ee1ce7f0a13d1f7b41200f7a7b259c90bc21f6dba0b820439d478dc775c7e2e71e8cab38965f62f38536e9422fd3d89f2ff65a694fe865f792fc9bbaefe769dc9b0af59f4a7a48be07d3e45fbff5c07bf4663e058a7ec0ee11e5eadae400ee36ba3a67db1b514d98921e57c59ed7b027cfa40cf6423dca18979f57187711f72a44ba2d53efa0dceb0a7d3af235f3a8fd6bba393f76a613d2e169ec7723c751ab6a8c28bc7554ee967b4ee510655da57c2e1fcb9ad5a38af7fb995a1ef11ad2f5b1e2cbd3fa41784e751e9f86dfb9c7f622ba3d1ee6922df37e50763c2f411b4785b3fc35a70b3b9a6e81d66fdc4d11fa80a13ef284bf7eaf6bca39ee3351553798e5cf4fe7c0754dac26ce3034fa3c8c0902388034c2ac219be54526360ac05f50efac5d5a03ea988cdbbda1fab2cfd5d61d4dee9634bfdb421bc7f1d9f461edde2ba24f56bdc8123d791e9a62ef608af6add0f7369db37535d5f5cd5e04f86f78bf0a180382f5bb3b587e27007cc9dcf357436df345babf16e7ceea7951be8ff5167467c2eb0962ef4279cc5b7d0aecb0071a8a7d886ba060bddad23b63be3601aa37b151db00a78e39b8c2bf210c22479b03a3ddf84c221f4b40dc791ea84610599303cd5f5607ec3d90fb2bbc265408eb7c63b054f20b32ee9da8cd50ecab0c644a3782f41de7323fe47e90fcbbc98bc325969b480631e42bee0727636628f56c13fe89d302e21d64bfbbf71a3dc476893f9f625b5ce563aa220bd59e18a2babd74feacd360a3f5fa13513d28c9e38c051f59d230ceb716ed50d77ad4716ac7902d29b50ed4f71c3d403eb6c0fb3c54e7cc15f3ebb1b3aa8231bc43a93f80ccfd48f3c98bf22fcb6981369e840fa278280ef61b352570272cf6fccce437551f8871bde0f78636170d757dfbb9685f60ddbd7ecf358539b34f29f6ded9546d2acf4de0a7d9c3010d6763c4fe6053989c4d694d939194f756bba9fff06c7f7d4177b64bfa777c57b9ebb0476417e126486bd43d39feaff4efb4976cdebe99ed43806a4f76f61b15fa05d0f8c9f9a4dc5553fdae7c0de5329756b7bd1e4cf56a3b3f5f033ea14f26ee072dea1a633cb4d17dd0236aa60f3bd9de7d556f4a622b73ba53d058773a72f9752cbab8bd39a351081fb7a3d8ca6bd6dc1fb672f81ef55a1fdae5693ac16b6e2763c4318841f1fc3c85b75e87384f3b0217a857d0c3db4ade3e87ab4cefc9ed35ea1f03ee69e738672faff77ea1767f324f52fb957656c89e8595f0d68eff4bf55a9ec0d9bc297fcfdbd24af081dbd895486f017c9078f8c8fe0ea97ec1b46624ff855af9f9f516f805469f093d3e85136817fb2a3f2b9129004e3876d1369eebc749e9c54debfbfb73d1467b87fa0cc56b79a697683a4e0a5faae7c675eff07b1ad267437979b8c8ee7b7b3a183f668be0a62c0e451b6f51474def67099ea73ea20bac3e16fb3ca5efe6d7deb02f4f76d72f8e97e0a0d87ba2ea9bf75fc9b3829f93f8f62344df6a65f683c42605f19be1bbdfe23f96e4391b52fb65c8795c63f1722b26accc7e1958fd57345e260fd0df5f9707314cc9592fc6410f7378a4e85d497c59febdd4f6540b368a7de4bde40cb60b3ee0ec5cb5f9ea7e2b319ce67195ab4f5ee257cec6adec170dc7bd9a9217d923da3d6ecca7e3a98fb3257eee709acc62c32795b1e1996d0eb351aaeb2be69fcbcf5323d7a8b4f61bb4aff0978da67063e93de743abac231215626873b099d2616783bb539a0b81ea6fc3bb3a80cf3b8cfb59bffb844eb3587446fcba96c18d7a00653815de618f82599146e01d9ce2c7bfc53df1e3be3ff998bf756049eb5017869141cec9ea5fac15784a11de26bdcf923dd79428b6e33e9d9350ecf312c31559c2f00863b5aa7334533868b52e93bb8e2d0d8f1f691c5631fe13f18083a12a77cb1f225f05acf51cc278801c5cb57c6a295c75fd69c4f855beb4fcf8756a411e0b73203b4c17fb9d3f19eab0dc9ec1ca73f079cf1295b49e2a8a29d861bfc77d82344a9d07a2d686227d72c6607e28e2b1a296840073f17cbd88cfb83e5ad86cadec9a97f9b5a2da17f5d64aa969210f0ec16cd96d17d7fb4072b0f17a2f493e52a3bd2dcb1121d68c6a99d5dcdfa28d4e59767979b00a8a6b2eaf9701d7ab1a0efdbbfc1e243eafcaba6578ae09c2419df1d97b5c1c57f6df437d3929c25da3360780c9f08de279c86a7e345c3733c7a6d6f8ec7517c79507e3871cbd53f6b93c6fc7700d7fa6423f31e5bbc6fbccd60f2ac62ed9e3c298b23be6e58147a1cdf21a2460adba4f5b2bac69d270ada84e59b1664ee5d8ecb516c79497635e8eec22cc35ea9d80f51aaa5ee477591d95a66b66e550d51abf64dd8571656915c98321e51cd7cacbf20d89c25bb2da2dcdd69dd4134b69f08cee96b0be6e30edb7c48fa26c486ba1c1bb6e0207ea5948af5991afe33b8e943d38d3b35161ec1a757b0dc05f451a7f857967fba6fa08a30e3021af921a68b5d75cc871530ef2e010c9b93563f035cdd92dd6b1cdebc7fd2e57acbb11fb2b29317744ee8015c88c180d14e379d5fd35670b6bcf74e9f747328603fa9f50df26463c0f865714d3b3c7f371abededc5d83c948b413913d0d6c2dac7ac264adee685ec9b783e3b56ef8833c56ee2abcce7dbd7d271d25e57acfb4ef6bb386f9bd2ea3bb2bdb16ab904e30123771bdb1b788750517f00e26e96f43dd585cec1580438dda23a18bdb0bc269c515cfba21758fe2a1e93f52dca4db3a421bc6701984c01f601ae138b8bfca315f9e558fc785a1723979b679cb7d2fa90eb0d067b02f077431a5e72311e1a2d1e0cbda7e2e797156b77a6c599b594bd878d03e38f2ed8dfa9ed2ab533807d777bc8f7bbbba6fe605f714c493ec9cb6e60fbbbdd74d17d28e0bdfe811647cf8a7dc9d70b79de6712ef659f8fe399fad9df899d37b51bb8a7602c0d795bfaf106fd58298f39bbbafa7001bee491711af7b99d3288d793f5f5f80cac11ec139d8d2f752292e620ce06590e211c271fffe36f34c7330669ac4c288f7a2d94af89fe3f3977f19cf93d2bdafc53bba2e48586aa207a833dcdaea65bb9771c98f3835a0fbe4dd24b1caf066db19d03f4abc7b15950ee7c252782b5a66aff673efe1bb30b225cb2e5438e6e621951ac4bb264c48fb95575518c1c8f65d42f118afbfb792de661a5f7b762fc66be3f7350cc11897b5c1772b2a01e96db43185f57a7268c72ecb56694f78ab2aa880356ec62713fe2ba60537fc2c37d702b6d8269bc9f1cc78910e3c53219cb85cee83de401cfb99bd203ca305beaf0bab0abb03955dbf269bc30cb431aa3b3dd766cbfaadeaffe06fd82994e14a5b93f0cfac6e58c1cf5b279f60deaaf52eaac9afe50d0fd6188dbf9c6fdf64dd78cbd0ef48fe304fa5bb3f9e29aded3ec1dc21e97beb7b7fce1cd18b4b239623ef25a3872b194c1d3e3247160f5d7d576b0dfb03882c6b0fc435f64bfa1788b4a1f87eceba1e18e5f8a4bac9f26dbae583b76e73d980d9c60dbf7dea8b4bae8627401ce23fe37aaf35ae7bb027d71d5dfa138061c27b0b74e58bd67482efd83dcfb2dd566fdd4ba733ea91af4e87d919e3d2a0de6e2185735f7a278261ac011c7317c1d1fac71505edfb8367dfc87ff4ed2d673b094e0a5c4bf151aeec497193db69ea289e47cef6bd3f9933ca29a07211a8b739f8858f734a7f189f3c91837d9fbc27a7e13feb138e35eb99c682cb37992afc4be27764d29c48fde297dc1305c22dfd733b23ff34f3590fd5f85a14cd6361887c59fd86b6a28f7d9b03c21f7e5401e14eb623dbdf6b2b30cc7feb2accffca2cd643dfdbb6a595ffcaeaeaca7e0ad5ad6a73df19e5973a92ca1d05f2339cff8bea99c67ec437d394f81e32939df601c969c67d2465339cf84e519391f75035a4fc4a7688125dfd9b4fd044fa8e6372f91edf5c76d2edb9fc3f90b643a4b161764fa17fb9be2787c2431e48de57a1683d1e44eff02384ae569fd71583c89bdaea6b29d09cb33777a58d7cd58d2ebeb3f8d83527d3d19ffcb723e8b076a26e7e9df55cbf9e277b5e57c117735eef4589cd253eb2e9527147a6c24eb19df3795f58cbda82feb29703c25eb1b8cc392f54cfa682aeb99b03c75a707e7dbf166036adfafe7688229f39974feffb1f7663d8e22c1a2f05fb9ead7993303d8ae2e46ba0f656c6330e0059bedcea72b032e830d36d378015f9dfffe29931d92c5ae9a231de93cb4babb8acc8c8c3d2223335ed411ed3ae87bec7ee7795f88e9bf84ff6f89e9ebed76c50738aa0adbe0c334d61616f602dfd92ef8f06c189dc5b6d122a9497c26aecfdee8ee34d793baa1f35e92b7482b7251b7a7676d7ff779da630066c01f8ee17cfd8db47c246f1ab3c8faa3a4cf5bc7fb75013fda2777f95ae0e964f7216fe761d6e4c171619765be15e77576a175bd2eb17daebf7f2bec55596ab4b5dde6e8cec3afd87b340daaf6b1cec6d6e1f4497fbe334d9f85e3cbf8a89da73d4f17ced750a65fd18b4fdaf9eef8cbdeb2aeef45d9ae6f629f09f686a97fd3e65979fcbed8fe2bba34db0f4337fb892fd9e5cab9c8337d331b738e69df8b57e2fcb43fb958b52b159dac34e74d9ae67a52473eb5a7ba98a4716f4fc6024fced5fa963b3f1adaf391837803e60b78f8f773fa4878d036b07c5e97bfef51b45d15dfab0bee3b9fe323d78de3b827cfa8eaf7f0646d4af7799ee36d74ccfe222cf5b6af7286dc80e3277dfca7685de72335d2fc5bf0f3a59cbf7ad80f10f785bad1b7c1b67f0d97cd314357ddf43d67fb489cc7b482efb066f1f821e181325e1afa367d41f7e6f6b46ff6311bdeffaab1eb883cc4b5a94756f21676f3194d72efac35f646e4d106c8b3eb27cf44ebe6793af7df711f0d7941f47e9ecf95769ca743acc03f54421bb5d56774df7ba39f0ff44cc7da7c7efdd14fdf836a86056dffaab9d4eb0b67fa155cd7c6996deb2539e0277d5a24dccfe7b4bbccd1996f6b62f5e761782ebf8fc4e7937e7d677a3e0bc797f1513f4fbb2f7fe009c15d062df60449c77a3bdcce07df778e5f9169b4adb50bb47d41e6d1f983dc9bb6455c34f54a7c4d6f7ec7397e8dddade4f03dddd56e2fdca52ee010f68c7c297f9fdcc17e267f8fc261cd3c4feac0cefba8cd7bd6ede7d9dc7df779da7df97130a7354768a927e9bef7761ffcab39fb2a2c9d72f690979fcfd95770dd35675f59efc57a3c24dc4fdaac6e7374e7db57f2f568fc77cfd7d7e1f3491fbc333d9f85e3cbf8f842be3ee0d796abad97cfeac12773f5dd71d7eac3b7ea976fc9d35770fc7d79fa57f5e677e4e86bec6e253fdffed6087f108efc1af53e44018f699fe697f2f33de9a012d2c340d88fa7f3f30d733da9139fda536d0eb3696fcfe6e79f9bab834f3fb4d5c786f84edafe17e4e791f074cbcfe7dfaf79323f8fc27de7fc3c72dd17f3f3b57b78323fdf7d9ee778fb95fc7c3d5dbae7e71b70fc6c7efe195ad7e6e79b68fe2df8f9427e9eb9ab2e8f0bd5f7cbbad1f799fcfc53b87c3a3f8fd405df939f47e2fcfbf2f35fd0bddf929fafb1ebd5bb7f9350779dab569ba7a8be6f15bf857de7a277b4ae42e854ee0ae7ded07ada5f48617aca5fa879dbab40f3fc3b625fcb0534c1d85d8734c0f3fcfdfca7e66aaff5d55c6db47f68a37f0dbf0d31026aadafdfdb47f16437bf02b9af4e7e05924fbadfdf47addbea57bc2a9f2fe415bacff39c0cbc74a7bf0e9627720cc8395eca3334d1ee89bbfd0df07c0b7e5ecf3900bbb0ecf3c4f2dfe1bbfa3384a768d414afbc0aef37ddfd47f1c7b7f91f9aab1de723e198daff53dc6b0de8d3d187cf1d0cafd617f8ee7c45a7773811f7075adfcaac7bdb12350ff34afd40fdd8a7cf2072ef777e2d6ff1cc7eea6b8e6bf7f5fc3d82ba7dbd92b3d05c35d00e2bfb1b69fa5f504f80c241c77b04d57d74f22b72efb53e5d57d030f6d9b3893af89faea1ef88bf67f8f9b53b055de7a9cb53a0f6f25a9d010a96e7ef16d4cdf11d72fe857a832fc8f9d3f70b3ad3e3f9fc040abe6fa93b40c9e8f7dd23d45c5e5631b5253f8482e13b721375763bb7fe6f9c0bdf7fb5b6f200f86ee577711fe694754c5a38237a6f439d9df50ae5af7cfce6644e8fdfd942ec0a6915f78037ebfadac2beb5f5f760a2f774d3773a2bf213ff5e59dd8ce925eed7f451e819abb94e5f93973fd37eb0a3cb1b3c73a3a5fefcc0df2bf489be4f7be402fb16bf11dafa6deb9e292c4cded766a8dabb64d1dbb1148ee9bd61dc77784846ef20477c9ae985dcfea9e06cd2b81fc390f1560c23986757e9c395d0116f9e3fc57ff31a29ce50bd86736b55fd8b1c5d9595a59e84a6defb75bd361fdb290bf5365fcbafa8b5abf53e6d799cb83fd25d5558473fadf27362aacc3e7621ded364f6d756595e72ef835e0c42087522f0e1dba0a7d5cd9481ace1d68ecad7670c09f371c9bf5bda9fbbf17bc6b9b976ebfcfbbdd99e4cd8c387fd09fb1611204619deb6d5f7ae933ecdd95bb161dc1768ddfa9673f62e69b9571a849344c0c3ec8dded0d2ebe25e57c08d13c4e528d267791ab3be4e08bfe644f656bc31ddc7bdbd93379d377756fcb830304775acede19d7b171603b43708ed66b8d845efb11e7c0b3b7bcbb8e3efa33759397985a73d9c0e7b4257f89f318f4478b4f13bb4590a8b01dca370c0d1e45d9505cf9c1efd1a38bf3467a1a7572f7a2b3dc6d1c580f6470acde9f10d695fece066d093c356590db869345673c95017ef179390ec2de1609c92fbf9ba841fb08e0c7b09baa63c38c4bce5a88ae07032899ba3ec9d70e104dff5cde5b7a510dacba97057e5fe9b7e5a9dd33e63a3b1b7836f6a97f940bbef28a79e0f0fded5a4f7c9fbc267de76223d1bbf9b2dac3f1c86c2603ff5423e56667103fa3dc387414fae7377806dd39e92fbc74c897bd413135f0df7384f6df6b310f654f0507b6ad333e55c0a3fda20e729fa9848d88299e2d7d0ca8b6905e45cd2662309c8d0de70494c5556ce6cb4c4355a3acdd605994874a00fbe91c53d0ef6c84cd99b494f304ddc6382bc72b4104983624e074957c6db1129ef467da62b3428fa7e48ba864e9dcc97e1bf08b007eb30d47b2bcba437676edd69dff5e30a7c833bfa49aaf41bf8acf87af76a2eaadabb03a11ba39ee899bd1b5f8575ee1deab89f3fec9fd8a4e30b30c73da45de700f4a84e0cfc9d98f053194e1ffd5dae876fdcbbf082b01dc99bde975c5fca524c17546cf48e003643b81907efae130eec7b521d57170b96cee85b727088df7788c5a29e61f9be66517db04056f752ed7d59ec45fe51f571a6555a6d65fcb48bfc89ccae8bb93eb92d7560bb7c8f8feafc91cfab5877551e1c35795582715c8511b5a6c25f4c7a6223dea02ff6c49c16fb79cc0958c78af457123ecfdef38fed0195f03d4a9717e179cee75b567b0888b973e207d3e389d5a12adf901f3155663ae5785f5913f9667f5def976c9ed4b703be15a2df58371cf7ca38aef4caedc82b91eecbf5204efb687007a9c4cbedf9c80c37a55eca62ff11f7440db33e44e330ee698388cf073f3597bc9a006ec573e676bf1ff5dd2df44b2ef586aef652e6aab1482ea751aed7c4f3e3e31837ee9f9ce7fdb4af71aeb77276dee099aed3b10f638cbf43d21327d34dba4b5eb5752e66803d22d2be2bd1bae2c7bd6b2f787efdd1177ac5dcefc28e7a77abc05f54d86accd2c31a73fd153b0ae3c96a3d4a431f13445f9edaf3afdcb7839beee663793ceee7dc707e03fb8bc07e1216a0fdfcf0b19f4f877d663426603f92c8ff0b78ea9efcb9f2e2b1d81739c179def6c13ebbc5bc51231e91b916c0abd2614b35e45ca8f89b7f29f702d60031f2533998784ce75c4c0fd89cf81c6ffde1277fb8c3b8729697cfa5a4b0453d7d6ae102df1672a290361d705ac84747fee3337d4dda7a1fa5f65344f473ea45bdba9a7249299e690bd3e57bec4ba4bc9fcb617d5c2298ac6a4ff90406a579bd0a6d9bd74ced1e62ddccd7c8d6fecadd19bf9443f7cbf2cf55ce83564fe4b60abd633ae4b4a2fa3710ab03ff2ca727601f7966c4367c5f7957b052db8df82e3af7a8edb1c67659aba6be7d68a98474ac9e8fe3b9de75698f25acd067275a2b8adb7a430bf6ef7eb616ade87b75aeef2d8f7bf2acac04f35773934b6b2be377bdc7626ad413afeb1ebfe5bce5c5f9a333407762039ff5d973986fda7fe37dd00e7d3a2abda76bfb44bc08c317de968c7cfa1e22a7d2eccf94cfcc93bbb85dfa2bc21e66957808d903b185471acead10f156f95cef622a1f0df54cb539b234bfc3aff928bf230e7379b3cd993b30387ca720ee8d59ce1b65f9b4f123ca8d1df7f95cd28c8ac6711406f4baa38b4e3b2e6c1c3728745d1132be3ee5e26b4a803dd9349a41e953e87f0ae0fb11ec7558ecef067963f3333bdb19df796a83f2b180bd8c7cda1a1f2bea89e859a6b2bad5c4cb287d93c3c11d19d3c7f9194b750307758fbc10d335c618d57c50cdd96dfc463c097c161f2daf111caaccfada1a557f56be93c7dea05f58671babe7d8cfe12baad9bfabb2e014eb425ec3a30ee172b0ddfa1ccc7be9b9d3455334105b3d9273ac8e39d97b4beef58eccb1b6e519f279adb8666187eefb9af45d84df54f395559ed5e4c1b1712e78cfa79c1345cc93c369737fc27fbb8f238bece308e6833885b195f1c6531fed725ecd23b820cee714c1dbb99b37eeb04f72d3692c59ae41073aa6ee6c2f8237fa6667e39eeeae9c1decb58aa1eb2ecbb2a314f2e58f38afb3e72398e27e60b1ffbaf6ae7acf0c55655893c3a9daebfc99781a0ff72a799aa6bad0f69c739ac369ea3bf75fe957bcaecf8a3280b44fc53edba3245ff53c6f72a3a4f735ec5b8aeaab1ccc90e72eb933aad1253d87e06489003e90f188612a9c51421eddcfc28f33b75edd77d4d19be7ea050ae7b4714f6b9d9e609a743c736b29ea57dcfd9be4acf8682aac159df79cfb828b3f9ace80533ddef97c7708e8ec18a7d560ee3e7fe69cad17e4f57dcd39785dde14dabe30ee9192ab4778cff24f53744e1465ff23dd3df174570a73f7a310b5b1f79adc25e25bc479181fe76a3bac57ce2714ebb9ec6abe28ed6d9fe4866d2c289dafa17368f2ca310878965d8e4f0b791d8d961edbdebf577b95c131ccf99a953eb795bc4f0a1715f9ff497e7557d50d17e8138f847ab9afab498b7275474d117e45b555711e30ea7fdf980fcbe06bafd9cae3e0cbf9b31c5db7d3d545a7daeabdf2fb6baee9cad78f25fabc66ff85b59bbfadee5910917b4ef24a5d6bd7dabf5750675e67bcfe8cba98d769ade36cea8f89c84f8198cba4f6493ec46b3bb32e7fff422ddb577340df595b6bc1f3740527b9d3d0023a4d853aa6741e7798447dd063bd37b7b12c475a1817c56a06815b3a1d94cff4d2f372839e9cb6802fa10d85fb86e75aa68c97d61d37aea3d3001ea932a6619dc8b7a3a14f745765d3d3e3fa324e1e38bbe9d247ee07e26d0563b9e29cf8dd7025622b93b8d1cbf335ec67ffc6cbb0c778d53f053fcf628734a6e00fc7bb30da477ded9f8323a1f3337396f70ae4ed519f73aee69de6e8982cfddea4a5be49e1d17c875ceebab4ae2607fe56193eb60a8f8209de472bedf7aa2916c69569a80c434dd13cbd273d76a55efea5f3e2c8aeda7dbcf4f3e4bcfa2a401a35ef2f9387b466028370c9d119687af6792af3622ad35d7810f8748ee93a37531150b5fe59bea9fa2e7ef14e90dd4fcee04beb96ee659469e04a7d7394dd21a9958147760fb3fade0a83ab07c92ee46eb37e40487834973c98289e88e0717542c08d2a6fe4e189bee9217b2da571158a367337bb5b82e2bd86dfe7d7cfdd2fc8e7f03c4f3f098e99c6461f61edb9b4ed54e405ca040ee326983fd226181a4793e66f727ca503d986f9337965d6db0bc067ec513da0e89bff5deeec3dc969a678372d8118f7d4f525ad7d057258e581765940c8734fa8d1b9dcc1c0a37b15b07f74ec1f6dde7497ec19eee45ec38391edef49213c37aaed11997ba3b2229ff5ef4697f8c6d1e9c033a68267f4321981322333383aef179f25a0f5789c67334355f978337bac654c9bde2598f4b39c90412477070b309e507356f6d14537fb9ab2bf403c3ecec13cfebf415861746e3041ef891afa5b2586413643186bac139884b3e1c27b51c167e7f7170c620eeb4c833b186b4efdaa2e5004ac1ddef1cfed497a98b4e3ee60edd80083b5756efc5d745ff1672c33b026abc18f88eb84ce215a2656d93c65dd75bafc632a02b695b5415623b67c8b79fda2ba12661292a357780ffa46897d6ef37312f8820a7c308e8b6d9e8bf2b102cfe8ad6a7095d609d5efbf75fe16fce473195fd9631cf7b7ae8fc673ddfa31afb5e6b913d81e9f111f5d353940f8eb91cfacbb929fc42c3a3d799869edba93e531b2382ce072fe3618cbc9514e2e92e781a3c31ad849e4e7663f8beee7b4d59ea2cec6125fb9edbbb40e6f82cc9d477482b0c477703c77abb0b9fdde635cdd2f5b5ab2a2fa8dfa6f4c7a621be5df4fcb79fc5658525c7538d34ee7cece0e56c037c092bb6df34a8f5415152f013f343e033093bb3d0f7ef4f113f80d9a58a8610ecbf5ecb0361ddaca1c0fd4dc1d8bcf801cdd5d22628382ff7d9d8f22fbdb28fb27ac88ff70683dc353087ebe806f73fec05b52e75a5ea79c9f4ffd9b34c793e21e53d7f0ce00b27770326f6cafac5c5fdb78dc24275b401f2ef76af67e09aad6b9c8d70d750108ffbf72a7af8b9d2ad441caf1fd86346ec8c1120e3dfd34c4cdc905db294367210b674d167e015f5751b29c6cd107abea9624bf6bb8a4dfc5d696efc626388f6bfca3fa0fe89b0c1d0de907a1c7e4eac6139e837e65a32f87a03d6a4e26d3951ed0eb3bc4fd05b582dfc05765d6d1a90a0f1c4c857dc0bb4c34e945b2835b65bc66f730024b053faff97dab6ecae1780bfd8a3c5f39d758af24771f0be78ffc7a75df8d72b5cd30ae2edfab99d4c7ce870ba1292cb695054bcf720998defbc8f800ce99e2b3a7ae618c81c8d357f818d282a106aeaa14e1deca83b3a6b09e39ba8078d0c9d57c7aba6b3986eb609fe2e0857ba9a5fae8938019ae84a9c4be4d8706d1fd1ea47f10e55d45dcd694550ff8bb73029e69ba3a8cc12c4fa3578e7e40df7f6d8889e0fe187a52d1fd2a21c13339d4197863bc98d69c0ad84e0e9cf929d2e3a87952fa021d5ed1a3e3a7f3519ded83f801f345005726ed1c5420d3d1fedf80ddcff032ce9d1d37dd2189f21c55fbad91dc29aaa3d76907795e16db277f5baa41519b6d144a4fa5f98e784e5c6fc8f535ed275faff0853b31f0be3182e75bf4ff47071bb64af16acaf738572cb9e61471af23d309114da7925f8833e29837c963b4c42e951c70927740e6181f912f92d2832a9cb52263b9bccf5597ff48f7420da07e9bbb9a679c042cb907baabde11bca2c70596d15bc6f73e5739f949f69687bf1c4342fe42ee21d11dc97a85986a2af9ad31d0b4b0a6adf72cb05ee62b1e206fed631edcd7def72ad439eff7c234aecbab99ff89fad406d94cfc5536e1d17c7ddb5ea78f45bf01e8de6eb54a9de4b3633d6f461759c3753a8864b65bfee55ea4ebeaa611a6af89055fbe09d68739757c6ded457155be76aa600f521ecee28251fdfddb1c3d319d304f3abdc9fbddedf538d5bc3bcccba987625d54cddefdad7801b843d429c07bf9e831eb727c5ac061568b96f02d8c15ad025f993479d288c061e8c02bcaf3b26043b298f3239603187bd6d112698fbe2d377ac8d7334cd0fb817aa21a1bc21a3834bd0be33925823396b937cd75c23adc64320cf55e0e57cc801f2dfba55a40588b50ae17cccee1f6c87b877c55977682bb98d341c6cb889ac47ab81bbf459d658ae999528e4f04e02ff9a533b86b54fb8717e809f3b48a74299fd7e5ea0172ba280032ef54bf4deeeb08377d2a5d8af149525316f907aa3b796c013d46ed79e4a25f6824f000dd85ebf2208609bfa92ed057fd2be06153c6e33ce5c0324eeccdece5fd07290763b27fd2deca8113c5c060dee8ac573b49be3e2dfa1ee5b837592fe6978b4eb09e4e6f7e4bd788efbe6ca7aca3897860ca9310d85da3879e57786c9ccefb53563d1dcc4d3ba13615a27ae0a456b097e4d755823ba8847050bbcf2b5b964e6b35f36d9ed937d8dbc5a42dcf705735f3f10feec03ff807ff047ce4358fcb385f91d4de26be6d2888dd690d60e264cdd34eab73dd7c3cd57d3e736ade92f96a693dfa7886d667c3959cd6390f4fcc296b3733aaa5724dda7ad4ecbbffccbe7542730d42c252789509ae2a2cf0471fbbf29b4a07037f869734577b68b08ed4bc81f9b70a7b347a157b4e08761ff03bf1cadc5b7ae26ba7ca9cf84b3888f83496cfca9c0f3eec03be7fbc0227e4d70cb795b8803f3ca14764d2d5e0597824af313f20e67c86b7d239319378cff1589907964fe9939407682bd4e44dc3bcea4bf36a2e89c33a3d59c3b653a18283f93332bb9ed4e8bcfd156abce7745e8c4f2ca8eef585f9d66c0d6ce3c45ef45ec11f5f959d20914721ec2e437c8d7e7b8daf31047f304fe81e0cabd18da90cf34fec2dd50feb6a3ccf3ff8176829d5d072197c8596423556c278f1795a0aa71a5bb57e459f60449596cff82458af8696f89cfa0a2dcd0a2d85f52b7269b5f9712fd1725eb53fc42b723957bec16fcd6839f89a4f80057c8d7f3e1fbd2047391dcb57f1d57f45d6f95a1b70fc926c82792bb6693dbe427a1e8c27f86d5ce73f07fc33b62e8f3b840d8073757c3b4778a8c95b1445597327a1e192bd1406a526ae8bef4e9872e0a3bfad8fe30abe76ce776d9ba7c8ffb91820f153539ad6e96a549cd68fefa94e7ccd6e1bbfa9ee23ef7f526de35171582e364a71c1a4bcde29eecac58191afb66f1d5ff0b5e3f839ef43b6d2a2c0b7312d4afe62eb1c87ea1c45df906fdb471fb18f8aff6a289265c0338efc7ccd3a11e55fc731767e5f9de2a2d25c67550edc8639f0a63d457c12f17bc31cc8b8071587a4b8c9f17e739c838cbf227a514d7354699d8b637b7a88a279731c53a6519cffe9308fda348fabf7a430cd0184f57b9a23648017db7400ca5f29fb39e306d8ebc7f3adfa0b1d77946c4bd8c057e83823f2135ae5bd918fd64df462aab2b95eb6e90674dc50f6bf9b78161927c4fe762b9dd1714109d77803aed17140846ba255b7ae1be46dad36e01a61e3d6461baed17e7d19d70db284f6e3637fb8d5aea2fdf612aefb5df43602d78357fc920cd7c7e76cce7a7c6f5b0fed775774c8bd61bf683f3bf1f75b791bed57577c54b1417722fde8c49f6ff57f4a7e7365ef8d3a0c8efd42ed3cf2fc23bcd7fc1cd60cfb9a52cccd57fc9e93e4eb54790e0dd7e9a87653a7a5bafc08121708dfc76f3d2fa98135a33b825f0be723d5350a7e93b2827774cc9a785238a8114fd8151d12f94c14d2676f81b9cd672fe3bce0cfb7ccddd99f2fe3a5ec7bb5ac83b07f05bf0bb987c4276b9b1b159be5fd31244d531fbf8da65de204aae5f7ad7cd31a4794f750f6f15a70b4efe277e6e4a8428f866fb3b52b36b1c9ae34cb1dfa1ca769ad7ad92b9edbd8b57b8b6434a3d5eb710dd5e1ac87aadf4beab735f8226df259f35d4e1777f05191673efbe6b5629e6cc0232a762fd23be5eb7a3eac91e1aa0fda21beae93e3ca392542deaa7cd860d74b67a9f57c52faae7e7f88fc011694e7e5c556fdd0c51faba57b7efe8a9f54eb57636105ce563bd231feabc3ebba89ff1be65e97f513d6aad35b62c57a5c36f0166a4ea16c3bd7cb367fab635c59277b18d6c08f0d736378992e422b5f768c416be9bd69a077c3dc6bb54c6fa2d58637c7abb5f416ec065d82985328ebc4b5d146ef8eb16d2dbd7b4d36b141befb65bacc5b7db98e71702dbdf70df46e987b7d2cd37bd04aefe698b996def3065d899a13bed1568e6f5bf1b84ffcfa86fc2906df2da8ff5d834e6f88dff98a9d8071740bbc5de3f106789b6c5073ac5e85b7dd0edd9bfc8aea9c85df35d92038ef2bfd23ea62f7b83f41fb1958da6be04b317afae6fc8bf177cbf8d6f32fc47edb7474ce6f6fc641d39944a1d6b0659ed6b3af661cb4c6bb2de311671008ffb6118f1dcebd9a6178361e4de129db9f1e0a9775b163cd1cddcfbc52ba76c8399762a72887b44cf1f20d315ffd5cddcfbbea70f2448c563bc773b1572d6e9f3aebaa83051d2735cb69bf8b0dad87bbf19cab51bef8b55a6baf8ab14c0dfd1bc6f32db2dd76c6f50ccf0954cb5a0de753d99a1d64067dbed5acc346f53e41317e40afdd345e78c90e65675bb56b22e381b6b5bac4f646c39a8de75a2d76a20b1fefebd76e183f6fa36fcb9956ed9a681fbccd9e75f4b71bf453f37956e35e9bfcdb5cfea6415735f8dfeb719b3fd37e9655bb6ebbbf5b78176ffdd14fdebbaaf177b3f75af3b05668129d4be5deeffd62dd57f646edab7e6f1b2cad674bad30b4d67ea171f78affdb0a4bdb79502b2c1deabfda60e8e407b7c14120e028fb246d733c5d0396e395aff8c339b86a73250d7ea465e9b4da04cb13756019adbec32f6e8009197b21cf2f9a607aa216ac0196e7fce306789e3a736898a7a61eac957f3bf9c94deb369d11b4ca71d79ab0067a36fbcbadbaa889a7f8463943d785b5daa58e7e7303ef35e7e9db7567c7dab0069e6df69fdb70dea85b8ae3bbd587b5dadf8e7e74933e6ccc95b7e2bc6b8d58939e68f4a7db70de685b8ae3bbd689b5edb9b35fddb076c31cc0276e85a173ad58030c2dfe75bb8e6bcb3737f1dd93f562e3703e1ade7794f3b3b4de5555d850ef19d01fd794b857e8a94b6ed9f4007c916f3f78668fa85c20e04727d9c313bc5aaae5ea02536bbeb90b2c485d55c809d6e213a1f3117e50a916ab0b4ced39e82e3021fc8e526eb00b2c283fa8520bdf059e4e79e90ef3a0fca0a63beb655efa421d5419be2fe4aa5b606aca4115e3c2120dcbbaada986b0305fee7ca419b627f2d72db021ce4a6a6b885af0d585276af9fe4b39ed96b9106729eb71373eef5017c08badeb37e5b9bbc87fc39d8c1c1c95b99eaadfe9a8cf5a72dfcdb289d0a918d6cdcef05dfc9cca5c4fe6c3bbe9e2da3b1b39ffba95271a6b6bbae9f2b61c79332d103617eb75a3c5a64b3c5b99ebc9bc79175a34dce9c8f9ddad3ab0b1eea51b2dda72e9cdb440f862d067ef828386bc78defffd6883a12dbfde05964117d9e0abbab70c4bc33cb0f6a493ce6ccfb9b7e80a98dbeedefb5f181961f37d6bf8fee059eb526b82fcf695bc7bf33c1dee5b4378b6746b3d5a9dffdf32be35e7de32befb7d6b78ff86b68eaffafb6de3dbf3ed2db4e874dfba658e0efe7dcb3e9ecfb5a7f37de5be75baaf2fdcb7ae9de3893c7bed1cafdcb74e79f70bfe7bc31c4fe4d86b69f4dc7debfa799ef2d76bf754935f6fd101ddee5bd7c3dee89fb7acdd35b75ecb572df7ad5bec408b3f5ebfe79abc7ab36ee87adfba9e679bfdef165c77cda9d7e2bae5be758b6e6df1b7eb715d934f6fc675d7fbd6b5b86ef1afbbdaf5965c7abbde46dfb77ede2fc9fbd34fd91c98476f5eaffb7debdafdb6f9cf2df8ee9c43afd79d2df7ad5bf8adc37d
-eb7a1d16e5cf73ef2f55df93bc24efceceab6fb6a37e077bf97262d66b359dfbe1f9aa827c033e7d3bb966dc55ef2dcfa5b7a33dd4789598f86ab88fdfe3c5f69cf811bff98b79d5b79cb23e5e35efbd67fd424bef5d69c4e4aed3d20381af3b024ff7327ef869e5cdab93aa0c7d4d111ed91b5a596c13f5df4e7be2dc19d9b91834199af4e46a36ccf552dfadbabd76a01f625fd97b05a53e0b88b7b5707dbaf2602f1705dd33a7343791bce3afc9abdafe205d6bb084873a007120371d865b798065f1e020c163848fb4c7b753dd53f7b3a8fb7c6415cfa2127c67e75da5de491659c199a25986ebc0fb3cbb307a5b1fe067454ceef343cafb159d13cbc985173ffe61a69a65d8fba83f0080e3685a0675f4aa7cec1d543938cc282c60a8f3de7427e18c2aae39139d0aff80efb6f2c4d6e9cd3ef73e76a54f0572be35a25fc609056fe3ba912e9dfac531a3f31df99e760ea7c93ba65cd47b047c3305fcc85349af72044f2aac07efd888519d07922eddc63eea695a95d3f27e85ea7e836efb95aafb153bef97a8c23ceebadf1e726cc7fdceabfb0d93f7dba3f74a3af53dca7a48c1b7d959cb209c07d0bf71df9393e192bdf9619cfcffb19db2b05730bf8e7a1da73da5ec5c4f2620fb59ff919fd1ff937e4ee78b2e936f9c0cf352594f1702fe3fed5b559c03f697f192bdabf2e0a02902a6f7aa6f21a736758de88786f81dec2b6863f9b7f211eb30459b92f32d925e059de670496c362ada9599829a2bf30b4cb05ed4fba1bcf61b4f4d3c5d19de8cd3ca2ae3c8a02721c0eb0efda63ee491581e1ce3b4f2808e4c7bc1d14ea8c902ecb1394ff9b0004bd6236cd4afc238eaeec3f0621fc254b4f1afbf8903615947ebc77e4f82e78a7dc9e38baf7be3281fdfe4bfaf3b7b2ad6a065785ed7bdd91fc5b8190e3bbe3f9c8345a87d1b715c034bf37bff3958babd9f9b8365deed0e410e16a7b1d63a074bd7f75f73738f834e670079bad6bf951dd5b88bb04fddada37e2df6d842c9d5a17b0fe12d2d857af871d5dd09a1ba93725fa4373eea1f03e163a8acbf5653df96684e28bbbd2ded605a6d5f19c1d55c35d7df6c68f1f4f8aea2e6108756e243aa2709e8b4eb2c95c955a8f78647bd97e00ef3926f4d7a729a8977d47c0f73cade74d74cd74ffb054e51eb7f00bec1801fde0dde8fabd193ecf218547ff839f4f92cc770df23bb1cf5f2db732701574f2bcfa40387a1c791aea706b6a9007cf73d8e8ae812e95cf30cfc76861a3c4c99c5b6caca47ed3183b96b1f71e0679bb19f8d80d11d58a6cb5a868d5f347980d7f7d5d25ced84a3f799cd017bd8d5ecebaa2a9aad2a4cd43b3fedafee851ac15ab3d1f8ce257fa87e6f56439384cf0bbde1e33e5d9dd67dc4fda66c244f5995bdb8b99eb4683eac8ce9d0a32cd40eaccb1f72bd66014dd7432b91d5d21eb21e90653e28f6f01f348fcf7a25ea509738d86e0dfb6b378c09bc220e86d68e20efaa2c78e6f408fba8a365addabbad863e357dcad2fe54102f3c3da991b16affb6b4a71892c61faff5213bb127d51d9ff9f5879ffce10e63af097711ded8db8e968e283cb6e2a3616cda57b63b4eabfdc290fc8cc64fb5275ebeef26ba375ec2d3a8fe78d9eff2b64443d138a12decfd5da48d735165d399bb40174840fe4eb067962c5d4d6a1f08a3c951930d9f213684b6d61cc1feb87314b4597be13176927fc33369d1693a2fb776149ed59f1eac3a7d9ac14ae57515eee827a98c274f9727276dedc1deb6403f693dd80b11d8fa33bf5e061a2d9d384a700417c0afee79ea0efb0d32232360c5e4dfc007d9789f4539b475a0137ab5fde062991a177bbe9d107384df66b793f9badaedf87b84ddae85b5d16687c27ae3f3870dce8d185c10ab765b75034b25fce7ecb6ccfa00c7ad763bb29f5fb2d9297c5d6d76e62ba0f7d964b7b37db5d86d88cb0cb755db9dd2a6b3edae5b1b6dbb533e7dc276d78e69b5dd8f8f3b3fcaec3690c1820dc860afdaeca8af62bdbdae8ced60af2b639eb2d739196bb1d7a575eaed75558f6772d5d956a3e0fa5e5b8dda4f575bfde458a4ad6ec6679bad6ec4cf2bb6bab68f2dfc79b32f16d3f7eb761ac816056c746a9f623bcd07f3d198e0d7df63ab2bf07e9bade6ef3cec017b4ffd9bc456f3eb7d201cc6448dbdf6e37ed3ed3d70b35ee204ec697e42cc437d9bcd4ee6eb6ab3e3ef51b17613bc8d761b17a87bf2a762b3751ae6719fb4d941d4e7ba35d64e60fe9add4e61ec1c6bc73dac4b761b310fc26ea77b6bb1db1b8c4bfe546d764a97ee36bb665db4cd4ef9f4099b5d3ba63ddedef4b5b5e5cc73761bd2f5c013459b90ee01116fc7bcd068bfcbe3bbd8eff298a7ec774ededaec77719d86781be2857f683572d6d986a360fb661b8ed853671bfedc58b40d6fc4699b0d6fc4cf4bf176ccd3c8783bfd5dd9a648283a7f43ccbd72b591759c8b51cc1ddbaf6f8fbb2bf07e5fdc7dd4dc655f1b1da3b81be86359c53bc4de4755617fd5c70fa91ec97cac78ee226faf2cc3f5819cc4769bc5619d8a7d4c6cdbd170c9bb46933d3dd683a98d3fad2c2d3cfa793c69f4247c22f6867b007c0d6da3d20dd6bc0d4ef54922c7872588bb31182b8e363e77387a6d73b4db71c498a980192e79d57a08985f787b138193ccbe019e6fe8b99fe851e43e1b6d78e51bc0abbfda716aa0715a9aa7c1c656f7586fc3a36fe9714edea03fd9e023c43c2d66f3c35aa5365b60f3e17ccd0cf8c3f167d54742f36d35f68ebf6fca97a7fc046d5cde4665630ec5d82792ab44872374bed2756c93ed2ec015dfaf204f1a016404b1c7069c14ed5c62531884aef837ed76753f892f81b07b6db02538abe21eed9754f1d1dd663f37b695af73719d4b861a7c5febe9980fc294d055a757afc6ae8176180f78791cc5afb1bf90d83b61a462fc48c86cdef3bd3ccbf4ff37e2efe7f6509493bbe13a175561074db93f6d34b1f3e77695fc9f320cb7326e19a7e3b7d96e63ca82fd87bbee67dee95e9036bc01e6661b9ee6ce61beb762c36be6693ffbae1987b2e500f6afd9f13c6ebadaf23b4f2f03f5c092b5fb6d3c03477e5763d3cb3836ea71dcddaea3f75c6fdbb3ef9fb1ef79de7fdec6df799ae9cf65bee99ca7c0d74fe7d70b7cd6ddc667f2f79a9daf8e6f3a17afc0d860efdbf1d3c5ded7c0f7cd67e3e87d75b5fb2d38ec6afbd1f8e96eff9f1fdfc10780355408fb5facad8a6d4f0dbdbfc1fedff991e3082e1fd9ced1f0c8cb4c98e6af4743472024fb5bec7fc60bff520efe897d54f26983a6d83d141ec2517d349dffc13ce9b7d97ea8a3bbc7ed10fe4e363f86f34bf61e314797dc7b65ccbf63e7135c748ed7f983f1d0688144eeb125e7ae778ad75b6c7bcd3ccd39f141d7783dfaf6a9783de6e517e275fea112daa8b1be24e5d5e7cfc9131eea6ec323397acd7e17c736e7d8f54e717a332ebad86c044cdf9e5b2fefa5abad6ec057573b5dc5c75379f527c67e9b7d8e6d43af4ad7ef88cd85b5d1531f6a1cd7323d9e581db2b896270477837f4f6c9e3be7fbe6d8fca93d14edb2a7bbdaadde2e33f7b92c38dabac9de3957cd7dff36bbbc9507aededd2e43f8bbd9e508ceafd9e5ea1ced761931e6dfb1cb092ebadae5901f39074d9648e41e1bed72e59b17ed327a9e06bb5cdd63bd5d8ebe7dca2ec7bcfcbc5d0ef8b5e56aeb65932d4a79f579bb9cf05077bb1cc9d16b76b938b6c92e17e07ac62e1770d1c52e2360fa66bb5cdd4b57bbdc80afae76b98a8fee76f9b9b1df669763db80b027df11330bf498d0464664d30e1f0395909c34d67c307d8150bfc92e43baff2bf1f2537b28e2f161cac241538447836d7e68a331d15833ab08b84a088ed1e3bf2f67de930e2a213d8cee363add4bb79c7906f3d772e6e879da73e635e3fe1d9b9dc74d77bb4d2c03e130246bf7db9833477ef762cebc7eae061b8ede73bd1dcfbe7f2a679ee3fd57e26c9a3d086bb5c98615f8faf99c799ecf9ec899a7f2f762cebc32be29675e81f1191b5fc14fa79c391abe6fce99a3f7d53967de8cc3ae361f8d9fee76fff9f1df67fb23db53a3e7bf232ee7dd89cbbb714c7b108efc5abda7b6d3151cfe6059df93334f79e15f89cd9fda47e9cea7aab0d7ad3c3835de1da697b8f028d7207ff83c7547def74de644d9c1a45e5d9bb237835eddcce4cd2331f515305536caf7ab2dc31d78ba6bec8dde2ad409006fcc37ae03d7de2aabd2fdc349a8bbce5543dc418b6bd3ef5cb487ab1096ef8067631b6adbd3f1d53be4a8f11b947fe4771957d973a27f00fe471f91cdfc9a8f90d1acb38f00e4757514d666c947c868d572b71cf51dda47c8efb37dad36df00bdd786fbe5397e7ec237c8607bde3700b85df67962f912cfc636db6ac2e33c5797ce1ddae7caeaf4ebef8fe7f0fad2fdf3f2f8922daf9515a40d575ed0574ff810adeb7fcf9df48cbf5ff0216a607cd68740e3e789bbe94f8fef8abb023f97ebe611742fe6988fda68d3abc1f737d4d06bae2aaba14025f7d681ae347235f49a253c3ebee9fe7a42e798be44706cd7e3ffce3ecab5a2ba6b36ebb903df170e1f4d3efe519507274d61beb39efeaa1152588db73d4f87df3918721f76a7fbec29bc5f3b07c8d67cc68748f7f65f70a73db756779f01d27b88e613fba36c5febf6f6d57bed198d9ea9afaf1983be5f94ece9a97b7219afbfe2331c26ae40331d7933ce0794f2644d7e410eff4fdc91cbcbce4b75f685359b7c02e4b71defb9b7ac53576bdfb85edbdd46d47b54bcd88cf3e7eec99571ff62cd7d91674af9999760f82fb8ef9ee91a143ebf9e3f00f37fe0c2611cbfeda23ee6a32ceed65c15e70f9bde77d5dc4738fc77ec3b3fda107c7cd72fb6c7997d3f6c4260ef91f65d112eaa1cf84c426f99bd6953277beb39acbe0b39cfdeaf8cdeea4cdf577dfd8dc81caf421c9b32b68f690e7cb8e34ec9bfd79bbe193d40c3e3c0373fbff0ee72be1ec3325cd3e9849fb505df3acefd2e7a6b1bfe1c2fd9cbf84d7439b9539edb6bd19ec01e25c99bae6538eade9c9e132bcfe80d7d5539bec1b7138b39b8f82d78d49cc7dfe03bb7a7950564a7a4471a60298d3b25efd7029934def875f29eb6700634df3eca36c6b2745a8bf1b12abf4f8bf353ed5c8fbfcaf7013f1a12db74cf38b195578edebb405daeb9c1cd7c78e7adbc3a6af220d12d17d3758e9afcbe8ffe267f663d603688f93f9cf87ddcc23bf8f3def066104efc9ee4c43608d237959533efad6e463c17a7988eea5e3c93c231c39d5c0d42bb192ef6664e594b3f09beaaac1c9e589eb9f5e6ce8af78bb0fe70b8d18737efad42551e3c34970cf5b5178f0d2c93de3c346a1f7d4761600d9fa79cf2be229d1fad715781bc9e56799b7fe57b5d7d4983984ff3f9a714bfb9f75ca31e5529cde4d8d73d09d9fbc00ac449faeefb8bf1d3257963c3083fd2f73676b5fc8fc779ccc183c9729a2fbc03dcb9bf574f3838f71d75f4610dfd695585bb577e0777ff737b921e26edb83b31f71eb31bf70b387d6d2fc9dbcb893ecff2c9b8ab132496da7ed9725479557a77faa3faee740eb73ab11aa46f16033bf63807c95bab066185d0d72ef61278c0b78647d91bdd0621013fe4967fa37d7e10603f8514e68eba9d5f7ff4135e4de7b5f37606cfc332a885251ae3031c6a4a1eae71bf3806273957bbe9bd55a39fafca9aa5b9e37a3c881f04dce7f27fffef1fbffff0b6bf76a7cb8fbffedf8fc571ffe3af1f3f7eff216cdd1df8d77ffee7ef3ff6f6c5baea7f1867f7cfc3fd977dd919ce55fff3bed39df3fe2ff077fcefffb02eaef3a7e1fbf1ffff307c1f4c6a9f3ecfe06f7377d9da0efcd1299a3ef7ddef3f7cfbb1fbf11741bc13bfff70cfe6eec75ffdde3bfce7ffbdd8f07302c3c9ffc089ff20b03546fe3578ffabdfffe3fde73b41bebdff86117f61d88fdf7fd8feff35ed5f3ffefadc3afeeef71f7e08d79bd8ceeee372f965ebd7cbcefff1578ff8fd07f56bb7bdd8e7d31a4efeff7e70e7fb687bd945fffd392031ec1d7bfff9fb8fa9bdb7b25ff4b09fef3d82f8f99fbfffe0b6fee5c33076be8f9c0227c877f28dc0fa187a8e7e2f9e4306387d7d0ab037d17eecc0073ffec2b21f70e77b84cfff0454b476bfc0fc23809c1fd45f7fffbdf177bffcbfffa6ac5fb67f397bd6eed7df7f8fcec6d5dd9d2ee0e76773f7f7df1185e0dfc97f2099fffe3b221ae37ae75f97c5f66215f826fae5e86c443f5d6f7fed7797e8dfabf339fe17bfbd18d68fbf4e57c7f9fd8778d93abb946cf07fabddd63f9fa26fe933d8959f7c1d2d9bfe77b4f3d27faf77fea5f435f85169047f36af0e44780c7b13934358edd38fbf2ebfaebbdf5f4321dc037f365f41fefefc877b36e10cd2ee976f43a4e07fe03d289e1edc4022bb2dfb88e9d326b5fff9fb0f737bd9fef8eb07179eff192dcffb993d543499f575c21028fb23fed9c79ea18623bd371cc46a7fcf1f3e82b9381c01f56d00b784b61cc31e2e584cb8e9eec0d1e97b6efc90dfc23635020837711d866b436c4b6ff64b05b80e96c38c030fb89d06b1f19989e61827c1d389fe9eb7fb7786b2e0b7cc31fdc69ead7d383f33756ea638bca8ca710f4c1633951e9a08e6761e0cad7920b4d414eba029438ca19d2b430b375d19fa5b79725589cdde046e1615c1b2a69d83065c23b11fcd3dc9cd4db0982a83f1e3bd4a90f79d38bc697679dcd0323fb019439da3f114c4dbd99caeee73fbfd66f6cc1ee74eeeaa2c9c357170335ce3a6276315feb61e3ba3d566b05e8958c81d3ef2f8937437802504862b05a60c4293e55e3d1df7aa32bc8339a2f613c05531f67a8fd9abb2095caf23335ddde0bea766b8556078e33353e1a69d563de0bec5f303d701335c0798ff0a8d72fbf38cb0f2ed0dba2cf687bd94f8bd28f1363366871bcc5973112ef612e66cc4312949d450594aacb8dc0c36e2465a33635264c6c2627d5cb1d2d859ac1d7ebfc6d4fd7233d098f1855d1f973e434b9e19f196b39b0ec3a834870c19dab91874e069f2f21aef61adc99b3df836c74b7be0e632d3d55913870f83960e5b59f3d470e8abf2e06482f05e1c9e60aa233956062e88ec3c0c02b80e3c7407e2f9fdad8c7b263d816eb911d6e1a89fa71b1dd1623060a6135c538478ec7d6fd081a32a8203f9f1b4b2d4de72bf3d411a392a11e0ea7178d2e589a75383834e60f9397bb17c5ed59ef098db43d19407d856c661d9d84e0e1ce30ef17e1b2d011fbeffc38c2399547babe4e81cb8fbfb99fd2e507b6cb6a42fce4ed64255c61d234ce7872da47417ba2e200cb574784d7105e57207d7f8d833a30f87995e32f7d2fe38b18f0b096005bfe70f9bcaef19aa7f9af7e09ec03720d4d8c3945cfc443e43197bce2e7cd31344e437d7682d0fac773509c9d669f2045da4dc7e991e0895829b164237f81a3dd53f387160bf3dc1d288cd9b2a6b98a630d9be68d45a7e0ccf308f8b8bee4e2edafabcaf59ffa2ca034f9723be99a7f34fae5b19b722b7103f9a0a1bfdfb70def30aff33c15f7e2c4c8527dfd9f841ef49577374de6fe581bb95079e496f7e3e818b0b902555361d4e5e7906917e07643a5d3f6b7970de47ad167c813a61906fb8f0bc5fa63c02748d80a9f0e96ac87b28181c8dfa38d4e14997a5874140996c5ebf4a8337868e5a4734ecbfb436c4c1cd5456775359e6e89e857ccc7415b51352d89b19c2d0053746b9fd9faa7c05f4f277e2b84e36e363a08bea4657bcaaf20853a17be6f171570ffb815a90c9f66f8475f19bfcf3cb4c74acb28f8e543eeeb375366ede657d98421c66c71354f66cc0e7129bc53414287b80d045d291a184321e5ae49735f8d1c79da7c7f7fe99b29943ca4308383bcab8121de771321b1dfd7491ebde97e53ad6e7655efaf887bb17f811d8e86fe3c9b4d43ae1fd1c5dc0775fa38d7102b80374ef087f9d0e47cb70aa17aa789fdbc3a8154cbc7e99e7623de1c136dcf404d3c4b4f569712f301cff38446d70542237dfb5a46fe05102a70c434dd6bc5d0edf395978080f69b2dc30085d869a03877b60a6289e8ee0110e906edf9e02b04fe62ef803fcb339fccf7d9784ff3dec67af63f84ffed57bfbe31d7b7fc730fcdf08ff89f701f6fe85f0ff1d7f27de7ec2fdbc1afe7799a239fc07f8fc17c2fffac8bff8d1ff6400fedb640072c29845ff0b6a325e63c24696861386b6305dbeff365a9edf1911bb8c96e7dbcc8e23da49ac85efa528772cdc8ca913c68fa186fc683c60c6f090e661d2e47d4b4b21337e3fc5dea2afe7a3e4345a031ea474d5c0b751c43684c50085e8eabe975c2984d199fd01a2e4fd0c7efb6167df305e648dcffb9d4ce20c8d5b3b7178d57bcbbd0922aee9eabc55f8bde63a20627676b4e018d3e57e2bf781168651256cee270e7b5b65758e61493318dc3e9a5bcee6bea9f0c0aabf57c5e141ef0d070c4dbae5710c3dc16004178f8fac878599d38f372e247b66cfb8aa71368473c9500b493f196b84242f6e84c9da1126dc9ab9f279fc4da42b6c6c1b82e878826f151658727b270e2d630aa256017ab10c1d586638bc19f6d0d260b27bb937e977b86fb3c77a519477df1b04e99a53d332dc4d343fb4a6ab702bb378e4e5176894db9ff3a87c4b93f0f087a1d8e1261cb29b90d92f8f93b528f17e926d12a515b3c6a50d3331871b871dae9d15bb92f8fd1a67f64b8c9cafc6ce46dc90f34d385cacece170ed1cf722e6cc57d47dafc90e06798b96fac69485191210716f65fcaed38ea35149264770a2ac50219be1826f61c6602adc3559f034d739003ce9ae89030f89a14d78d81715d50def9ac25eb68af088330096ee2e631c059e2e3b58e42d0e1e75388a7927e67b0dd2423f1df7862239462f1acb51c3bb4e4b96416cf65113e7c9c1a486ff4434920efa54b297b4795165c78787a5cbdc9c8086b4d4e75c28c36fcc84c5f5d3cad3e5cd5e9cac84f5479a19b870ebbe401d82b349e3fe628fcd16b4e5a8f2f2b7c42359d0b863d2e37de4f962b6e93a8ed91bde8cd3d2ce797024738a0ef416f2ca315de7a089f1a11575df47deab63e914fe508989afadb180b13f6e8b7d12ad045161da7a90641df712588790c20545c6bfeb275980f7f8219d5093ef24738ab31d84e568cad266a6ab81416f48e6b47276d3e54ded090fc61e9e0d577217227b307afc4d3d49be2e8f2f3cd5ef7187f1151ec8bac2c308993df7f8be75528f0d31b74108e1561962e579b6ee845015e160b8cedda498bdd1630f0b91fdc708c97f207f9c8e173eec3f00dc061178ba38b86ce5fe757be2edc5e17e4be6cde1f6a1f6580fe83673eadc210d69a96f52e43faaa259291cf6f061b83cc9b8de834bbdecc13f46c8fcb6a0c8640e680f3822e591f7a8c86078d0e9c9c37860764d546fe7e1499a2432b4e0ab8af058886cf6337be869a3d23ca755a813f76c8ee8cfbb463b446e0e985d5265e7aa294cf9db989727a1493b075838490496d103fbcd228762f12cd01f90af3b444c4c2a2fd535579e6967f8c9b21bf8599327c73a58f3fb3446837129127ae77a1ff56b52e471aba8afc0040f5d9943caa7c83f923c70f51e8b99f2c03269c9df89f7487fd2399a137d685762bdea1827cd31ece15955f8bd1a1d70fb7a4fc0387bb84cf4dc2229c07d0c4054136a8a7006bc1715ab1abf2536dba0617608d3c321061f28944d6077af2fe0e39d23529e40fe7e3b1a67bc925ee8751edc9ab119da392e44b61ce9da0b27e699c7fdb61d8d91f39678d7ddca92af51103f17555e1da1fda06131cbcd709db788b72b3290fd1905608f39be4ee506f2b5a65877551e1c3579d9ca6f5b7900b36125fef7f4d31037293c306529dc894c6e2de76a4c252c82372e72b287d84e193a0b91c534c502fae5aed39383265b379d5e39c60103beda65ab2cf740ce8db07fe5ec828e44d06ae599f5bc89d407864bfa6dfcbca073344e0ba987b9073d0afb2ae9a7e47bfca1c9935025f66df8857f36912e6f868b22ad7a7adf6f60bf2ff0432bfdd59c3e4f0a4b200e720f3f468f434d428dd854e42019c32982a32aec61dba01fb33fc315e4ab65336c1c8194a797f41ef4ab806e69836f145839be4a8a63f6b9c783b2820eb1623792efa3e2c8165e847f26c259ef19fba51cf8cd70dd6feafa057941f34ef9fb77ae2724bafcb7282b16fd3c2b64c97099fdacb27fc013df60bbf761de5fc85d66f08c107b20fd06e4a902f3db327fa205753492feef260d1bf2e7f57f1c43a54583b18fdb60ffa7abc8a69664248291c4cd29d0a90de32912833e168acea3fb2d86b138be4cdf11f00b63fa00fa023f9f22215c8be8dfb1fffffd05428796a2a043560c8425a94002efffecbff7f101d994111cfcd57fffa38f61c400272a19c12873539310c4df3a24047b3fdff12f24047bef0489f7dfdfdfeb8a7988d68460a7299a1382d897b381e5dc5fb77c53faffa694e0f390c419c4ffd30cc5ff976617232e282617f5abed98ff8b19fd2fd7f65d385f2edbf87f9222b5fd194cf33f69c76f4e3b1e72c5461186be59d9fc79f8e7bafb15fe47ef8fde1ff81fbe63bb7fb8f6e98f562d54372ad14d6f2489935d8e2adeffc27a7fe1ef7f907d8c78fff96f9c54f47ffefcf9f6ba6202530cdedf7eb6e9b67abdd4658666b504b1f9afd4291e1aca140fff53a5f8df4c59d44b73a164d162682f0a1ec4211127c7f69c3cf94755f67efcf76d7b0201d047fa7f9d262d8dba5f54d9b37614795715f6a14996b0ce7e9624bdd231a63b093922b8a9447c644fe1d6d69df4b99e66193679d589c14113d3f184aa3037e334c135851d64df5a67c3be5f747972dd2a435c8f4bc9389c744c77e24b0aeb7314ee98ae743529321a43e18ee66ac0997d14fe8daf7a9a2c5d0b3f2392d203e9b821245f93054cef31fb4feae3ac86c7bde8f0fb15bc79b88a4b0da29b067a6f7586374da3a0c803419cde631fccf4bedf267b281d48c449644b3b49d73850386b6280cd14df8e0e596089e0c1a4989f8c4bda5be074530cb950560383966e9a3dbce8c40ad7e98dcbdaec4d753d47ed2dedc51a87c9039d50f7ba4c1e4d39703857ea1b34199a53fe4fc4cf484d1c1c7542c0e1edb71189cde88dcfac3fbcb9abe1ba1b251767f4c6db118e3ba3260ee7ae6e6a4fba68f2009b29abb3e1923d861e3866389c1827f666d87b7beb44fb66a6ac63289267b8d283a1c77b9370ae1a4df698a9e999f47eaf8ac36c6d8af1e63dd631a752a8db430087f7b9f6a2bfc5bd6dc2248ce6e9ae7464ec310993b232e982b9747775331f646f2b0f8e7acf783369cb33c2c035e5411c5c590e375d7abb90c50d227a09662b0b1873b8005edbeb075c57c4205cc81b00dfc1842f092dfded1a5fc4b8bd6a84846da6eccda44988e335a1fafa03bf722701d295a3c7a46e0f4040765095a56faec1ef86b841ec7d037ce746870b70dc08fff9290687857cbf9a34b936e12d0ec3d70eb8cfb9966560644f7786a1de1b3ac66935e0e825a9d9034cc7215f5cb530b82fe4e5552526be4e59098c1e47efc95d0ff3b5079edbfbd09981bda7b86573bf2bf014c6daaaad9fa48bea4a6111c7cbabee92474d82df92dc542573bcbbd7440b9b2997505356b8e1f6f7fa49f2756a4c9a94ea9a238c5c28cb6b42fb4f31182c940bc4e1dc1edfb9a910aa8fb3c553c155c7f09b36957c6d7db6f829f633bf862e421afabaedfc04fa84a18fbefec0cee608c73ea74138130707c39580fc4e743ae64f8a4d74913d5b5f3ccdb66e5c7460406a62709dc9dacdb02d2f2af13d7aba73f11451756734a96f258c8cfe7ffc6952039874e6dcc9dda0a5ab368e6ff4d0ef1e771a5a06d039e3c14da33757c3952e7a4f739684e5e9f4f2ac874e0157aa6d39155c8d30525b93ce2c64ec798d3c6b4af0cee464923960197de8cd9ffae472e05cc1d2e9fb59131df3737ab77582fd47837ceee5e6c121cfef4698fd3f3a1fa9f36d6e6a9055dd9ca3992bf598a971d5dcfe151ed251917ef91483d1422477321e3c94a944af361aad4cad213fc17e7d4e2e868107f8ea28d12b27e82fd74373162e6fdae3d2e35cedba508cab91d32f0be5921c5cbdeda800e89587396571b5b7ba1907afc7d181a3bb26b61d9defdc74755b2a6c086fdae5e02ec01c0e0eaa1cf8b3e83000c07ad294e55b719fce4fc395305361af0c3dc075fa4e2ee4cd9fe5b17377f3ce8f48784d402636bf227d3bf03579703269cb9c9fc07782ae4938c94d810eb4d6263d396c1f5e4127cf9448ff9a23bcc7b9b863b8a613af7105f614e86933a2c9792606ce6c9dedc7a40677c3958875cce30b058ef3cd29e66bf2e4b01d55d6cac6860347958573bc96af890ec9d1b865348d89f6783715e1bce9ad2c95f0cf6638b8a8cac719616f308eeee774eed04964d3a4826bc42b47408307bcc2b0ceafbbcf8f83388eec488cc3d0ba457a281824f0ab0a7b8a6cd6ea31837bd13ce32460051b4ee5681be35853c667784b950e2ca3b7ace59d78ef8e21ee2ff065055a0a2afe0194eb614287ab0ef4646f75e6e83e392336bf6674c433f3c347c1ce23f6775d1cb03b6b6bd7c574f9a7dc8b7849777073ee5ccc9902e83b38b6edcd98b28e798c7da769f01eefa12847f61ed000d2747edaf8064186e6c80336fd669c96bed1830520cedced5f937f7f8a410ff84f26d81fe45bed9afb7f898e1027b1dd0a420ed8ac30b016f224d4621fcac0fdbba204efc06e9b6b2ce0a63c99a727e7c6724e05fe421c077342833e96ea92379d96ac920db7585b3deb23dc02fe487e1eb927999fd300f81df82cf43d8e42db195509deb5d0b2664af03ea3c7c09ff0b8e9865cacf107985b5de311cda69b0b076ce33a78cc7b9b5fb3d001f6f56aca81cfacb1b3b6c62dc33523df6e2ae8a68479b1bd8daf126d3cfd805b726f6572f4d85aacb1b371c01df0ff19b4c177973d312e6b5b3dce751e1b3ab0b6f220f175ce86edbc7f5296b7d84c42c39dc0ee4fb10e3c1bf6d19baddfcf5bf807b37831b82de4becb1eabdfeaf6f14ffde0e98a787c03fe1c6b6bc8f5e2ef00fe20cc405f6b8a85015e9cd17740d770261edf4c97f434eae3ceac31d2b0c13e26ba3901ff3ee6f9de2af01165fd4c0e1af5c739f39f6dffbca32e82aaaccedc89b5809fab8b7b6f260e424d19faaa2c38338a34561479e228c69e898167e0aca3caab01f4ad6829345c32acca2aca6f07fa0ef37597bc15f520e063ccdf2ac252a7279e9ec854555746ba9c0aaed93ec7674696de3f29564f7d91713c7e82d900476a625be88d07e94e8d814fe7c1ff37f83c33ba0f7ce2d82e2dfde8a04ec08c937430693264eca3cb9e96653be2b1b6764c74b16e1f015fdfc1beb78a30d19521b6dbb4ec6f7471813d32a9a1077d6b25dd6b90dbf71dd8579d266d550e26c057a9c653d839b23b92a512fb3784bf11c52676102ed61f3fb7b27a9628cb9989ce4fcd2543b87f31b1b9fb9fc601e09d89e5706ff1ebc8ef8e630447eeb12637657c4dba848a78f4d413e0930ba9c94152cc7536ec784f3288f5c6c1628d912695d047d20d07f30d3b8073cd44c706fef6f691d9094dc17c18cbacebed68e6efbedbcce17cd644df66ec23f09ba3836ed11a415e06bc284f7e69b17c97f0e79b541207f449a0df651c2bfb2ed64206f1b1b599253c07f177fc13d86d0d1e3a5a578e621f71fc55f4b7d7a4ae49d89b06645a4c70d0f735f1e871f480e468e78af2b900ed4ab61fea4430f722c482f9894f688271f406c433c0f780be0f6a8f90d6536847ae0bf182e5ec01e00b10a33ae9cf46977041f77f1ab6ff6b264bfa56f47f296b1c03f49a177c91e47bdce3403c479ba1a67ca0f701ed8d0579d1b0036f215d4c8e7e27790ad8b14d66e7a71b9291fb3ff54760cd89f75fb3f078360e9805702e132418e3cdc431b950362eeb1eafc6547a6ce3dfe5fdba6d44f77b1517c135910900470c030ee099138e3b9b2ccf803611be98826ce88f208c607252fbb45de318e0691dc780eebece44a02f36095cde6c7d71f4a9e03034dcff2db559716c3ba38f646e6fbe6e1f816ec1e398ffbc158f397e1c5fe155d3e9f12ce3986f42b937f1adbc7ce3c5e06182dff5586cee5ae44cbcdb393a147872d373e06b8ac00e9be1c0d59dcb7a27e3b64edcaf5b65e518ee04d37bacb990077af22de726d7634820f77eeafb522ca51324f095afcc7880eb32eb18f630c945ec57b99835e6f3fd0a5ee3188a9a6c4e76d38ffd3ad5e9919d6126c24097616140e42f29cb33637f00bb54cdc51081ce3897c407d933f6059b85acc93850e7603a16dc4c420a97c4e4a189c04f3b16f2015017a63a19b31828eb6c42737b2bf7f79aa8ba393ef0f5036e03dd9bc9c2e404fecfdaaa65023d05ed0f6b1927d6da890cb00dd7cfe9fd0ef5e014d8a2044fe5bc89862d0e1fc0de5c7831b10b519cbd5ae3b57612e8e3ad18dc387aec9b62f0e0e8bbaf8681cbd14bdfa08233471bbe6907038ec67cd50efa0b917d6c15ef4da737f6ec31fe07c6a163781dd5371f782137c78d79201f1ba023566bdc0238e4c67d5295372096fab59027ce8c3afacb127c5c21573474221b00fc189564d61f1e371a92dc7809fcc2eb969e3cd63df3ba81af504ba1393dfa9b07cca5f10b89bf1a3479e7e833b989e3266e3acefe3d7e07ff8ee21f31582ee416ddb7fef0817f98d375d7c594f9a9435de7b850961d0cd00ccb6cf230e78b60c1a718300b913d6c69e9b025a4e3e75470743aba7af5494f1e3b7970f8a427b84993ae4e08b8d10b2c5359dde0552e25480ba38c5e70d464cd314ec171ab4405e2da34485e337adf2ac2455586ef3a4dde8c697031e500db2a435f530280a7ebe714fa6837dd0d069f598e14c073d07b4347a3185f5c63b68c07c66ec4dc9549802993205426c1559904ae2232fee680d9b3d1fb9b32090c851abc836fcd10373f27979d32fab82893a0afae87e64cf46d6ecc908c131872c8fc1245df9ed97b7b8633bf18fb785e3ccef60c3bfe62ecf3590ebd1d3bbd5b9f0ef6e7423ca63f5f3ccea719b5ff73ee048632b95fe11a705dd39c89678fed050663efcf8b8767809f73a74057e0b781c1389837b31d8339ddcf8c7d116721e3cd94bbc7d817761632ff2893c064ec405888ccdb8c627e6d6cdf9e89d15a336a7f6643d4bcf793221eff9989e6bb4231e7221e0646f46f16c2a6508cf74921e738cfa48ba14c70f393627e2de1ba67ef93eaff339302238231501632f491139d7786f80b199f710d7b26de8fe5df334e3fc6dbe53dc2590e26f198c16103f8637a844b9ba3d813631ffdd501bf6a8ab1dfb8d269a558f719c5ee125c7154423fc6e3a66712caff8475344cea1bd4de56ece4f796beb02fbf29ca3d8625a525d0c59f1598012f253c0061c54e107f87f7129deeb616321e37d994d6b6849918c8485c403e5b8275d58572615723afbabf30e547f0dd683d9eac378fea7780f7321e0170ac862bc477c9379f13ff1f4584730ea5c94a9cbb285a817986eb95248dd788b918fb02f63612c70e8f827d6e5b911cc739974f984b59bdeba7d5f9931e605b0a87af40cd44ecacc29cf0ea5d27d40b8c63127ea0f629fe354573f45370d3e8d5fb8cb2f4199069ea785626c171663bd74faa20976739f42f4a4e9e6693c0d028dffba48e39daddc1ff018f7980c760ee00b11720478c7d61808cb214e07188bbab264b47038b2e2deca6cbb75abe73fcdf66bd81f9392aee435326ef1ac0c934c14b1e574b2f925fa81fa23d00b9b17143a197de0207fb3997f7725e003b6303dc2c3dc0975b91f13ec560b51049b88fa81877f56ec479ddcf29807d658198e5934e5ffd3d72c4d197d7d84d712d43a396b7ad184ca31ceee0a7225e8c1d1e3c664ea0ebee247a597312f4b830e0927598d0d295a97151448073cb3043dffb047c3ab9407d3c0b8f1e4bbdfbe201bbc9e1e537457cf7e5aa8c439d00c628f4cae264cddc3dc6be708ae5f240becf12d957eed7990868e3407da1c99b4aae3a8a3fa0dd0c172273df51cc2f93c20861bd790887ccd7366ce08fb3ef9f74ff4f73e4858bd1c79f1b7836363871ae16ea043edad293704990474db4c2d943c585354fccd65ee2275eb5137bd337c25955d8914eac9cd994f96d7118df3f471b9c3f1c096e34bef307de354e775c38f021cc374e57ce427c3fcbf8fdce49419f97356337325d450976dc9a3434ca79576cec98dab2e93ed09ca02f10ea05e6d58f1a1de19c3c71f4c041f90e593e70f927e3dcefcc0183b96cc60970cdd55c8d62de3431f13ff6778ec202602b95c9dd9e119bab4a589671146e1a2d0d617e3f8dbbc077f9b3b87d20d8401e3fecb9cd1a0ac5fc0271b0e116e932532ec719ccb53ace42c69d195dcec964b903e07369e220f32d28e897ba7a8fbd302e8843604ec55765d6d12936f637311fbeb87738db063d09810fb87601dd185f77a5be393adb3a2d9d347970644e187cc970a75c8234973ce1c9759ae737aeb1ae9a039a1a218c1b0e5bdaf13578ce253d6662b0967be5efb23300ce85df6d40ecf2290b96490be7689f637247446bce9d2c4fbc20aa34dcda0378096f275a0e377e4fe3e699e8904df980bccf6f1cb0fbbc679eb772e0401fd14e6262e6d70cc7bc3991c5b00bb97fe1159cfcccf11343934e1c7f861c7d4ce4eac64d37be4e05078e56fd2d057df4e8bc931adc4c62e018c7a22f6e8e482c8f93f9e108733ac6010f3fa781ae8877db8865af70f60a693d713ea7f7805963e43254dd3988a337bccb1ec6f1cf9c4ca60f1798ffd9c258c6c216bde555ef99574d61c7e959fb347837475e2f8ac73f5cd65d9e4deae8c767a9778e36bc9902e709f8642ddb72171bffaa2996a386308fe201f8f507eeca8f89197f3f5844f0c0bd31a2e52f94e8ec7c25070e5c7bcc0e447979d6c3a397f3d34318c3d3f7eb565e021ffe96ff5d1253ebd4d1374e781c9783ef310097bf90b1da35585bb367d3a5af53479775bbcf0feb1d684de71dcccb744916c71b768071aee99863605fa21cfbf2e46c54d99cab50be37de4c0ca27d51d66d216b3a8f63009e2b62dc08168463034b0771eca984b3a940ed365111f7da9d5c3431476f319591909bb68db36e3311ee11e6eab9d3e4a18aaacbc8171de6e128d6646df5cc281ae039823bad1ca317e555127e88f9edb25096fe6e8d3b7342f20d62b387f4ce6a552c1087c5fc0ef1c19d56b7354df634851da98a00624f6f175a87843f96ca2a7d166b46b19e4631de6c4d1e16329fcf0d621af047c4e39b490d1e9ab21a9ad3e4c2ece6ccb8ce91b183c342d9c3338b19bd275511f26a2ae3a92e80e717ce59ee49e642640e8c7d39cc4266cfd89781265af0e7b3f565b090f7d7ad4b7ababdb7398af1809c8bf600d3804f077307da09c055aa03f03ea701f609e2d2e9d133a7ec201753673a77caf8ba38882e0649492c4a864ba8838f907f8aba1377b7f2c0d2e960b02378c0c77dd6d630eec43aba4c12da66822535489ab8b7b7f2d29e017d96f1fa6316d528c4f8dc1f398a39f2c0979e2e7dc30ebc9952a81d817983e41c2bcab5e574e37479d6e1985c0e588ce2fb19dd873671b61e849c2bc08bd9eb9806acad1d3539704c7ab3372717873b09e7adac6133ca31b9e90ae868c88b0bd92173e77a853a0a53de94ceab257d37c14866fde16bc53d3c34b170d67ce75c21d4e409a66d12195c9e1957f33439381a848431f6f1679166632baa71c10faab23a6ca9424edbe2951c5d7acb6bd1fe429b90bcf60bed6446afb88e64ba04f827616e718a17f6a9d39be279c001c89270d769079b51ec3bf001b76bfc9acb8bf4a09d162d8f1331cfb007169011919e5c353a706462a06f25dc5c28457caa44651d8cb535879b32a46eab2e3c4f9037b93aa00db03f644ecea15d83634ec2cd54d883b6892f542bcbab4190b8e10a8e280f8ef0755d60fbecb2ddb2ae3325b1d57d52b707f08c6d4338b60e2fd847678c514e2a1d7be173f9636dfd1ecc6d2c28da70cd29fa58c3c2597d8e96767c89cf8ecf3eae805e91eeace62423d98b2e222fd6b8f359e4bb63cc77196c397fb0707ed1b24e96c3da17e0d34fc17b76f12eca57ce227e8b6a48801fa914f9c9a0cb7293f3a34a7562a9bf395dfe59e661d656d33326f88c91ccfa9abcfc9371034b75253f1957fa7f5136d664596fe6e782389d9f96c89f6d15619cfac723c09bd2fbe7b4f4736a4c2ec409e0490fca6fc4c3c0761c8b63376f2538231b5c5ab744df7399be502795689c8b43c8596fe997bfd1ed204c646e0bcfa937f08c40b7331f16f866ea1abf2164dc0232ae3b176f2193ba2ae1d07ec3bab53566cda66cbe8ecdfb141db2a4df4f151e05fec0093ec000749aa3015b9ee5ba9d269f07c8fea7dc27756273357ad21dd69846b58eb7854c3a9ceb3ce431ce97e2b5ac76243aaf733897ece9ae14aed25accd5fb27bdf1f2f9edd4c719657a9851962e6bb38c34c67906c6b671dde206f85512c9d1cb0836e02365759e64b9060acae574e96f4578c6f5a726567dfacf69e0cc4f46fe8c07f83c77d656a10fac8a914c4b30cfa3ba6abece36c5dff2cfd9f48354c5e0bc503e72b5a7abb48691a30d9291dfcf06f0cfc3d85fb72d2ffa5e725545f2cd89e6698a01f49107fc2c03f0bd7c84df71eee4a88d2502ca1ced3c60bd81c2fcb915079646af26b0766e1c78466fe5009ccf2816d7dde0a6ca4b9b9bb20e478d83d9da03f360b03e279e0bae55fad98c626ffa49ba46f5820c8881810f7385df257e89c29306519481a23e02b17760193d01f2d442643dc6be5b9a383855fdbdbc6fcb789fe211c80df82ec59f81b103899e9ce0376b1cf802b567d35ae44b3bbabb1a41f9a2ac9caf20dcf4c457b0196f26a630a1d712f7519e3691d929e67174bfd93f5fe3d3c4e736a8eadccb1c5e22bc025984b14e0b4ef7306e01b17f5297aa8947180beda8bb855a0bc2a304ef0ce0b30acc304e39efa8ccafcee19eda6d9ce322b442ced53c7dba720c7bc0ae4635e760231cfa43061d58c00791edc0d10a67a77579924417217c5e689756e4a7184430b8cbabb829cb39f475404cd3505b8e8c5d5dd635129d75ad8b6f8b7be8ff29130358bff6a960dedcfeb77093d409b0f0795dcd75ae9abcb4997c5d43458ea4d89e6b557c6ea04d8ee3aac27e5cd6ed5f4d7702ec19f4013f15cc376cf8e4e2913b3a63544e0ec688f5b6a51c4f433e02f155be1606d6d03d70e4b7f06c17c6edeff17d05ebba90df2bf165e497c7bc221ecb7bc96a7a9ccb4d595fbc0a8d7332a08178ba90637a27b7d2259c851733aaa3f90618143cd651035d51707296e36b693c992e64845e91569616e513fe6ca865eecefba5394d84cec8749fe5ccd65e945f803e5e54cff6ff13f72eeba92a5dd8e805adc604d4449a09ca5945514e3d81181050564005af7e3f35aa800235737dffbff7b35b7346118a3a8ce33bded161acd54f8c53eff07287016608e7a1f17e463e08b289f65be6a64b53feefcfc6e75ac336313a4f8dbd74417612e062cc04f04dc8c7a0f57410b7fe2b8dd7ab291ffa4acb9535537deed805ff4cefd0f25a8dbd271808b43f5e9fd567ef39b8e7898a633d8b47d171258c37931398cb106a5e6e673f9e540137e737358969c81f178c3b4fb7c847079c6c2216be90e4c8b67ca667f6c283de4c357392fb19b299a2dd7677e3956315e162fe20d646df3f4a1cfc03441dc7ea8e9f838953d45af9f9128a58b7aada116f274562efae5d15fe283cbace27923fa9610627e3585d0399f834645f79f07bb86ff31d903091fbffa3c455fa6a2f2a6959069c58f8123f7239f186e6d4b3c6bc5a87a112777a4e8d3dae89172a69f1af136f42c36cf3ea7f5671703a88b7d81114c8b579afe23b82ea77e3def49e71902bea19bdbcfd9fd5c98b7cd94a0f82f2630a240f2aed5eacd9776c736972383264ced9d7cf398df9dfc7abbcb95c94ba5c89e43665d754d3b03933c36b7e19976b2ec8988a7f5f8e291e1ff5b8f4b5d12454e214c9bd67f61bf22b2ec8760592b619131bd2fc7b2faba977644e2aa6dc6f7cbd13e5d39d8c231081b4394220e0e3c4d897acc430432aff12fc6348fc0d3f73dadf53c7bfc59508c94f0c75448f314b1c3f88754125f89171ac9955fa506b24af73fdb47cb0777c6cfb164ab2c6bf7bbdbf2f604f0ff65a6f3f672027487e39f7b5749c1c04f11f673b85bdb1403e95fd7a4d57997521731b6b5d0e26534f2ec1d5f5afd105e58d5a0f34fef3638d15f87dd4bacc6ffa0ce2cbafdff598536b47ed55c1cde8bdead993e69ae2e137bfec5d2dbebd7da16be4eebd7461fcef2a061f24d785e8a2672cc46d3d67d9fdfed8d9f7fe963d53d74c0fd2375015efb994d9da2283ce51c07667f020a3b961af5fc993fb9eaae95e985c7ff9ddfdd97718f3b87ccc4572e3cb909a1afffe9bf75b2cecae207892b2ab3bbbc5f6681121f9ec6cf331da335f663bdf91612ac5d68473cc198e4befc17e3e5c76db98fb4140be5635319ce497eb93e1f58cb10b2ea164dd43213a03d1a30371a62e0726765de234b38a0d67333dcc07bfc19df4eeda967f699b185bc090a7740cd4e0764f7df955b62b021c571dc6bd68ff17ddaf3ec8b74813c4bac9fb05b49d82fc3213edb16e8c0637be10c2cbdc3f2dce419cbc79e6e4e8732cc4eda858cada1f2d73fc2e6eb6d80246f22fd74568afe44f6d3464c3c8eb3c04bf7207f598c35c08b2fb431c67eb7269db8fee9db722afcfe77c387b32cf60cfef86b8be0ccb810f12ab5178c5de5d3001ad587bbb2e4eb393f8fb9ed0d02b8087fff51a2a5ea74e0fc277a5c6516d98af6352c886d4e417395621f9f31fe696d1b6e70a6a1decf1056a90e69b14e22a9295c05ef9dbfe25e3088e3cc47c03b99a3e8b59414cbb7633378e463afa0de0d121b6793bc815f3f88ef0dd08e7508302bdcf629b1f0df6e3ecd655aa99ec5a9316e8f3b7c5eccca827e58fbe9dbf2d5ee4e7d13ef070ccb8db0718575a2c66bd7a078c6befef23ecf70d3e73b7acef594c116e593f1419ec63dae9f42088748e17cfcb6c5a2eb63943fe5fadb204c6ebdfd1ff177f76427444f3a25bf07e685d6ed89f4c8639e3a387eb91f31537e6bd2ded5f8c07f99f34d24fd625e0a2dc3b411dd1bb7f6499ce5f843c472fb6ae39bb4b684fee7b29cd4221ba68ddfd237ba486b08e50c791d65a5d507b56f9e372bbb30bdf552cd4696cf3ee3777787f96fabb5a1d3f795dfec857a74f5e97f8b4d3498bdf6b6071be08f0f43aaeb7406b3cb8474ff7bdf07f5e9f2d907b829b617fa262901f4ee5820fbaa0f06abdae549689713cecc9736337f36a9ce30e053ac70d7b64d2e8e390f837c3dc37fa5c73002b836b0f8e2ca5efd01e81bc15d2b3176ca75004a7733c9e835cf5b0d96aec625c459f6f80cead519803957739d0bde06bd17e28e49de58f02d72454e81c74b6ce6c7c3b98d515cb1f4c1217dc7fc37affe77588c979266b8dec51e5c95e29ceafe2e634eec43393a69e9f7fc8558ed69750e217fe284c83641921df53b31824077235f6e6cd3a0c7fa7cb4f7e67267ff6844340b1837c75f2ae7b7bfdbd9851f91baa76cfbff3d75536beb854cc261821191dfc79168b46325093a6fcabf81ad2919ae9667e3d695b6979f6fa8f5f4f38d7ae586f9bc359226b9606dc320aa421b6af8be96ad2ee4789959c8e0f86484666bbbcbdc7497d38779a53327b59bd86f527d8501b47adfd917256d2e504d9125fc227fe5d7cfb0eed49edd9cbabc74df2afcc4a3aa2f0256e1b24283fb8868d4f918cdb3b22eb59503bfb32a71260ae8d1cc978ffce10f9eaf248f77992c5203b7e235bb82d1b9af3ba3a1aa6486a72375b42dc97a9d90e889cd17b2f04f05beffe4865b4f93cd72537ff6a711e6bdeb3ca2bc4e31c9cc7b3391e64c07ecb76d89f3ac93b999c94ba99367976246b6a9b4b437d36cfa9f377346c2cf73c8741faea4ad67ee73911f32ac7a54bc8cf562066a8e3fdd2d97584a3a2c1da407c6a544d5790b7e4a78719aec9f31b7cd5a9c120f6ea5152cc71b199356dc3683d38fc0ed7134729c8a22cad831189e1ccca74c5ed607c831cfc45bbe3facc83dd617f16f7668c0ff8aac636b6619f3b04bf25f7d687c44bc73f869da0b344d7f2f1ba10d5903f3c75be4b7067df5d0e6ca405f133df96db8fc21da929da373b799986f6e6ba3a59a92e6139bc4abf0b38cf4914f9d9a6f0b650cf0b31ebd5a9e475594d8163c2f1d2d5a9888d58797b85a9c473b57e53b69fbc2e28dfca317ff7a4b45ec56a4f972b27a650e2225e9dca64ef286fcae9b3f61c2ff747d6bd9bd3e1fdd47fe8fb0599c5ed6d9e0dd8658e5b560531c4db6535dd646901ddc64f25c1b8bfce97d87731346ccb5f580cad47f7ba6421b99edaf765886c5df42f92d3f67d43feb5804f6450a7497e778b0f4706f0089e55d4983fc5f2172cc303fe8783ffff903ab0b4e1cbd1661fc512e990f9523177d5fcbf8efb51aedf62ffb439637c2f79b7562edceeda767af6ace2ee38d5d4477e1991779e55dc1c33293cab609c2df383c6bc14993f9e55b08e59a0ef39f49b8599bc2de2f359c92cceb3c7cd7bc2fd14b417041c634673a6e1fbb1edfd58e6c7b38af18b6bdfe03e48273c1b97c3143b71295abb8df18a6b0164896df9cb94c9d4ccf2172955032b7e5fd64ca46ee7ebc6a74dedfb67a86d79d033ab18afb3c1c1ef789807a79ac2f8d17863e50df98796d960ddc6601f853336d2a431704441bd9cbc46f66bee679bf46bce671ac43dab0e8f24b08c6652f82401f2d23016582f915eaf8ac1fb500dc18eee7d4e647c83d79e2d72cd6181eb02f268c7fcdd9a8bf24b5e0a7bf764effe86b551fe6d30e32f7831a285ccfcc29fb2a3b007585f517f3feee32e67d9d4bfbcca098a36b783f3e4d07c28723585b518d46668e2644a6a2772252e53ad56489d853a558504e79e12b0e55f739f08640fbcaa954636268d5fcdfa397c243b5ee699a4dd436eec692ed2fc8e09a170ace1fd0978167d2eee2c51f95b0d36fd6e1dde06eb6cb01d355929fab9d586ff26f983c60b7bc159bf29ce067827b43b132bf12d36709d286370e0235e5631c4b99acf22fc99fad57d36ced4e307fa7f4e718221bff04d89cfcd73fea0b3b737a973258d732366aa55ac24bd7b65497b4e747bdcc6c830d669fc06717d724f4d007c6bcf66b4ea5bac084a8eb1afc9650ff8bcc8002cde765c2eb6e7f83063e2ae0e342175b1554ecb720aaff4b330f1355aad944a9ce448571159f46c9d08ee94f8ff7706cbf326d64ccb72b38a0c53857aaae1f7fa760d985f25e333ddc13175183365df63bc9ad2ceffa0e620efe33dfb3ef920f685c75d3718be87ba5ddeafd1f8e67f946c82ecaded1eb73a3c29c89690d534ccd26be82cbbcfe38a03ffb667675413a8973fad7f971d66551aa678873a66b38a314f4c9a69b24be267d3ce1e9682fc8b5ba37969620a89616f7c8f65709c6ecb277fc16da3b99bac30f633a1e39e3d2e0cee9bf7d05a729382d4520366702fb8d9c39c9c584aa60236fd6c5865648cdc814d9a737a06f575f86f91295ca43fcdf4dd3d32e760c69cfd197376efccd93b32676fc6729a5582fed5a40de083bf464cae5965d2fa2bbb748ebff77c4f98b07e9656a16da99beeda147d6fb34c0e3a8bd460f862513990b7af90fe2cbc197b835c4a86f316b07eced20f44e6bd898d7a33e6471b81df9ba9199a7316c992d8703ef88590420e2090f89b26e0da0a8cb95df731b771f1e3c66ee6615ebdfc8b5b22bf9fb79905da7b4827605e8e11db707ba0f92c81bbe7c8de0c332a0c333a1a66941968ed9d756e8f2264df9d3db11c3b5bf6fd6026b9cdad2fa13dc903c74acddd067f9f0ee6adbd16b8857cc74c913e476b3d5363af80ba10f38671a2b692ff9fce8326effe787fc1c8a275f9da9ec13f6aaec539fda49d335c2ff24df6079bfcbff1de8655ce74490d35b34ab08c6cb8550aca57574ac34e708cf6544dddd8aa8d2d705cc5fa48f9c7d87ef287d7f61e5ddb54109ff5ee3956e28f80636d7a90158c6924be40c0964fce6d8ad6a88b0b60dc53cb3de1d9ebefa0f199c06ea779123e618f181cf8666ffb2eb7f7cf623b3dfb47d6f7906d2f28b12e6f427d389e7a72769da51172937590f137cf5119a8e97a5213628cac613eaae37c941588fde039f91d138b7c4ac3fa403ae66a7362a8590caf98d105629f809565f28339e46ea1edb7e4ec99b71bb223d0757b07b8ecc8bc2fdebeb8c9359ce5ec937c7463a7f98e595d802f4556f810deb7d3bbaa708bd5d91cd95f74ce01c9a83f438c70a327f74d1c459aa277af616e70ec2fd7c512ed4196e664e9d7ba2467ffce4650d7427cb410cf09033530f634d71c66c8fb0afc143d5b502c6ece964d7559012e3b1cff05fe93827c873f13c48b7efabc06c237d2ffb92e4551707f3a5f2ff1f1dd5a3435785d0ed0387ef2073329a89cd7dbe3bcf5ec71dea379b3c473e1d9bb5c7f897f8f9861ac04e74830d6c092ac31b2b9f766847c13da6e4ac16ec2382d6895bf3a3dec69da36b5db3aabc6aefdcb39f525f1e2ddcfdf60cf9b55fa5f7d9bc777c539cc15b7b9623b61cc7b779e413ad6cf8237823f1ae015a2d29fe5f05e4a9c02e6fe49fd16f93ec93b1908798e8731613bd59a1e666093d13cb9c00da59b4a7e686a42848879b49192a7752814a75044d68281463303cecb61dda8cf2da32637daff1b64d899928917a8e56b6de0794e6a46724f668a20e3af4f38c4a83a15f4de11f25fafeec8baff16ff00bd214c2234e630ebf86d71bee203785836197f0c9de5f420d37f2fcee0dfc5f34893769790e48e3d73020df70e72f50fd2a5aeadaae067404cbfc567bc0568bdb7f35ca77031abd367aa09f39b66562d8fca6f3ef7ef3eefabba1b37534c3145b694672f7f3c1bf95c6a83e9ea5f73a2385ccc064bb649bddfe6de1cd6b278c35ce385c26691b9b9450b9cc3a6bf43f35506f266c84fd6f066fd2f715564a7f571043397ca97cfa97cf927d24578bcafdf330ae2c92de02cd693f8bb9ee171623eedaa69a2f75aff89491b37a2fd01e0cac36bf5cb6fe72f7fdb34b9faff64cff46ba1525c9b05b5f24d33297cadcc14d0ccf135c7dfd36734b1fad7f5602e6912493f1f6a5a08071cf0553f8d6d603e1005731e629e8da606ed51663a4c1b2fde4b7d9dd7adffc45f884407394b6695450f7615ad63edd1ba5c58707d1a6cf3f3504f37dcdbedf5dc78ba98f1176db47ef353fcbb30b32eaf9e33e0727af78f15b3e2c63fc656c9a99a51dae64ca1a680db24ffed9ef3ff7e4ff971de9ef2dd1dd98b31fbf8e3c725b3cac6efbac9d6c691b9adea346df246410f176fa1793885b3d7f3478db9366c6a0e8fc58f3652a6e1ace8c62e2b83b1a7c8e6b934767a504f2ea1f4cd0fce39e188c53cab5f2335f2a4f48af940b13c75337e8cfff6903ff6a66c3f8a4012efc128bc06d91afededb6ce4713bc847688ed23d932d1967cb66b89e8ee2c23c95f7d056cbbd837f1f64d6dd73d6af7e9f34bfa76ad1385c6bcc72e8fd838c67fc11aeadc4750515e339eadda0ef555735c6449218f06981e39fb287f9f839901f3f9e3dc9bfb2479e14aaae204736ac6b56ac2e2f80d7c1d84dda9a789ca35ce434a7c862961fa9f857be1a21995edd313fe20daf49664978dc4a1b0308cdf41dd9168a19e5c6ce6df81458cdc47cb61063dfb26c7b6f097cea2eaee754d3d04c8a868b5a93a6bccd3250676598f34a17a2dc70ced47d21de7e31eca4c5a06866756df62f92bbbe6ca5ab6c5ce046b739c43986be8a22e0faaafed8a878a399105ed23934c5eed64cc9e15dad08f95cfdf7952af419b24fcefb2d5b809f262539e61e1ef37bb3c1c020db75da7033be933a89373fae701f816c5ef80dbef19eff759c1823346e3950e9750d3bdff62ddcf2941d0e350d2b247b823aa1e29229dfaf894f534aff37fc84c88f1cf292c4cab1640c69fc1e0ac58fe6283f866df9a1c8347520b45fd8af09b7778f3c3fb80eb7d6819712db16ba34e5f74e350de22a02fee3616e12f21b533457bb3e8e684d6c96f59fbead5c5d30074a97bb0d45c021b5f9ea50e8e3145d8aab02f02ae0c7263dec5fcf16c05cd9f7f66c128e156fc6faebba621d9c23bf53351d8ce16cfc20c5f14b6df6e80b1ce42ad2800bb289db26396d4b9138d9d3fb3535ce54ff8be9419ac377de91659a1852d8c5900af7ce66805d798c23314fe248c5de74b33dfd9c9771de948c8d457ed73b8c1b6305cefe8c3d0297aa09b1c99b83eeb75be0f8a405313996cac92738c706b14b380f2e5b5e915cd6a50dce07be982b7addfa35f6eab0c69ee26a1c57bd583be143a7f230e92bfe027c562d7f6f31843b5fc95f722e6f73c82bf6c6254729c51f8bf515c18c2e840a6abbba788932dd6fcb5aab8b9ce0db803b57efdb1097fefdc3f6fe98cfb3a7e3af480f41bdcd96b9e9b8df4ec7473a63091f2dcbac46bb69702f819b1d704456c93a4476abb11bf9717425f7477a22a2702b858f798788cdb0cb7b312549ecc98e35b7ebe55d74c80d74754ee859415a8e218f2f2b3cba3e88935c97d05844880312eccc0cd608787dab615ca7e5347d768e1db3ba613df9cdbbd47c7877f67a90ab31da7b07f9166bb152843d7c0cef8716f3169a950f5cb647268298893dcdd4d3fa8f77cf479e199d74695d785897e7ba9cf0fe91cfd1f9f3ef7cbacaa2e9415672832dc355367f0b09ef04f2e535d92dbedadf29f91777e3436e77fe322be4efd69a742b307f6f75d748ff9e87589bb480589b7744fa6c89636d72ea0762797470bc8db3479fbecbb2a161bbb9e6b014e627ca011f47feaf396581e769c17f517cc68d2de1d64ca999516638a9ef626ea1a2d917615a1e919da66dcb5c23f8ccaf2dcc7d81deef60ffd77b423f81dc603fce7e5d65da364fe0bdb6e372417ae5f822bc57647355f34e8d4c9d184e986a32e48c226394b47d1434e9bba09e9faf4eb0c7feeccd88c414e7682deeda36eff872e0b3070e831de130a0ce36c8979ce0abdafde542ecbcc3f8d81cc61f80dd8331c4d141aefad7d4cab7923245706723e33e7f5b20dbf057fe36c2998b7c4cf3160136edc896bfc53d77980b1cf2bb07b4ff707c2531acb2ef43c0996dfb1ff18a3d47f201b0a7805914906cf8463ee645eb9f9b238ea357b5b6cdb3f6ffbdd833e19f11aafc6031efc1bdbaae468bb6af03d8dd9955b80ec4a07ccf2aef8e8979461c33456345ef187b6694205b54b398be7e3ab25dcd735aa6f6681912ff3cecd697e0e9f0bd4be0da01aeb9d4ff1299c6a6ca0db3f809ee656e48d3f7bd49f87fe587f1e5d4f81eb8ea41aeddc7951a7bcd98efc6719ea927b0df68ecc95ddfce73c0e067cb28a4ec4f25fe2078a0f2ae6f55f23ebb3f4a7c8e57b112432dd0d3983fd2158b29d2fb16f0b134b896e88eec2bcdacd0bb656aba4163e1a9ef916c2ff6dbeaaac6de04f6bd99f29ed0f9ba143e11bf57ffac880d6e9dae5fef62ea433ef931c628d27c257515e932b281ab6323dbbdb83aeb4876427d2ec32bc78f589714deafdd0cc9cb2f2cf347867d2bac19c87824ab7a35cc9aa0fcabc4d551332bd1d82d7e08b692379ce0cf629b3ff4c038c8b7cbc2ac568645e96fbc3f8e4d0f94a616c24576689dbcfbc06537ced48cf4074072cedaf8bec5e42b8e417b1d6c21b42e5e9cbeefc16e17a707c97dacef80fceb27d2c58926b9187b60071d865476d1be38b775e0b27bf6e22a027e3da4a39df2dec86a2f4ede1b5fc4addd4c9b2ff88d9902ef92264499618a9c1a7b19d2255f2653ea686f644ae30f65680fa0717f9905ef9b15d2652592075f267e077a4ca148c6e4b86737aec6584f639fa8c1957e6dc7b78e87892975338d6d8e0d916ec6ffdece5f62593a5b76d162b0013ffb8df5a590f2142fdd5d9791ffb640f603dab7637a7fa17dac8de83d38cfd4ec014f7c0e6a8a0b0cee15cd8cdd0ed95023cdace2038585f28f3c0b5c77dbfc4a9d071673d0402d0dd275bfc5036aac7771adb47f677f28cc15f02ba2b96a30578463aef08f2cf3c48ebd203b16d974e8ac87627971cc04e75347ed1a5e8163a5b3e9de8147a08e527d6ea59a9414d0d3c69cdca97a8a7450937eef6164670f58ffc2a77136758f6f9effa5b75ca666bb229831c02989ded5b0d76d0cdc1fa95023b022f923ff04588902fb4d1f54dc02eb81e0cede0047e17c0eb07f095dbb80ecd0d8dce118a826dd78e893642658579b6eb67af0cbd038834c3df5f540c01615a5071a5e4ac630239a1be4ec0b9dbe19c63f08ef461122fd2a96a186319a540dd3f81d385a913f3ce0c96af373e43d839a8a69905aee0e1b99f2fe9d75f56c927af6e6be86dca6356ef177c846b87fbc753cab9ddde623bb0ddb8d85e12ca1af45104f6acfaea2504a39cf2a71bf496cebb41805f09925976fb83b5a9b554a78742faaa606afd7236e586b396b608df05c83bfcdedce0ff3e1405d1ce1938cee14c6feaacfe6b92ecccf1e60e3ba9add2f7b07f285f25f299b10e209857fec702fddb98892835cb110b341cf94d01ade22afae2eba203207b91b3be411a1ffdc30bf08394ed2f385d4e7894b0672645b76dc71f129b146d72064f9756f8f812b08b02902d407a5213a9bf30d1b48683f88c087a498223a8367b00b709dc3dc7336c099e8cd7ee72c5a985efad7fa40f9e3d2e0e25b3e0fe13bf6b234f5a5cddd73d6b166beacafa7f3664f381da2dab35d5ead174d3d7dac0bca916067fbb9af67f9e40c7e1f6b0e837c9fa2cd853815ce514a51bab7c3732857a3bdbd61f682523c8fdb437fda4bbfee60986789185d50617f124ce493fcd2b45a1d5b2ea988aae3b9abb1f7bfce4fee9f3ed9509862ee890cf39e130e26c0c80cf2e6b816c8540aa8ed119426ef94ed1df51e929af8a7f9236c93f6e7917ca63fe44e201682f558ec662d47c191e2787c1d83a2df37f16c2f0aed8ab1e059d65ff750dbffe9299f57976706be07a79a22d9660a0ffd63684c501bcfa56a8b31ce7eb4098d673564dbf319f71279ce77846b01bd1a9d6dcf5ee6e89c7833be6ee4f50aecc70abdebd573185cc35663f9896c92b09ea45ff2676dec76b49ebceb32f48cbb056911af62b5e92177ebe56fe4a08d1beea01f213b6a6da12d2b0eec22f42c06ea8d7618470db814a11a355c7b1b893fa2eff6ced2ddee6ec0e34dd5038a740d391edff2e83a9f29d50f95df74f56878cee7e3df39e789edd4aba73b36f6d03792ef6d9fbf15a983a46b1f3bec63ff738c514932f51460fe326771f6630a6fd772f7ec683fa83e40bf9bbfe40f81db76f7eee15afe1e3e55dbce1f6afcd5d84b159277f6a9e7f6e28466f549ad31b62f84c9b09f42afafd14678ecc143e7d03136feaff85ce609fe8f87b51317bcce8ea79a5846ba9387363b0974f676338e39ee35236f8220ad2e8ee4855a9cfa8efc5d2fc48a716435f0d32a73cc3cd0efd37fb4d9f4cd106f576736ffe720401f85de7b6c85c79cb12205e86cfd09ebc909d911681d06fba8a6f10b0ad5bf4ec7f87cc0d31f30ff331ddf18e919f430fbb58e81d49eb7f7a0eae77a3c64c628b87823350dd0735fc779ee544f578cf982bd3ce1b56d3eeaf8ddba3e6f5846404eecf7f3e36c90add5e75b8ba3d43095373fe391adf1e39984fba7cd7774f612c1b9d367e74f239f9ed7484735c49fd8b276b6b9ef34e3cfd69736af867c11b99d17e6f5bc7c54345602e78118c02c40cfb218ce56ea67ebf31707cf78c0915031bcfab19f6cc2432fd73be6f87ace994fd74f37fd60adfb1eecb6e58f2663f9e1117c6a2f96d7ca8535bf98958c212b383f073e7cd0e3d8d85b25e39815f443a5f462b49099bcc98d239ff0498f5b1cbf06df7c3d0d8e25a3d545deac11e02ef1bd2f746fbce038aed0deece907e007c82fb88e11f6d66b5cceb3b998df9afec529e943102d706fe28b3ffa05e3f35fef25c0bdf2e0b19ea93be7bbe677540f84a10f9a7498226dcb8f40d66616f863c051dac67923fed0d6949f8b60cb5c9d18fa49ddb5f87b6ab3d5c811c350bb8f739b1dffe398f994eee1a299ebebaa8e485fdff4b2771e7923a9b39aebd294d81f0f7b0ec7548e502bf3efcb3e939d4f9a1ba6326deb1845265363e59fe66f2a8e5d2e2c06d793b53113e66eb025899b134cb0c8bc05e624fd92ac63db4bd54e7df8ed89e2df70369837997029197584fca28b66b67b31c7fdbc1f7b7287029ca10bf002e0bc6cdef58200ace637b22b4249bcb81cc8d6913102d9f6866d945ecf6f241f706ff91dd8cfe7bd857cf70af3e5d0b8b8d73d2d6a1c3b4f8a5dc36727c13be7affafa225b0fe31612c02d84427ac2e359fa7b8bc98d51fbff7328028ff21be9258debc6904f893f1f9c8d25df615fa7c8bfee71f9ad4fa9ea0968bed4906034703f75a88b9c16fd9eeadffc02c90bf4d988a5ed6f4a074ef119621b7c765f6e1adb39b967f2e6d57086389f4b1365f601fa503fe1bec10637463e7ee4c909f0337a6634c2fa8c8df69cf5897b2346cdfd738c47a2fbd6435ffc627f644718bf04e7c468fa2535be7530aaa0ae197d66d913666f5753e0993393ab2e7ef05f1cf67582ccba10ec12d84bfe6973c77f4fa01715608d64350d8f672477df1f750ce0ce5ff69d075b10cfcb307e37ec7fdec8d721deaecb3b493ba43352431abf7be6739d41e42363735638d41907b33afa127ff79c41eff6410d08e60e207ab0a6f2c9b8f76264989dace973bfba5958b7fc98d1daece24ab83737f411ce491fe19efe821eb2506f3886de1e2bee096f0df4611cd376c774b1851c556470738291ff4636fb3fc013846b14fb31bc5340644a178fa37c91b31f57d07be68b6bf140484736b19f5ffbf5fb355db7c28f863166bf4edefc1a7a340106fd574c76fdb26f706717d698b7a639d7da36efbe237a52cf72ccb7b8e501bbb0ca1a79bbc43aa0fbff03773ed81524a6a00909e9790f1c75542cf55c2e1ca670edcd10a73de893f5d44e1ada1a43990d3ea706dce4d86fd1b0ed1079d24640f7f8650ec9f8c5c4131a7c2c9b62793be8df3fcb1b1baaf61caf91dd94fdb6839cf3004fa8b4e786c4482197067615d40c44439f8eb6b53c871de919f4e63e7f8d5e62ad299ee6177c6ef2baedd334f05998268ed062b9e5c56b7b6b3e067ebd010f059aebe60cdeb11cbdfdad161a306c4a7fbc31ae73aa0037f6cb9ac19c3ed46fc6297a87da73dc5fc6ff8dc7ffb4f6159d39b8e7a72f0dc7ff3807d0cf4cecb83034c285017bff17dcff368e525d78cea7817bdfa3fb5a97504a87fdda9b98d9f05e030cbf82fb79c35c78967fdae4c3f13cf8c4cdba3eaf09bec0bb35f71ffdb23fa4e20c796b7a7f38d5f46bc4e483b86381397b28fdfa7abd7fd03dfb315cf86da78b5fff768ef74a9a05849fdf80be7a90d3b884f38e136a1547f85a744fe7b3709d4d0afdfb49fd44fb1be0b5835e744dff76a4efd27ecd6dcb0bf1a83f71cfecd4e9f73c064c5963cf23fbc5b7c55b6b930a3d1f2c26672c56b08fdbf051944b6cc3d5863dce7559c9d4f8b97e08629c5ba1620167a87f6fec3b5a47996ea6899fa4c6f34116e79a5919fdfc05f0b3701eb2a1cdefbcd13d03dfb3919520bbcc2d73b5d9c963eca7e64f3d5caba8d0be39ff35a2e2137270f16c11fa2235f1056d9ee4d807ac861896fc6bb4815ecd800d372be6e0049735702fab91cb95f707ee246ec7bfe23a325805f388d794cd37628a96578cc411086f3cb68fa02673c7ebdb79d1e744fbee3026763a3d48bb8bcf4d8e9e59d586b3e695edc7bb4b63a6b64cb930d377ffc8465d0faba4d33775c91ad294c21e13fe26b6649d2e3f7e817ca33469fc94543f215be7d3f0132ff2e5654a30e157aaf73f60bd3507e92f36f56dbefe229869a8d792d158e7802b45be877fe47d677b8eb19cbdf15f9c98f4eba3bee9737b05fc44ec66b89f4ac41a76f70e68ce5caab6a2dfdbfd137862be46ed7eb8f4cf21c62f7975546b5b2ac76fba992f4cceaeb33807319af7d6b6abb56d5e63ac4817dfeac5edb66c8cfc0ce423a27fa1c70d928144b6805ddfbccb09eae1881fc514416695fec84b7b32ac6713b5ef11055948fcccc543fea9eb99d5c5b8fd23b21fd20be99d7f0e85ea8af63e8e13a6572aef7981bca7349e62ae875da93b4cae131f92d4cdfe12d3e47bcff1ccea8a793bf2eb800b10c956e66bfb97758733d2cd51cbcffcfa77b961cf1b9cc4c530a1069c8c9daa1b7c35c736cfeb527575b9e2ed05cfcef77ecbe2332b4da60719f857ae860d3c6ad43ca17b94e9eb7d3d8ff6a46ecab3c23c94be8776524fbfefc979b2b95d41e11a5ace13f093494e5fdbe61724575afc469c14485705752783213ee7a8aff79a74bb50636bfc037adf51efea41bff897f732c5fae0d0f1e3aa5fa3305786bdf37a18e1add9e3094c7b786731a0f0c32d1611f7cbe300837126dcadb5cfb179c0ed72834bda38be067d9026c087a8675eb4b76fc07d8364805723b9bbbb845294fa71f2674f714d633d9c335def44c2df88792f3b7c459ce4bd9e89dcb8edd9d9e4a374b14c1d33e50f3837c378f624d1e4728e7bfaabc3da51ba279e6fd7eac51f6d72ef04fdb46f41c69f82ccba07b582ec92191aaf9b89c7fd6871561ad90cfd15fb3a4e13d4d2b3d96b704a62cdbcd58e59f9ca494d038e2fc20cf8e0619e94b81ae909f219ab18f95341fd1d639df449fdfebf5c73bbe9825a7bcef2eacb5eea096aa88b05f29bff05eefbf8167b9998fbc2b3f7e02f2eb7fbf66d242fd5891227ffe99a05b26bc9fd3d7b1d3b1603752fc0ef4eece5fd967d0f469b2894762fcf7f7042fe6b35729df4fefafc4c2f9ec45f3c33ea9f19b2c75c473d114ed37b77266f31e1568d7fb16373fdb4397bf6f84cf0b150c71a48502b359035a44fa64de91f0a731ad6936e8f09d1708fb5fea61f57afed533b387b5659dbf74d88f9672c3f480bc6b198779f459f8ba1d3cbbfd3e3c236627b1e9ec8981539c3a406afdc3bcb14ebeaf63c9f35fc1dd9f711c4664329cdbecca45903e8494af672bb06a130a93d1b7cf77c75daa071df1cb3882d2e65f6821a3636f77ecba333fdd65b3307f83a0a8ff04b347147df4ec1d63dc09a0e7c99b6a769cb2d75ebc941697ea6eb369ef6556ae26ef28ec4dbaa7b6f7debaae8d9ab6d9c6e57f8331c67d3e4f5d4af9396efd870946e0da09600f365f5e738edf1b442cc6067d541c6d79aa0eef64d9dbef979f7ec2ad31d32df98c73cd6b66581fbe92159a0e233decba9405d34b54ffa7635ae979ac09cea5270f685e4cf7ecb1714f771a401ef4725e85292ebd2fcecd7d5459f33c5de4cf2551c5db5ba287ca193e1ae19211ba85883bfd3fe7ed9ff3df2a12744c6a6cede819a3de89905bd8791ff84d6a291e5f282c7b1d4db19e96c3f9eb4320eefab668e53fe00351bc91fffc146007ee74213d21ed772ffbc4ed22f6999620e8289e273fc0fe414924dea65685dac44cfacb1cb59b84f48125d7dae40f37444f63c92cb968c64ff0ed9e73f8bed18f0d19a0c36ec92d8f84d4c1ef92598cf5982783cac1f7e9f08ea53900fb71aadffb8f5379a9bc138c23424313c53e2af7bf41c697109200e5aee824c3c7a5bf62fbf01db05f0a4a1b8c97ddbba86cefaecd6497e70d8ff22d3900d01be8d7d5f8624ce1769b38fc233ab932625c82e785b032e8ac80f6cfb402d2b92634f7f27af7363b47e5b434dd2c45fa4acbf60998b6b6f124d9a9f174295a2fd1ac4c99fe0982f31ae8d9265028ecf9073f64b3c8560c46dc89db7bea56756b9b6cd81d3cebfb3ef34ee185de7dae017bdf411dc2dcbd87715fccf502ca09ed11e6dfc85c586863d87f7d0652483e7e49d06faeec8b207989f45e959acbf4899ae4f29fd393bfc1cad831aea19d8da858f642ff08f02c7c90f3a8f64ae193cefc8c6dce5bac4fbc0eb6331fc2bfdecb32503f1ed5a85f3aaa40cd62dc0093cfdd39c5592472c9eebe2ea6ae3dce08f92c23e889d2d1be17158705f5c2b49ada534456709f8f1887d79d5d16712cfeb728874f62b6c014f8fa1e7573bc051dde4b92e508b614f9bfbf76d0b69cef7f73fee3bfe7a4ff57c61646300771bd12b984faca75b7af98a6b237b7bf3b7cd2fbac094c8df70cda1ec8b6a245b912f807b70dc781c53021b3ed2b3cdd5cf76e710de136247779fab38f8eea4a6b897fb2d0a85a4504ed0df8bc4b3a0bfe93d94440674af8cce73eafbc0430bff36363c8dd98d1a3b8aea6700d878ab8e729b9ba03301be13bc4763278ce8df53fa918a61a933e6eac4911866627198a79750b26a1ffd5f54233f0bd383a8829cf3ecc9d173aaed973d615c279a921a656b972439e1414ff47bf93799ce0f6ba2710faae5d53f2daf0d3739e4ca39bef0ccc9c875d48b1a7b8c1abb49d7576072c17d3c9bfe2b1334f7a97e0aa3201b9f952cdf405ec8a4f7d727a58330ff7a284c4acf59de5d3b04de7b2ce30b12870e482e3715a9dff143fe23cf84be54bb3dda53238b19ca9c508a6a7f147c8732931fc89c6f8e7dfd01712f9c7bad3d5b4cbeb60fbcf3adbcd8c4d8ae4076b65daba4a7ba1a3ed6d43d1d3ff4b1a3e406b29bc7d0d75688def7ced2c4cf3f470bb3c263b1c268efacdfdabfb7c3784a716e30a346cd94613de9fd2eac2779c0e2fc387013c8c1a57b0e132d845bb4809ebbc8de7af8fd3f0bb99a6e1eec92a8d6c572047d9921ded3bc6b82cfce9195c879e9e1eabec05e811a07885d6d1c0fc981c5de413ed6240de2ef7833e797eb36ce5e5d5d7b033daebe707fb71af98461663debfd5bf89c982871f5063e25c104209da9608c79e139843700d703a2b5d9821c008e6b7816c93b43cf22320f4bc67570cff0207eda0719f90d5b77a45e7de9f6e7613f7673437aa73eed697c56928db1ddb5b989057a17e833269fa19f17d2a7cfe6abe957fc15270d5ee1f088176dfc84eaaa4bf3c6a7628d2dd86a540d07f2071aeee031c6f664248f89798162889b3a656e98f31ba959631529bd28d23877701eb50891ce1e59a12ecc6f989b24a5f93c69ec468debb1d13e44f69102b5ce2efc8d6bc33569fe47937b584a8811637f3679d3a41b1f0ad5b067c143ceabb5e5317e0e640cc4531b0ccdac640d79f11e9ac58fe6accfb886bdbae8d21cd96275eb3be0cf595deeae011b9f8e2972bb377fc6339dbf91bc05779e016e799129fc3879f3474c6101be8b2d839ab9eaa2cbeb3573b6b979f99532b9ce05bdb3633f626b01276b399f371a274bff66f7d01f9de610dc154ac6de75b3df87c38a2b5773589093f62f72f011e36c4d0fb3c77e62d00b8ceb5d3788e7056788d7413edd1a07d00765ca131c3ad44c6a4c7009ed34d1ea92dfd413766faff98559c9a053479b2be67e697a2a3cdac58ddde459c3e7f021b56e0c7061e09eef1e92e1686ff6714a7c6a931cbfe74437d79e243ecbf0e12ceff2faa43ff04e56af8164d5216091aae1fb152d77cd690d3c905e969e4247851e7eab787e3b98504bcb002fdc035f591f734be1698ec886a7fbc83ec524caeb7c6f951be48f385bf6b2e2702f0c8c1d551a2c596eb3f37310a721e114a16cc194d7a5301d620a06fbb339e798ef7bd4e0f0608d303eebc97aa8b1f7648e597f67468c66b1bc2e894797b3eec3bcc2436eba89b142ad2be0045fe63543c1cd1a3b1f725cc7069f12a62139772b8271039fb2c5872745bf4e80bfac4eebfc00ba1ae9a9e15e1cd469da964fd6adddeb8423a62723092691fe0cdde762981163ecc43ac8c4899ea5f72e5f93fc090183f471de99c99b26af79983f33cdfdf8b33680c72a671ef39dc9134e8871a99b69e2d9551a4abbef202d1964ef3a0e73a6e70c308cc8d6603057df96c46d904cc73579bd7a883fcfd6de709a35cbdbe73dbb0ee7c8a32860365128cdff4fcf7b6f3e817ffce9f8d7e083e0da91027374cd83c23cb2b807515ded1b7ca02397be238781c532ff3a323b7544ea6f91c955615a78f68ed7d9f253b7f27028e3433817d41e3e6dead0c65cf0d846c13955c21f8fe363a00731679fc181ee7e835a0113fa25c4465da6abf44ce38fb51dc3af00f303fd3377f92adbe57d3e9c09cd3b43f8e47a7b10627e6aec3ee2e5814f29aa0d534d5c6713e926d8f99dde91ac5417945215dcf829cfeb73acf1d97f56f364e2de8e240676319c4d0a3c02740e65c46afd33dbda22976ebfd1355e6cdf2697c4fb461219f7c1eeee7e63627ed5397a5fa8df912b5dcfdafd897b574bcff6ea03be49d33322dfea6ebcd0dbc7e6396ff7fc1e9a536ac0fb81ae211c0907b33a784fc6fd88e1292eaebd3c223fac5b73a670467f7da6fef04ce20b78d998ce4dc13ef815134af84e5a0cb05945863dcdd46cdaef61767fd8830ddf0c39e75d9e5d9312de04dc326023714db73d1df0d18b3a91f1575d50db3584bac6d322c658cd31ef3ee19af163a6d46c11c70da02703730e8e6c04b100dc8bb8d72343c9601e4b820daa0d0be9d61683c3420d7eb63bfb7555eb520a1c5eda16cdf1f2e5f8002f4ec533f7ddff5fc4cd777f1a7d83637d7d5fd98c91efb26ef19dc0a99d4e9bbf5fe41571df2f35f67afafe516731e80c6bed7c4bbb9caec7f48f4fafbf406df5f0da53d3878bd80918e7d08f8b3367a8516df23278cf024e19d71ac8ebe2691c6d36ae9a9e90caf683d2e5d6fcc93e7e8209c579b041bc913e9ff83ae0b2054ecca28fff1159cfb1d217183e5a16a774ff7c83f04e79a7ef58338b3870ac14ea676585376b7c0e600e8063c33d2b8e3a3dcc1f6d071f62cfac3e9443613d295dbb4ca9ebf2d549e970cc665204803340ba799392e73f931b0cd863829a7d094acb5f1dd6d0bb9d6d64091a37d597a5d8438ea9c52915fe9d1de9298f7fb38bae3e57de296efd418e2440e7b077ffc3b6007e5c2a070e7d55083f58ef59013aeb66857b6c66de98d45f676a36a67a9d7e0fef97a379f025a4a73eaf1817f551d0b800d7acae68de144741b20ee49d67b3b7504e309e02e7060f782e2dc8db3cb17b693e9c1fe584f70fe441468b98b277f4d69e453a127414b5c6d2b8f8e2081ff4e921df7ff5b365aa48142600c7db0767ae68633be8fdec51371e25ae2e0ef05f31aff4045e9f677d9adb7ed50a651b3dad7f4c499faf5497763cac555c419c56979ec6a6a7fe911fe9648c4ded0cf2d1019747dbb733ec1334f5444f654ebb0ec185ecff0e2b8f7c8851707146bdf5e964ad89cf2eb6a79aba76f50a358ba305c4a420ef01751093c473e8312f00170bdccacd3effc55ef94d07fc766f97ab52d75137fff1feed796be412f479c078679cfbfe65add1f95f9815605a379295798e5a7b36e67f257595e89c0ff47ec50ef3d9bac9d6b8df5393d3c7f9184d9a16c039e930efff652f357b0173a2589dfc6ab8e74ce09ebb18bb02d71548d0a7a3f05a594cce6f8dfd213f9e604c1be62afcd1eaead2f09c93b3c5f639cea057524ee7114221edea056535354cded76a36346aa8194cb4f476390869e009c5d9b8e7beb7b342bb2e4a67cb079e504e35737a09389e0db265aa4b696ad89334ac3f4dcf0ec52ff9e3aca4e3b3713f9fe13766c21f84e4acc4658deb7723df35d937d562fed5cc75ac0b6aaec449e1733bdeaed59d3ffa409f99c07f21288592a857e03737d1ffabd43bad6364e779dbdffafaaa17c01121796b4629e80d69972359fa65435fe23c187d162ef4436ef926eea19c169e3949f6cef2e64be204d7cf513df1912f3aacc1132ac86728d9e4ea67a4ff563d3906a30560dc3d29bd0752157de1ba05f6c9b8fbbca038d60a58635ca3376de22a11da77c0ab33da4c7cc902be9b76dc16709932e89a1597201dd8606d535d5631874f539f5b7fe6805beddf8b77e11e341e90e9f999418f47b3c5c1157e5d45c656b9e9f28e0f8efc2bcc64ad674b3688bf073df57bd7a0f96e6d07dcefaeba1b0eece102f87cb389bfc0bd93f0b5cc84f56d350dd29271b6d318f71a43b64c7534cc27d700d7fce718fbc2b74c8dcb3b5abfbdbd6b789ae931e4a4c6f3a8c6ded1be2f43c239f81ed4537e11a33155d383b4f4172c53b877e6271855d3c5b6df9727ac9bbd85dfcbadcb42336fe785c95e35f37c5e98ccd9bfb3ac262457f47bfdb8cbb5ed8d5fe03836bf40b6493de5fd7b79d48e4c0d791358b7663d163fbe00b57c1788c750b63df0a9490b5ee396d38350de0ff20deae8fcb4a89c2d7fd4ea087007da7d9e6b686e53e64de3e0df0270fbb20218fc70c616d0e368b48982d39a7791bf9159176fc6627e3e12fbf5a51ddebbd4be71b95dbfc61d7cf287befb05e627a1781ed038256b1843446bcf18f6f8e2667cf28531e5a99f35b51ad145cf96b5678b8cb76b732d0d9f6b7b3643a17f36f5070ea43667d4dc9781beab197ff14cdae6636217e30bd09e43e7b076b66c0e39e85e6cc44b9f7170407e0573945e8ded07d24d342ff0d590e6ef7e5dfc686604713f1fe371418e0176f9c8d68f722d29c2d9f44ccb24bc0f5238e78d5d1fc403f92437bd8dd07570c69edd1b30c8682eda9c18de8b97ee5e6ee6da804b453e6b8ee5d632c531bde4ad1997321857f3b93e2f880fa6144af75ca8d7ea38d7a7fc829e1fbbc1aec0bd70bf558e0f9f8d7f8fe49cd9ef61f050433b5a9efd51f0129fe9658d8d768b16501f14e5de2f78ceeefa94d7e5cdd5e3c2e2175b287633feea4b5634c4a8195ceb8bdff7123fd284b636fbbcb73729f5f7533bcd6d6dcbe44f584784c781ba57f719b91f70cfb6b8034fdaf13a8bf47b4e7ae1a8d72fb9aa21075a03274a11a06bee379293627d27bd5d9dd9c73f8fbc2a458eaedfe37b26077152fadc86f5a55d72106f576d367ddb6722e73a55ea726ca439cb6390a5b750e0735d0a53c3299bfe386ff67d5e28c7a617cefadb77ac2294d21b92472ac7b2bebcc903a94ad53b70754df551c319388e319f851579b3dc5f98b7d89037904f3162a5508eb72be9bffe8f92225b962f566951a37b349f1bd2f2ea4b61ed8fac5bf31b749d3752afa1f3f18f21f0843b0d3f2b38e6fe22a69e73ac189065db666c9bd8e7361363f0ec505abfd9f7057acfe67ed35052af9e9cfc63c89bba1daface067e27ba2fb91f751435de45bfeaa555adca07f9da0c40ed2f51cb26f3e19326ebef91bed1d34dffa898ccb86ff673ec733f0ff8c7a7ffc77d4e22e388c6d77ed7581ffff4d3eeb730085d96e585742c57898bff6d26d39dee4a5f0656d220ff7e5fd4338b75a0e8280512796249e703f9b96733b5fc52aeb679b14eab0edf5a0867c82eb95adae17fbdaae0a83db81bf169cd28e57066a91a9fba665a83fcb292524ae02b52ca4be1830fa15e636b11ffa2b3ceb29437466ab2f2e86acbcfb71f1a3ed880c45b65dca144a16163ea746fedce25cfb16132ec0c6b787dc8c2ea8275fe263d7ae005784fbd8c2b34a6fcb5c0df99629773e08ebe25dbff7ea8ee3c6b6217567bd5e10147f6ea14b8bc203ce8146477735919b931a791cd6d7840b3ad4e50f7e2154e77e8fbf8fe97e56deb4bac0fd0f3077fccd31d3a6a7da15eaf205f54af2ab18bf154757cd4cbbf996d6855ff7b9d67d9679f3ebe49dee23eab59c01d35c73cac8b0313faeeb7cde3c7b92901ad373284c8e416645a1d4f52bd30435d93b6e0c98812d730ea4d6cebc6a4e35b56b72feccf445aff7ea8ece943d827ea327cf9c1c3cc9ca5c24df842a6a7af399e266b99db177b0e546c1652f6f4a5ffcace1cc604ea6ab669677c0434a0bcc2ddad9a94764a7ba803da47acdcecade5cb9e6e4089f43fdb4754736acbb65b341ad6d3eec2168984a7cb049aeb8e5ec5fb7fc8cc0df866bb2cf7e9de42bcec51ca0f306d7b246364711d0bcbe68cd45b4071aae61057814f6f6a4c1315e7559017e718dd40074bcb3258e034b690638109a1fae8eaecf6c53bf86f820e7995186eb71ac8b47b809019329a87777a4e681bc063b907055471aaeb13a1adb8f2e077067239b5bfc682c93efa55d9363bb76bdbb9498f8fa4dbf1b9adb2d3b98e2f35a0747e9382fdbb16fa420134f84ff327f18bbac3ce7c994520e9d7f5d5ef341fc745f139e47255f8d9ef23a62cec9f816079998eced299a1706f72e1af6388f52a86343fe7edbff6efdf27a1fd75617ca09ae491fdf699debd2f785dc77e64bfc0573dc2d0b9f9ba4d8beff8e16427271b9eaea6716e6f2001e9d09f40e6bf82abbb1a1e7d0678d41fa1bedf308cd8d71fa467ab6fb3b568abd30b9f80c7ee6bab9cf8c8914a8effefdb9ed191731dee3007dfa619dc7de96adf58c929bedba552324a79dac4cbfcc69b1e65a5badf447d6dd73aa9bcf61ace8416ab8f836e81eec41dad4ae1d4e3d59bd06423a75a1b6acb8eae226853cb5587ee929f3af667eff59a5b78b268c7f34e7960ff48088fb5951fd49683d369fa4bdfaa56dbf4fc71e30ae25139c683c867271ed25f425e8743c4bf0936033f77bb2ccc574885d6b71e866c50267734f2fd0788ef4554c0f721fc1116282175d1a7771bda61f9849622a3bf27c133f3f1401eb751df06c407f01c2ff51abb1d7fc3f37a01e705c401fb626cf2c246f0d8728d437bfe0d5f28fd333f44a96156a7ce7f6333faee87be6ba806bb3f7669a1bbb49dae07930b717cdf54c717b60ee3da89b75919f2ba0f1becc6d219fe2e2735e863ed7a5f9af75580733b978232bf766480f85ec1e7a6046e4ff6b24a74f18cf55e03a8de739b3919e619c6483ed8758afbc467ba1c69c1fc04b08bdfbc219fbee71d5d5cdc462752a795d1ad6890fec9a51dbe3067aa2e88f7df08b10f260386742e2479ce694173d8b223fdb149ea3e0fe6426d4f4e6d4e7c57ecb5ebbfe9e7c8df347de2337214b5d234729ae271ae3f993a18eb799cb1199bf1fad4e0a4d5ef0614cde1dcdb97c3b0777966be62cb8e3fe4c9a3447d79d014705d804d0f55c3bd67e1c93a17dd797b6ba1c60ae23c0a3ae2f680f7b5c9806e83ad2f711739d75d7e09802d427b57bc73307f57b27b2a76526d7a51bafc96b5e839a69e0a7261ca491dad85bcff14ab75237d39b6107bc26ef78abb3d3189bab42aded872f420f1f4db6fc45ca36cfb807529523bda9c74a8ef9acc186bd011610eae2c99e24fcbaae5df981c84e0f72c9231b5793923f5e3c01fc92c7890cc11abc79f124dedb93846064a607f9a3f8ebfda405dfcf0de37b0542750db2f4846dddfcd4604b82b822eb6cd5ab6c5ce07d935fc8be293a3e5fe56d6f56432ed7b73dce2d01ff6f830d7bcde70e354c916f5b685d8fde363f77bce163cc4983f6a9b4e60396d8f4c2f7d943b6ede833443e816652f3b45b5e4364636e3f0a0f70081b3610dc4cd902d75106f6836cdda81abe5a97be0bd7c45c17cff1d1509f1079d2e6112f2e7f14ae89dec185dfc2fac6e85a0a6b2c2d725d5a5cf6801dabd220c6720bce503d69e7ba916b3ef91ef739e1138a2721d133c8059f907db6e678d63fa1737cbba167acb27563d31e355c1340e41ef1f5c4f2e600261aea005f620b9bbe58549f84bebf1657892e7f147ba13ae9b2cb9b751fb7a4c6de83bc037d49cb15a805fdbf3a9be8beac616d90dc1f7bf6e21c8a6501fb43c47ea27767d9fff57c6a4e9961be622c6f82d167ea72299e3bcc2554e0bdf7c16bb2f21fcfe86748786058c341e36032351be4c8e2e8eb2056811e27b1c67d5ff6199ffbf177ec88b78b369bbee9711e38c264aa994aaed5ca197d7e10d6902b73b7ecd5b0d74fe6698ae6e968d81bc80d29e0f704e81c1cf58caff776d8f406c47dbcf1fe23df137d2b03be896d6a76dc1ad7ec04a34fa8e33cc81570932079edd6546f0ee8dba2fcabc550db591fe45bd4ff1ee2f6c943ef036941f1852b999a81ed5b787688fc8d993f42762d53a2f3d66004d4d8cb3a5c0c858b00df718ec69ff4315de04f4641b6b986dc385363cc4de6c5c83f156b4fe2477edcdd672f549d9e83f50c06b52710bbb9e9b2db9e71cdac3aac2b965b0d5e10ecc34000fbf0aed17b8dc23c0442097a069f45e0907d7f8e2b5de0f34663e804b5d1d3dff8de9bbb12c399875c269cb1d73c6e94dcf0f22fe0abe8d57d809d4372bc8d6f4deb5f8aeba72a0c336a634a6077096a636bc41ae1e6823e91d09383c56797e82bb84e4067d53aee6db1e85d27973ca91bc17ca1cebaf08f4cb5e2c6d3e778acf282ecc4802d2fce96a5c6bbf47dd2ff31147a67195d938633820d10c42370efed206f003a0ded69cc778db9fa36b46d82657f636b2259cad272a5d505a7c5f0da02e7eea8d886105d91dcf0e3e207f7431419e07185da0011e982dce726db50e291df9b6802b24bd7177704756997506cdfe5aa67e82c0107057dffc8b0a7d498967e30789669b3a5676f72a4777e7b6e284c6af0c7d13eb381b7291a7ed6ca1273284b801fa1d187869b9d7917e9238c79e45d7382b1c02defc99c378033f8fbfc74bf70f38b3f52738fc53dd60e72856cd9f63d0f721551b676def553995ed03ef0312f01c807e8c123c319bd8776c5187682fb140bd0e318cecba68dc37de7a40ef11a889fb53ffa8cd09ec2399724a7b1966e3db9f9a32543ed9d4ccd169740e2efe1bcdb6b03ce4c06c742d43418594528631c3b7e0f75c847f70c230b7d9e713c75c1f7f63db19f90ddec9913069d25cf59c3f9086ae0a8a230da6ea640bc0662030d6e82697a03e84d2ffae3985f986d7d5401b63de62f2f0cfbf6c0d747f2626d3fdde13335413d229db1c7dc57548ffb39ae9f1000b301390e4f6863cf57cc9f04580a7cbeb8f134b863b94063b45cabcc0d33020e7a3f85fc6ae75be15e88c0e76a73698839e6add4939738bee9448c310acefb96137d92fbd926fd12a282705235be5b7b4d831305bf82ea07a94ba2bf079e6b9a0bacbcd2ba2c6cfba497688ce52abb15946c79f3470c1d2b2c1acc5370af98ffe53ee85c35fdf934c0dd59d83786fdfdf10c9ff96b2d53584f44725ee93ee7856756ef9e3d41b2b9c639ad73b410aaa3cf4db2bd1db24106f92fe03cf6388b5965b89fc2c0e7a7b0dc60e7e47e26164457d33dccc9799a801fb4b727900f8178a6c30c73b7fff19e84731ac91d2ebde0dc0992c165ea008fa5c50cf859f22fa6a98d6cfde47590f137cf5199af0ee75b7c71d605e9357a0e9ed57ce97258ef9d0df5775323b6c1b95bcc01f62bb7af3d0ae8dab43fde36873a956edd22cc01d4d8c7b3fc1d6a48d1bf192f84b64ac6f8c9eb92777547d67d752a1934cf1e87fcca7e1e1af42fac05d2a765aa0909e1fd5d5cbc8c3f86cee2618ec9e767cdbc450b8c596cec44b06b57b19af9dc920db8f4a29c98c2cd2a3638e6ef6d9ce138c01d716946b08abcb1657f3cf31b38f5f09aab45e8280f63807bc6df103b3b109b8ff605940cbfbb3fb218180396956f5f504f179e86bd0a484fa226061efb238ce524fbb491ad644cc0c3f6a3c6debcc158b67d8ce2e471acf8d9ed7c39dc26f53291f5e55f7a1ccc916ddbea8142c9e631ae9b525324cf3dc7ba34eb3ed4598ffb8bd2dbf6aed5a558573c9c29c06c3cf9fcd28c0df75b82dea0b5e72cd17be40787e50fa46e1bd79de0f3fca2ae371d5e47d5520387c1eb1a73e80d9ec3b39257cfe87aeda1f552a414f0527eb6c4f1e5f62cd1b155b0abdb7b122cfabb47b888201e56bf94437c5b238afbb0b6fab8e1c2df3bcb7993efdfc9c41697ac04fa94b53906e0ee45ef9b7a7235a57523ddef8afe3db2f39a5adec1fafcd9d8bbb76dd670ccb77b89b62f32f5b8e0892ec7b66a9f279afebcc7b7beca769710d90f9cc534eff8c4ee4d016b7882dfed9abd4cc643fa035a8945ee43de013878bde61e1d8e06af818dd6a58973e1b81a6d9b9135c87ddbda8692580633d2b788fe4caea61be00f995cfc51706e79d2fbb21738627539b8b4fbbfd76ba004de8c505659dc13336ff78e2ea5f7cdb3359de52bcfacf280eddba63bf47bf9b3fb9e494bdfb692bd8de4d95fae6597b9ef90399b01efc4a35dfcc039d5e4295fe8cc595377f9ea3d58d1332bec63e0b5e98f438033f3d43e3f98d51df92d7d5f663c94c518e3d4e2c31fcedb7bf7dc57f3f39fc6f86c2c67ac5f37d760fe7a1d867ab4e9c1f0caae598d5a39a0fa369b7a528af63ed81b2ee8cef4eaa7c0bd7bf3ec35bfb1914de6668ad3fe6ebbb759d647fbd65e6319f9973102373ec1a83d7f4f16f637a9857877ed8a31396b827b5ca8ac9ba985e72c88bd037d540ada36043b9543e79767211772c79f87b25a74f7d9a4d07f6206df61b9257e9e5d67896d248945dfeb9e93e07e1a9278f2ac6feaffe4732ecabdd3a6bd2eccd23484e7dd90ce8e825821e38ca220595e3d62a7eddb7194e9d7aefbdc3d594c28f197e65dc15f3a35ef5ae568cdec19b181a514ed7b0f5fcb671ef092e131fa9c9721dbd2c673d2e6754d9bf40791f81c9d9920257609bccbb2f66ceb42ee7f77476aead9137c7f99c83d71730dede5b999ff50e2db9c3fb9aeff3d17e52e37e495a56aa9cd491c3a1bc6e7c6439e31d01de4f7997a0ad38ed79bf453ddf290175570eefeec3aea0ce900cca9decdb7674e7e3c2785ef56b198eaa7f0bcb79767aab690d46a7bb30ecbb3237d75933f0bd363a0cf7d8cfe5dbc2d05f4aff2b698e5edbd0eb897571373883c69036788c4dd71cf048ebd86ce12cd29f4a45232fc37b2278258297c9b6771ac53e4bced39267f5f43671debf2e7750fbf039d9506c773dcae61c6739ea3d0d7b4f7a0ae6143417dc501ff2456628586533ed8afa15075b1ec6dceb4718647ac6ac747e0341c962cb6af4056747ad707ae18729f1d1fef296cdf9ef4ddc531e3aa5f6f0131c804e244ad2d644fa918cfaef08fec958ae9fc9a5fd69b35dfb250cf886c47bc7ede85aedd1eea05bae6a1b393c594ae1125dff33a604d9ee78f693cf0ba5fe385f3cbb339d253572f1bf69478e0ed003b93d8884ff41d7c4ec7d528df40e107dfe13e26549ea9b18b347382c6720ee25edcee4f104fba3ca259c47aacfc0cae79eb5f53d5744c4597152a37467405e96df7a2feb8e5a8c79c41bbbcd177687c7b981f0b7a57ad0675b55d2ca4e947e266bdbedfbdfc34e00d800f2f9cb5bc942d4e0263d5c7fc36dbe5fa6f35c4a32731fe6eaf5c709d3d558335dfa486399d6ab369846c5dd7a9e2e0541d7dae9a7a361b7bf666ba97d5e9de664f0709fb7b07a9cafdac9cfab6c5b80ef443897c33393ff4b84cabf1e2f8198462553bd224f052e65fcd2abf0cf8f7763562feb437ab2dc677127cb57c9b92baa0a901cf29ae7bb35aa06b5a3c9408bde5feacd2f2cbb0d8a961a1bf2704136e257a9ca0dffc0b78a4b4ba6bc75b045c176239dbccc5dddaaafc83c0949a733b1bf73c70ac2a84bfb79320a8cfc990e322b05b7c70c7a92640ee0363a14de0c39f6bb3177d674eeb414f8f5b1cca4adcf4d56bfa69203f4243f784da1225b7ef90cb4b57d9802f9c1b706eb4b285219cc9d8e7f55ee2e2925cab95ab1297249eb92b3c87c6547caebd3e0f538c6c7ecf9c92fef0e4794e3555704e1338dab5d947b134933f9dbc6cf41cc519b0cd293f78d05319ae55727dc08f6e4abb071e1a9dd47e35b962c056b5bd39a91e43389636e0b680da90abb1ebf14800bfa459133e01a1bae2baafe9a5c96b6966fa0e711f67932ad2bc9523ba346faf319c365744e4c58df4bb1bf30ba1aa0dbb42b2a2cb9f72e369702471e19e8e8731e4ba84ae6721367cd89d2f2db7075a1f1373f39af12472b9658aebfe9222b8f7f9400e66c5e2bef1c0cbc0347cb6bd39b68718f8761e5ff5bd7deff7b11e676ab6796647317a87ab44f2b
-f3da7abb8c57146849b2506bc06c4e8e7b846e7544d35f91193d88f21e0cfb4fe1e36695c38d4f0db1d06cc66198875507584ac2e2f0a5fa88ebae4a2b54a68dc37e4f805a6d26597077e56b39a1876700e63ac0fbf9c6aeacdc6157a87e7fc556ea6c6e2b9998b35603077999a54ed9c85710ff7f644be4714b736b26bac4a93927c35c2fd0c716ed2ede7f8e0dd26e750620bc807999509f9ef765e981cce8e0431b0dcb0d55493d78567a1779c5c436e92064987f744fa48977605d29dfe9d7de87584e33209b291735fc27113aa769d3c033863a70759c99b7e7ec19d39875b16fa1e0642c4796634c4351b9e99507dd6cacc90d6ef5e5cfc68f68ddf9b55061c88907327b86acc8d5e00a71fe05cab3bc6b3353dd5764598edcebe708b917fb2773e21960939e88e3b2f73ccaad02517cf93bc20e779c18756c976790da67a3a5f72d3df6857ec40c6b8fc028d5d5eb4630ff133ce9d7f70ebc957e4cf9b7577c6d1781bdce0c6f1225f50f2c77a61b497615f14fa6959bb35b23dd8ab275b485f61ae1282793bc8b7588993277ddbf9919ef269988985e5a8053c83fa1b8d3318a9476d9b9730e63e6e1ed91f375f128f9e19fdeb99f08cc2adab423353fe552f8c75b6eb6c4eaa8e2388abdc70d67fe83e4eb80f749eeab81e8ff86bc06903757fdab6bc22b98a6408e4ce7b3d9a2dc056003f803dc17822d9237582c866ecf56b03cce4c31ccbcab39aec1ac9e52f6463673ba873a4f716d4b1fe5eeb596b8ff581747f4dc6b7ab7590597fef3f2cede83598a335308475651c6f5775a6fc83fb13618cf8afdc2d741ef7297ebcba1b4ebfefa6f7ec2c483b7c8684f9f9293e5d26ebd4ef43dff6f3ebf7b826dc6d6cb4dcce3757177a3f36bd65e77c083ccb49dbbb5393a63caef3eac990da9092f7006a96d25493783f4899c2b5cada311382d300ecd705f7eb8cae868de416e066c05e70d13806cfa2fa5ed2fd3d152477ed911aea92085c490de699d8372c85d33e937a0fb62f0313aa07f147a69ed098e785e22c32f584b13ccaa9a953847dcce21ea756e427cf79e7a89aae168bf7149326408df03bcd1d886c1a8fd4b3e23ad834d344ccb383f35b6bde4f4bbdcf8387739b1d360ff73d0d628c61a73ecfd15c19cffc191827f420eb73eb0a11c6bd0b1d7678c54d7cb3c755d8f8569f686c5a9fc7d13d0f3feb30eb29ff8a2bcc7336d1b33854e7afab940f466a761b7eb45f3849d6d98ee6b52a40befed26fd1697235af7be8f778c8a0b739e06349dded366ff8a000f7a509c9731ff30ef856726d676babb15b61394b62ecbd339c3cfd0de07048ae03cd69cba33c63d31ef795b47bce7d05f5cfa406e7758f35b3e3b0eaf51219f252574a97ffece14016db97ef3c79fa1ba79a06549c457be40a68deef866434da4b2f7bed88ddfee9cdc1709d291fe8c93c57609b3dac4dcb81fbf01b0aa757d11c60abd1fa82f49fe7a8cc3ab3327fa492ba9749b677d47bd8d44291fcbf4baef9bf7ebfa6576d4f7fba59d37b98ced5e9c837e1ac3a9c93dc71af8736ec992c941eb101ff3f8de932e8b14ac648b8ad0663a46436f26f7ab64f933fb2471b64ff30c8fe79e43d067b06d93285678b17e24f123ce7bcd24ce02d1f9c4706d96f0f3dd75fdb0f3d3b846fe212386e58312d67b7b3fe03b1de661e90ad3cecc141f507c4b6479fa30acf15b28b7ee9652b3ee504a4f778db371a63f7aaa6c6b9cfd7347be4736c38457b711f8afb2f1426b99f215b08faece33cfd8bfe9ac8d6df71bb8efbd47433c50e53bb8e6e9e3949bf24eb48f83a7d25560a2556430dfaf63ff2923a48bf834ff560339ea15f27f490c37dc8fd6355afb8f18f56270dfe90705b9617a7ed6bdb937b67ec63b5b605d8bba1708b1626d46e2219816c63c59ab30bc306b9c12f86f573d027b28967028fdcb993956bc2438a316b83da9b0efbeb406f496ce73ee93548e5567d9a6368d7eda397fd26293c23ffec4caf46bdbadf56461ab38f57678c60f5709f4cdc3f09f3cc76b5dc0d9e0fbdfbaee39e6b7296a4f6db92a66f4a537f2a28d09319fe2f6eaeab586df58a1257b97f5aa6c1692378c88ecd766f4ad6c820a56865b9ed91dc11968d4a5c7563b3ab6215abeddfa487d8ffd2f737edf7ee873a43dfc17c6998df1ee2099d3f8decebc5ac8c8c197b5d717364435f0c1bd700126eee0627417c7da8ab2d42e86f22861af41e879e326cd7c710fa5d01074d9fabec593f7605e69ed4be2ebd2de64fde65d669e344374d50bf3441f909b2dd8f122767a3166f5f42da708cfda850530cfd029d27356b549ceda1e69b8e6de59e1350b2740c1c8efee8136a8290fde41f598c9b07ae61e81bc1869295ec084f53886c2461f3c095e673bbb6f6e3202839e1437b57067c743dae0c8160d7a445fec5dd5afe1d77b4bc432dfd8c890389bfef09c6c1b5d5bbcf556c28edde7dc9cac2d9b95c6ce7d5622bdebe66e5686f6f98fdeccc2de4cff18a03ac7ceec72ce38f3ede16cee77835fa8c3c097a5cbc2d6665ec8fd404edd185a0146ecb6d353906a3e50ef0b0a67af347cb1ce6f99813bc4dca79dbb2e5565b652af4605a71eaf54b60ef7b2787fbbbe83c4856addbeaf56b5622bfa2f6b2ea1adef33be995f1eedb62ed71e96595896c284ddf9bdfac8ef03e37d7de247b7b72227f77ef27ecde4389bfad8ef35489abb1f7680f164f6cc463c37d837145cfd7d7ad93f7fd96891726e6350a8509f0760677a65ae0d8ec1973ababa7bd3d5978b6c768a44f1af0139dd4dce3d0dc29689cb12eeff8c5dd05be6c8c434f4f7ba86b9ff63e0fb934c1d8e145bb0fa8b5e7954c8c498f3cf4ce91b15d7006aeddb97a9977f79c8d03f32357d3c1b5e32771910278d56cdcc3bfcf6f35f4b7d82894be2f41c6b3488720db2ce02cc997f808ebd494a7f9e39a1c428fc3e0918fa070ef6cfd8cdf4bc93679182bf98a6be701c9fe6e7e33e0243de1f16c66be94de0c533d361c15b0dff07ec6726c87f92894ed47fefb3d9418730d00c725e61ab0f0f54feecf43add52ff7031dd3f8b5d277f185ce87b8ccbf909dc2aaa92fa518e7fbb27f33e6ba443ecdde89527f0ebf87e7f539607bbe19f0d586b8c7e7d8b3ab35757e7ffdcd1eebfd626f43cfe325bdbfff32c6fbc1acc899ae627f146df74efeb7f78a902ed01ce6ac6d7bbc03ff0f7befd6a4a8d2e50f7f9589ba7d7a9e06d46ae988b92851113c940a729afdc68480050828bbc0034eeceffe46aecc8404b5baf78e98bbff4547a9cd218f2bd7f1f793eb3803cd3d61ea97202f018f411d1f0e4f4bb3d7ff18fbe04782388ea444d8a73212f1f9b4aef3b20d12df40baeee47a02dd1efbbf58ec9087b8765043268521e0bb32b87d80e16b062732e6121a0390a590e36ca7ba59e9a021faaed658211c8e3b29a2aff5b07c93d019be39b9667232b04c4067393f4bf97037f63367b23ece268dff47f716b615e2fd3fe4f9d9015fb73447b90d58c6f57d44ce885e84ce713e73137a6d9cb79e03d8c4ac8fcad6c5705a2ad17bdac47bd8a5ed986eef87d3ce97a739b526f08356be39a273405ea92ffd8adfb5c253aef93926bc48b08d3e20461d858019fa4130b9a6c37e383317ae638efda9d6fd9c6afddc000e866e31439fd38db8eb0cce1ed2c14cf1f41ea9a17d589cdd097a8f92fb6972db9a6ae6ca09b7d38fd1564e6247364e4a74adcfc0488d5c59dc6f4b559c8dc3646915b963ad39c752754f1eefe10c8b948b125d53b4c60cc18033e23d52b8f9f02dfad0ae6ba44f2b899fd8699161bc54febc433649cadf9484cb4d73f30cbf85e01291f3bbb99f2624f60c78e110431d272c6e7842f4316e690237d1c99745039d9b356e5191d43169e07a17370d0ca7064f48e64401b29bd78e519c2cade0a6e6fd3b9b381f454272cc713b2c0ff41fc8359c2c5cc7e058bd126346de5f23ae711c1fe3c34ed6cd782c1b0721b9374b33a9eb8f25567755beebe698dbc2191f1ce7d2b504ac252d3c7d4c2e97a9167f4e272ac12e552f3b49c9de0fcdf799e9a6518f3d93fbf4bde15253c93e57302fce6df43a97ae848b8bbbe0f86197f009f423aa1329fb636453ff81a430dc846f84872bf861eb170ee9f64b5dc99494ea7f0ae5ea387a65ce60da5e4f53414c906e8f79ba2056572ecb807d0f736f130fd793c731e1c1fe740503b08e215f12d9ab924af4a36e8d0d39991774dec0b79eaa672f5d275e54dd0fdc9948162950377bcd0877ee6d0a317882e52aa9955ea844c5d9318ad0d2e8f58d76411c7cd9997ffeedf7ea19df7a27d595233a66c0178ae7b1ea777d268d4b3572cecb3d77a1e3de5a530bdb5a1f67e922d99a97a363e411cd035522cc477acfed157fd6b55cc1756a70855bf2b799b5290067568b73afd388d9b39c27f42c150dd82771bed5f9eaacc5f1672e6ae8ec78ee5b6300673be0d540ec7aa240dcc6c5bc1c8b3ae61a67a41e2674a3da77b3d58b6ca9a9a16fadcfca81a9a5d4f9103058a51ef30e5f73cc6b86738280a7336be03b833d1ae753893c0f7473743e8f2fb6a5de9c8d93bbc218daa24676b4357bc83627fc7fec7a607049499fa62497f11fce4b4ef9ebd0335afa7286748db9147fd632a28764da7959d66bec3daaf54dd095358c59332d91bc515b31ccc5cde330ffc1fba138c37963ad7bef87e2f088378cc68c189b5926b5e7d5bd0ddf24911fcb52b92afbec44ea74908df8ee9b7c84ecc79d303e6d4d1ee7a0c7093af7c8675c938af39ee14c2d9abf39992b5f97559bf16fb96385132f153bcc777d0b384939ae1f4cc5933ff613c016c739d030b79a4ccf55c8b3bed8e622f32738df9bdabae4f3652b8f4f24df1cc9e3b3dfc179d0bb1873b6e01ce9ecec0a1ca9855bdc48adc00df08526440f7d10eb05ce7b90e39b54ddcfc336279e1ad9ddbb18ac46ea09c0066f6272231b12f3775d6f4bc3a8735ca43877878c4e0c71c2053e233745329d6c80ab9cae77cc39b0707dc09769fd66707983038dd9b736e0a2fb892fa9991b0dc2663e3677dc02de20e196a07b4817b3f7f4eafae306bebe0858490c1e17dd6b0c6ef7096ab753c051c4f84ac0970c639b01febfa69ec8da8ba624a6a5c039e59caafc4ea9be067e031ef1eee7d224cf7e8c3dce37b1c7e37c2b61bfac1763dffc86e4537e101b03f221a491b8b4c0471b3b56b8843ac074bcdf0a80a57a7224cc6be98e0bced239248b8e8ea5223d0fc94fe0a573ac55b435f158b3f38074015bc3797438e754c9308febfcbb2b014e4ea3e614e93864dc4517703fb91ccdf3d3758af512a6b67cd4d487c6ec7a4b5a6b0bb0a4c818621984ec2992ffcdfc66f8d3bb3564b7d7cd05e3425fd97800e56361cf2aa45f9cdc8e5ad8e625c2b86776ea635c0edb21ebc9940d3406d98cf002a07972f7fda37be3efce07f46e7acdd65ac85bb397f918c3f2bbdbb9f6e7c38cf2a6b3be635749c3a4e237203616d2d51fc8dcbb315a5a0fe533233bba75fe9a21d673f0849300ad0982913b24f5269a632e38af0c32b65e9c5c23c1ba447a1287747be3e48fd5c4ee20db9cd8a143b1d21f203f00e7349a8e767de64760aec77c217735d3f2e68e236169f855fe47ad9bf175fd2cd35f3b15bb1b2179c5badf259c037fce955f5a4aaa26199511e54c1ee56ec9e6fd8c52350d4e9eb0285de19ad3e720bd02cb865eede7045d75532c37d8ef650b46e64a6162962af1b3ada229e67a7f642b71acad6469edb526e23569d0b65e6afdbeea0f775a40cc6743f2fb6a3b05ed67c8899c04c4bf9134ec10c2575aeb47c8f6c7dc4a54e7075f05b1874eb3c926ab6b27eaf539a3b603e6e910917dbf95a0de12eb639bc6ff3f5aef44c7a87c34cd711891fc9ae67ba229c1f2825c6bec4b437a60633d030ea16ca4bec4132ea7a2e18b7ddf0f9a719466ecf4f79e3de132e0d09a0411e5e7020cf6ea5ddd57c0b1a5b6c6ed1829d1b5f2a757f6c4e1376481b0f944b27a27903c99664ecaa911e79928e21cfc43853893fbe293b8e76929744f48a7dd5aaba312bd45533d03ac1c6f7fe148fe5486de8bec1f2fe1e0fd2e5f949675eda3bf335d4532fc8cf45d96d7189d258c5d1992782d91490bcc6fc317c9b4b3f6213f7ac84f09dff95d6dcee333e9d731e647be413c1e10df42eb8ec40f7b99c7617eb329c6f1c1b9517bde029f08c4ca18ec2d61d4dfea4508398e7cc1211bcf62ed9fc99ad8b823240f9935258a779cb35033d0fddee60b71719e55bd172d8ed41c3de4a6fad7bce6874b5d8c5716b6b8e9dbfc91e01b710e01e476ee709d58e24ca8ceb77a45e73ef84b317f51dee26a3a3563900d7e18f19e6bbf0b79df5e84eb43dbdc4b8e4674a7046aa7cfbeb53a6ea14fc9dd1ea5f5afbfc228fe02c7987206440f623f4f620de2058f23d257abfa0d916217a3734289ae559eb92e744525a2d835d88f3c9303c0d6df10ec33c75c89c81e24786989235d8e18e7f7d1fb6bcc07fabca5a6724a74addb725043474eced00ef299e227288715c5ee116cf3ca3bd89f8e635c68bd1d56628de708f5210ac9e5fb71c79321a0ff6fe7bf36eb74aa9c38643b586bce8b70cddb331e45924b007986503bb3ffc79c64347e35f027ebb2c94b46cf2f46b63c78d743b9d1cce301fff41c704cfaa9ba0f42828f0b18796e993cc6456dd6b7ddd5092d3b1ed4fd7b3567f4ebb4c2de753ab334b999237ebe9645928b7241fa6e87ce1791a9dc7dcd48e243bedf1d5ea77a76854b1d5fd045ce1f66550d1ca35f63ff98407287c05786e5d7dd78632c0bc00755a0ee0574f8ac6d077a7b3eabb876b16cc8961de5752a6fee71c5612c72c8634763b26dda0719c552063d67a2885b8de5b08cbf7bfb8c602dc37b2b5c5dbc2e29e79348dbf78a6b3a9931bd653f709d57f6e3919e5a618098c109e39e8b26ec311803b227290f2470405c80ffd1b7408fa9f25caabda93778f8911c85b1dd9a2a8f6c0d05eff708b82fe52ec650a132c1ec824ffb03eaedd97aaf87fbb5aee7d5dff22dae976e707d631f739caafbd11d5fffd4aafcf9e2d6284a8ccb5fe3a934e650ee129b68cd2dade20b9efaba76937029769a756b0af647433d5b1b271e9d49dd6c26f7303e0ca9d17eb6ee2b3e3bda5f09782ece4e193fd2c5a80fece48f31a718ae89e8c578ff293fdcc31a6ce9bb7eb56d679d7fb047d9b8828afd7c660f748b998cff2e4df0c5563e0c6ac34c75317c4f71ae166a836d201904fc5c15878f7758515c787c3e4e286fceba5fd77e8dfb14ab60aaadce5bed9af943ee6c45c1f7f774dc873a550df87eeecf84ce538cb08767029673bf7f2640fce68b1cecafce84aa76907076fa25e0915dd669d23505c00c4c2c966bb4ce3187eb7ea346a3e99f033cdce0a6105fd2bb44cf09d007ce4c3ce64afd4d773515e0b78133b96e2bf6977084d30c7eaffd74a8ed4d9df29fca606a0fbea771aa4650cf0ffe26f4bcaace15b8c962c25fb969de4b783bbd51e56be66c535501236f98ddc77b5b313de0e3917a213d4f94c33ab4093f76331776f59df8cc55a423f9d2e53a97e257efb0aaf238d95a4a34a65bebc9ff619fead14b8d74797b7b9de963f1a3ea8793a1762ba97306ec0f9cd729bb1d259a014ee1f8e695e8f7456e5b8b1be61952411fdf4abdb33759eb958e3741f34ad63f199fba4ede881ffb7a1ad81aad3cc85a9f07d9ddae9f3adc71dcd5fae6088f29cef3bc8ab309b2c39e62c626ad67fff0253b853ca54a9f5de33996aeffa81d8c7e0672db2cd57add80df4c4d6c73bd74d36b4f892e916b8ebb3ab1c9d0775b3072770cf8a324eea4ee1df39a6fea381f7ac6d9e7d1355d3c77c25547b634badfb716896e8e2fe8b3735043d734241a67c1d7e238cbda5c6784a32bb2ee7c5c746e9d6c372e2846d49d3e86eb0116622ba7e58c31f7ea7a24a78d97cd73598d9dfe16b56a6fc3d6fd01f1e1b1fa03e0f93ca851a7eb3883bac443e3b97cfbb984dbfbb4b51634073799ea62f21e3de6a9007c2f46af00ac6c3d732d8bbfb7f7cd75bc12aea1d7993fdb07f95d2d7a150f879cd67b1cc1fb9a5ef0af10fb0073548ff0fe453a681bafd7aeb8eee3acaadd85fc02907f198be95971feec694d00e1fd31b11fdb070e74a473814e756ac57b2245523edd0eb27fe34f3cc745d8e212e24c6104cff422823d3a12d3a9a4044a549c01bf348afbc0253a513e97661f3f472f32ac236f44f47c0f7c84cee9313f0f8c7f84fd7e2d7c8d9ae77e086be6f64466480f30a5f5378c63027583c9afcf6fe0f8f5e8d8d7ba256edfb1c20227769e2f5dc3aaae4a8bb30f524fa8980ffd26f7f889601330fd979408e3db07477fc8e37dc0d403b23a30c9dbbf55fc54722ca275e34650a348f0445ad8ba685e0411c7c161de921fb6dee4bcf2c74508cfa4ef9a288df811ac9732477f31f795a5881e93dfde5c1717b8ee5d884107f0751ec99c12c7d1ba8f389da85cc87db67e98d6a1505ecb0dde3b5fe012b3b62f1a8f46bd3ef89946d80787ec5c225fa05ea27a27e8edd704c9ee26878f9d7a7b9123b9e927467e1c819bbdc20064d62c5a7f7a76407b97def740469c1a32e2d0eceb9d9c90bbac9c403a11eabfc8d4f73de2aea17b85d806e0972ca75691219bccbdf1f5fbe591e8835d1b57b2c01ff2a129c4200b5cccd140fb87ecf9736d3b91364f56d97bda6f8c116a23c1ad67ed7d90450c2729f8003e269723d24b597988b1e9172cfefb872530ba90217e28118b99d8963755ddd6bdad4b742a743f60b1e39aaaef4aa4bc524e5bc2c91a59bc88d72af0401a1f163dcba298d62a851ef7709d56fa27e461016efa4ac47b7303b293c95da3fbbcacf6f9f0a11c3fd67b7bcc4d215e89f7e88ce8b2ba601f7d29fed74c1fd572487f6b7065d59ced0b6ea9899e178987f7ce5d2dac603fa8c16a9d95a4ddc0010832f1495d672593bd3d0ffbe339ee5158d9415b3dbf2e9fd4704e29f69b16664b4bf9de96d96e7465f69813ba529021fb7eaa8bd952831c17263e5feffbad2e66d31b1729fbecde8f8f7379887f0cb02db22fea73291757b2849c5534e7aa38d5e29cd860826d5e2bff5a8dbd6ae0f8356af3a88ad7d6759e1be023bdc77730381c9b130c4e491364c7a4ea81b1ddac15e14226186bda25f2b11f37c276217e07f94ddc32e7dc96cd971df2cdb60931c3ade0c1fdd47edc12fb15db2e8f6ada9ab620e0a2b66ad27d09efc7467bb5f8bbbb3f3ec46601bf11f579a1ebd8336bb2e0f0d94b7d77905714bda73df1819d5ecfcf2dfb41f96b1fe735d5b654cb7f037eb9aadd8c0f8edabcfe50e4de539143f61ba9df869a860fade2207edd09fe731b8aea43e04723f184c31c63e53136a006677b90db3a1751db6a66561ce4157694aff321f1c9be9a3c977b37f4bdff39d7b3065e0b89659d97c3b7efde2d43d7bcce8745b99ccc310603f0a606485f85daff296b9b4dae7d0fcee73ee0a1b8118bbb6fa753697c6ad97ac0df05b15fe0721b8fa672b765fb5d22867318ad910ab7c0879c1eb4262e90fbcfe40273ef9d0dc5c8e7587e05ff49fe409b13017cf8646d78e5f5bcb40023e6d3d9d4b13f74c66e6b5dbe3a676d8d2ba65a40304ec6cd31023e95e444759686bc287b94af1afb4a200fb28ff5340d63cb29fa1b8b617722d88777f6f15243f75ccf38af8e89358ff38a6f13d9a91547b7a4fa77b1b307b2ccd2f92ffdda0f6c3d8ed5dd596c2934a64c5d26c65923327d365941bf3f2c0e72a5e01e4e3c91d8c47dbbb01f5f7c8eb352c523488d560bd7acdacbfdc815ba11e048c36f8608fc067240f0e51799bbe72257e8d558f229600e92f573e5800f73d87fadae2798ef1f728dfd8e71e2e378565ef7fef0ae061372efdccea089c31b05d9875eb031a99a9b67f2044b1f9fe98d5cf106779774bdcce420dfe9bcbbb5ae7d2fe17217387b09bf08e0e061ccb725e5db01dc4f45742827d264952b29c3d946ae6d72bc00af133795949312c5480661ec169d3f2eadb9b8d579e08e9a0d21afb15dc70c798ddb565ea3625e399abfe46a0cafe38162e9ab8983f1950a568f021e39bd5bcc614fa1f34811dd160718e6bc4f52e06e919593278825fa8cebdbb8864df51e29a496583dbb91f2e96a576e69ad5dbf816982ce8336963b972b29e57a68e45a807f0e385dd2e46654fc04fc1970674bf43bcb91c7470d8e3ccc05c3e26d42dd3b8bd1e9a6464719ab89c3195d4f0aa2e9b8d7076e314df9b4a3de9fae909ca692ea5952f7b8bc1d4fd3e4eacd0e974c89e24f250a817b4c95e2eacc9db15c38151e0bcc0fc60d9e608c38bfc254073e4a91f296ba7bc8617af5cb5e619b4542e5a31b5d89bffaed310794c0532c8033d2f93da957ed838a8fc97aabb9242388e1e73b0de97848cfa6f1b87eaa46e30b5a77ab8311a1330b30baa310aec1984517d193dafc6e46ff43e6727752f98af3a9c061ee229de75be75df6ded9fc78f4fbaee25b413af45cf4f5e286f334e37bfc2a74ce4671aa1e2aceeadb2ca5dc0d6b736b1a9d8fc9f556bd63ec67680dfa9d6bdfd112b1e13390427e6925aeadfd3e9714d4824da82ce0c3e57ef4dd1d669759aaa2f7500eb962268531c3f7c57fcdf5c5a56a9c93736f932b15bf1ace9b77a46ade7888dba1679bf3543d18aecd231dd98969fdb81a390e1a47c2c744b920c4fb678c282604bd06fb9e298fc1a8e2c368d5a1577d2f3046deea298fc254024ece13c3ad51f1cb7912cbd55eb5ad373b0cce1ec89d2e5a47a1a9e1fc7ebbb45307eae6799cf76a211d1ecd5970f46e48d758f8584f48d098103c8f98c5a5aef68663ae4497c8972fe61af21b1f736086c54c5e201985db03fe8b2f70ef25e04e397ec1af7174b5ebdeffa2bdd486984de690c388fabbb4e6d91df7d5a4c6d3ff321eb76f6070b6f86c90fe5d80fdf89e6ef216ce3de4ee4ff547678e72f4a22b9589d9133caac767753b57ed3731846bd9867341085f16e4ca56ff175718d8cf63fbfb1a5fe5bd9d2b3c79fe3e37ba100e518861557a11ced7a6d8e784f387e5e089ae913b31cafab72f30ca69de43db3e63ce846a7e205f9de50161f882d3a40b3e788b7b8c7b8e73a8314fec637e8ab2e6a7b897cb24b7306be0d602de59fc03f4abe8096f2ce43003af63cee2487ad1959b4961866b04f32bc118a35c950f31546a3c9c76adf1baff31a9b0d867b374bcb705e3e6956109f921fae8c75617dbbfe75b2d7e9d4e94afb86f20260cb5f0a39cc1b4867ee35c60925be0406ec1d5b5c61cd27d63c8199828df2db97b76caeba5ca21382c783be5337f7225fc00bdfed6e4434730fa48cf70ac75df4993dcc13906f976f27b390b5bc1e83982719a6a62f62eb0f8043ef7a87e01f6c1035f33ea579dbfbb6ae1d100572e5aff69cd6bbcc9961dc847c9a67a76007f40a9ba4a941f9fe8804cae128ff4e95705e9a5a5ea2ba0cf633cf9a95e24ee64912832daaf907f5971d7436d7584edaf8a3788e11cdd524e7a13fafea924793415e24f25e1a2d964ed6e0d8ee4d5f31dac4b8c8b479c46775831cfaf111dec6be6a652fcea0f8bd2ec28557cc4d2f9c44d1748ef373671327405fee20a3dc2a71a7f2a7b2e9a0ac6c916c4d8310c4ddb00cec6d925b8824ddf4f17d94a8ff376313653324bb3ab6f1ae50ece520f9de12b571e676e4ce7037c36950cbbab5110683d39d6ad674d5f1bf8d9d8f176317e6282c7bb9be35a66c8d1606dfab2d6e56de0bbbecf9f59245e9a84ae9cbc36b1091b7513d59c511fa027182592715b2babf46bc02f9a30cf23fe8de7380bcf31c02aae19926f527390d1dcd4f5ad9193216fbeb7f5fd2a060358564ee64ed648be7cc9138475b1bbfc1e9813d0e1cbb0e25ef4ca207a4fd79927001e45ac203d3a0d2b3b92f54963ff26e8c417761f835e49308da82f03e9698ecc62822522e6af7e3b7e71dedef9b2dfeffdcc3497f2f5816fe504f8bfd8677d7aeab33e143016ef0762936b57923b8871de3df98a6cc2a383ecf8e45a5a721738dda67af603ae47f63ed89b9b9cacedd786ff555e733349f5bce47ac2bcc224d7476ae49933ebd72f6d6b30407d7ae26f6d63d8b9f331d4ec1de8f83b98f3a5e640e5f38b8531cd44bfa4987d70df03fcdff8e458a3e35c8a2bac3a38bb987beeb002a55e1b9f8b7d6f8eb17bde1ec6eb28de5f1d17da50ae812acf9ce08a65b85f18afbbc2b721588dccda48d018538c31ba8668ccec43bb76bc747cd100dbed2bec61c87ff8febbf174bfa386de2839117de501a73197bd1fbed259abbcad2aee89b1b5281ef5fa3695e21f6e54f954bfbb510ffab2c2dcdb195ac355bf911c07f985d6533b9f90c98382f8ed6fe4ff414e69bbbfb88f9853a811bfcdde53fa7e035ffbeb9c43928f05e7d9c94dd139a846ae00b5d1cff47c369f81c5cf636450923be0473008172b8eb753bf2fe4b31e0637c73262b7333f51ac91d639cd9c1d63b08b3ca4f34d16896faecf5ed913b616605f2678dcaf5f5ef3fcd9a310ad1d5aff8eb16642a8cfa2bfd17a6b7c46039e16534b086ba4812bb895aaba0dc03164b075c427fbb19ca549e1c9647fc9d856ad6b29c28dbeb932f22200ec75e0fc68e65bb39891a2b2bfa66e472d96fbcbd949c5d2d5bb7dfa77262099c1fd4b892e25a9ad68cc5b1583997047c86b4367f7619168e3f5426ff98769cd410ba33264312aab584852f8ef255a6322601c36f90e56a40e7a95fb432e9c6b0dae8590cd1784fa81e7e3fc7d2a85d9520bb9a5a59cb6267fa1728d70ba55985dd09f518d373e9514c0cc7e84e965a7e31bf6ff905c4b0be98ae29e3e733b511307d9476998d8662d1728462360671c5622e141d069bda3a3c580a5e046766af25ceede44d7971ed4fec8a23b37b8ccd28f47f7c6478e16ba8ec141ded054bb866ae4840dcee4320ca71526da06cf23e531001efb7e8b732126fb8870b70d716c4527fbe077f1de292746037fe250630f29519c3b48d7807ad895b8ebe0da79348e768931c71a359e1a53b7bae722c27d99f9e92682ba58d3aef6ea5482b165f13c38b7f3165578f3151e06adc5bc1cdd21776c60c941cc7001b5635b2b4c1c8283867d59fd4f24fb1a35f096c1b925c95fd2fb471be727a733a6cf5329861c1c0fea1a1552db19bf4ee5511b3be38cb133d07578cfadd3ebd9b65647af4cfa1fc3b76c2603a734f0772cad153d07701e348b99d1e1725fc3dc14c46ee3701b503f5627a865b3f0df9905b1fecb541865043b35247583a94faec7588c3cf0723b8755e143acd1e1a602d4aa830fc529215f9b83d80cc49a3ca67dabec3db5c91c802fea5e1713e8dabb3bbb90edf404cbf519567903eff3993e51ad1d9cdb43de2fd5bcd6380faa110767f35148ce136b2f7d7d462c4b2c977e51a38b7491f237703ef35d677df6861999db0262da8e603874dfce6498af575fa2f30531ed8363844b82a908ba35c69a7ccb09e6e60572c8195cce677ce3e66de0576719c583627c77333916711b8f17da96f904c7db94d4c97632ae2fabf6e74469cb8bcc1ff2645cd5b36ff600176945f82737601b07841bc1f84171992b39867dd7a2173de49780dfc938ac487e7aff43ee9e9cda4f55734ad63a33d42e91bc48d099907e40bec7d5593daaea2033c2f313fa72b2bfc3e769f517f4ee56ce3ac64e014e9287354d48664ea1be02d97bb44f102bc07bfb21d693ba713b0316e3299bc9b4f63c6fe312400dbf8efe6fcc6249c5759eeda1001c5682275a6c35fc973ec7c3b9ef80876ac8e285dc4fd65c56c9962d912d33764db4eae8819f07e3f4b4e66fd55eab6dbdf797b651b576c6640e864507f4232d4cfef63cde3fabd6d9ac6b7f271b62bb7e60d759ecbd54ccdd11de2f0aded3b65ee1345558941b34de4a6bcf3fa9c927763e17e1fd9f2c29d603ae572b209f1db836597d19fc8f957cfba8ea529bcf07ec2662539d31468f0df69c7be393f77dfc70bd624eaa66acb08d8dc2c8d6f352c07159c7284a4b2fce4ba15fe15d1872ffe8eec5f3ac3556ef2992a522e48eb6fa9dbda7800d206e718d7af9a446fd1e130564ad52e911cd5a0a5c9789ce96261e1147312630d6e9b85e2bed1803d5a7597ca20ac36ab2ca6623bec24a71a3f83bd9f30cbe479001e627606929afee81cb5a180f8a630246ef2b8bef42b0365f2b9c97a7d80e749dd658cb4a8a6d71e008942b4ea7575fba00d690db31388c2d67880febef310678d9c2006fe20a3ece8507bbe1637269e6fc32be6bac27f6fa1f5210ce8714438de0e1dcfa954c02fcc10aab24fe154e039c75dbaa0eb6cff889203ff63bbccb6ae6bc57e3a08b9c89f42564b345caa76370486ed66787f4e81c59bb4a4a740b09dff30e1c1d2bb6c6fb3be4de3fbc7f95b3187dbe84cf2eb354295e359a1f7f268b2ee605127317bf2767aead3162e8b5d5598baf75f93ca2fcd0683d5860fb903c0c187fb466f1998075aa4df67ea8f166e1373986bc7da861d1c5fa7e64ef609e10664f22fbecca7bd100c7eeca411dd763f29c94899a78808933a0fd0d503b6d210f1c59cde967c2751cbb1dffa4c89817d9b7dee8ef104fa2bf63bee441e1768c9b638abc2fd1cf10732fabeb6423f4d34d600b61e8a67ea24c48dea434b8f9a65a6cad5500f12059ecb8d1e0d3b1928b971a37af84cfbc270d701d97157230a79ad2c43f7922839cb4f7a8b6f7c49e8b4fea582fb4be80c4912a5e1dc0f8201c3c34763c7d1e5f3ec218dd631534303df0b9dbe0a4c67e2996875ac37e98e9132e64a8b51c3ec644b88b19907120b171740df8ce9e3ce3415bc5d4815823ae5f06cc7cb9e6257e8e4576f71cc88ba3b8581fda15d9045f3e879d3b5c57f0502e7e677987910e378d8e515dd301ede76618e7edcf69a4c0189073e2e2a562b785c342c6016431c907c63cc88ff92b893fc7048cb21cea30e58de822fbbf5543e5012f36f69f37d6e25041fa68db6fc6fabc689e0a5a17c4f7ab00af9c0d58547d6a7f363096314f467c723a46e6609c7c7e8bb9460fbe99c43857007efb9c9657e21709cfae8cecb371dc1e97a955600c8cd4089d4962d966c24dcb9cf08d8de31df04ca0f5e5d5f1129dbf8b05427d39f6074c379cf8bedc64bace75c96f1b342f752c48e747e47972d5963d7f20bf59e8cc713b9ee847807b15baa691434ebcceafd13524672802bec954ec2c3748a705ac900e79c63b9a072f4df6f7fed3bae619e763367cda4725255883185793436773a366ca027b2844efb037681fb631c42027226f705f37304890ce989cc026928d746bf692191a13a9fe3e95d43f3dcb48bc431ccdc0dff52c86e8d7f6fd01d6c09f1b7c5fbeb3d0f72e1bebe8404e9e209e9cf49ad99da64fb7c5617452e1fcf730ce45b41277d62a9ba17d916e4edb64cc3b96dac3f86f763a9599df2cc8b7ec7c68503b7464da04ed595a5e368376e70b29f8afff7af9f6926d3f7787e2e5e7ffbe2ce3e0e5e7cbcbb797c536dda14f7ffdf5ed25888af0e4fedb3ba6dff797cfa8d879c9c9fd7ed9b9c931f889fe92cfff191669f27d9f93afffdee7e891d1e1e388fefabb621b25f0d3013fbcbeecdb4b1edd762f3f3b3d91fbf6921efdddcbcf6ea70f1fffa788e06a81e3c5ffe485ff14389d137ff67a3fbbfd7f77c4bed0fbf12f4ef8c9712fdf5ea2fc7ffce8f3e5e7c736c977df5ef212de368e92dd5b517c46eea9d8e52f3f3bc2b717e973b72da2e3418767ffefcbec78196e8b1dfefaa32772af3f7ef0e2b797491484f57f74b81ffd8e20fcf8ebdbcb6c9b176f9eb7cbf3878fe8f4059117fa22eac3a3677405f20c138de73f7f04ea9b16dd76e882979f5cfdc3ec78c1c3f9179ac170f7899e3f4483f322fdfce38f4dbefbccfff8430a3fa3bc3866e1eef38f3f8647ef94ee0e05fafde8effef803cf0ffca55f608afff803a64c49b3e367b1dc166163c9c0ff0d8f1efe51df7e06bb027f5e1f8fe4d37c5b78e1cbcfc32949bebd68c536d9559306dfd6bb6d7e3ce06be523ea534eafc66fadbe0e7759f559dfe545eb6af453eb8ef9d13f2530dca4e95f2d6f686b7478f9597c9e76dffed900421fe647ff9f0c7d70fc777af4e109c6ee338f6050f87ff31dd898197480eeda5ff4834ccfd7fbf5af6f2ffeb6d8befc7c9995c73f87ab63308d069663aab92b780b297a23bfbd058a3418ba9d418fd0b504f3fddbf55d1b0cb71335f33aebb3278748b55daadce28ccc1457be30f70fe65b7391b88745e2480350593c506936c1ca1a84b61026ca08a9d3c6091d5dcad849bcc302a0e3e751f7a2808b75132871754d04e22c7afb539924675f1b14b615076e2a72cac4b8513a0405e094378163857bc71a7098321d526ff2ad393ed9c226f0816a0ab7459793bd63f66e8ed6c5cf1e33cf1654a0c250e451600be265a70d20ddbd79df20f4dfb8a9221df1fd128cdbd19fac2fef51ffec77fcce2c1d5f9059ed68bdb3977a6797de6bcdcffa2819ae373d7dad71e56cffc68e9fe1a6d7c4ebcc032f35aebe99948eb90aec431c003d849cec312cf820b72d2f703b4a609b7ee99846ac4cd667e8f7c42fc13d9b7ab932599c9dc3ba635b6a429e1f6fad45550edd9e23a67f9957de5d0b900c6ef416ad8c79a019f34819a9830d97e8333c1681c1251b6d241a8634b05686aaad36bd8db6317465246aca68b1d4e3b56a8c92a59ecc039db383d5a6e728a342d5e355aec846e6e3b505e160c75adc14592c1519c2a09963ae4ea40fba636e02742db3960227154ba4c623330ea74a3a995d0e721ba9bde6f8e0688303981c690f8d4de0c946e99ac9cd13a06c2db0cd5e4c9e9f6f4d3ea32157af7c36465d76de643c17bd9e3241c7f582dc7b093cf99ad8d62251b0fb23b43bab607b80394a6ce1cadbf1e0e09ae3cc957a7b57e0d86776c8fe3c6d0ff35765acf2ee619db9e626d8a663c1b608452f1efbf37085d6e2ea58bba857d90c438cf49a695071b0bba07bded0fa3e7982983b5a0fbde7a8248bc49fc0da099069eb0b46e409e27e2b181cb43fed2544ada1fb32503085e4cd15169f8eb50a96da00d2940dc18836e4dea97c3ddb020d6187a563da0ca57470d84ed69c373c9e67a5972b92dff16ef03963537f5421e4fcc9e086f6975a5e02b5e3dfe8758a94076a6771b68522f1252f7b27fdc32e1891f72703de97de44457a8ba792b247fd7265f13033af67a744e6e51ac39735fb84d63f32af4f040e6681d6a46dfa8932e402550a22a5e327760acf28e8ffcdcc75e609d558258ea4b4daa3869e60705b9387e74099cb08d4ec63ebfd38ed82bc0b42fb5aeb599345625bea7e0b7d4326ff82ae9982425fcd2c7a8d92cdc04db4f864d7832205993221efc5720cbb84adc501bd5b39141e8668333c657f0cac0a6e727df6924b3493069e52957b8aa52529afcaf8122951de1a6b0cf938b3b03a0b54f1e9389f4af1a7925ca20f4df981d63d6ec3fdda44eda9c607b793aeb9932780a95f78423df71f5a4c9e575fe70abdb3271b27d4af86ebc3b2db6380e6fde60ad76406f31b44063f0f085d5eac4471b3ad30376d488620aafbceec9dd48ba65f5e7bbf7e1a702e925acfb1209edc1497d9b6db44f7ed2c154f5086252019f8262a0d372b7f73caa4711ffc23ebdfb6d6215e7f3d628a81e90973314b81aeb31aeffadf2073a2b7635d160dd71193300e9069a3482ac85430d7cc5534d506f76d78320f9a09a595992391f6b5fafdfcde10ae6fae21b6cfc49c0beefa23de8fcfa2b30518a3b7a303949c3d28c3f8aa1f700600fc88117a91f2faf8bac76b5fc1f49fd8547eda5ffccfed181cecb39a7efbcbeb6d3823f25fb53b427f7d39291ced6fb69d3953205dc682332c7a3a0ff8bdccfbd09a85b2c4910dd08b783d31cf7ab006997f70ee2eceaebc39a1fd3565e4efafdae0a546b8bd3db94606f8834081b5f5f59890b2dbcc4d37c1528333a8ef4f9480c0f2886a7d8ec0be76e5e4e468d5f95fb8b22138e6a550a2b74f85f41f64457a4dfcd4c8d1f9a744debf9692c879fbeeafc63627ae1142f91a44cbc93a56642c5f969acfbc17ce48d4eec281b2159ebaf90fcb248fd07b49e880d03d04c7e983b982e760dd259b6a6f45e30c94e26056be5de7c3b7cb547bfb5424d547fd7086ff37fd20637958464a302db10cc1f2a19743098e84daa0f4679d75bc8c945facad2445360b81f0a0b2007436274d12575edf668741e9984eb693e240f95bb28f7d0fb4bd4e2b45eb475efd62fdf017a06ab682477d4db7a6913b933994123a69d28535b4bf9c7d79f5af5fc9980f6d804b3a265fcd0f914700555753312bfb2be720d90c505bdca1a58be139b5e8980d1ece216df3ccec9ddd747358260550df597ad5feafd6cdbd3c6fb757fb9bed85b2e545e8089b47635de9867f678c5bfa251e0322db91ee54d1344b21b401bbfdbe3ebb3eaafd3410ab753da17a331a4ba3f4901df50ff523c7524bb7a3b4f6eddd399cf8ffa7fad3efe94e00ebf1447f62c689d51df7ae2cdeee752843b8dbc3ed334752ad4d39c0f79bab3bb9d63e33d17837e50eb605eee0af1eea82906a9bfddeb5d0ef067c9672a8d65ccbfe61dbcdae1f52ba5df66edb89c141a832a16baa35568ced49fa558daf2d5ccf0027c4bc63b8cac4a99effd2958d3da1bb7fec0a6d3b3d7fcfe3567dffca29faf75b427ca8fffdf2ef97ffaf72a2623765d387ea9ea2c4ff0f65f81f6994a77013e354fd6fea850f8ee831ffcfbb4abdab7ffdff000000ffff010000ffffc0f7c8a669fc0400`)))
+var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b0800ce56d66a02ffecbd6973f248b228fc5ffaeb6c4880dd9e6f06cc664b8c01b3e8c68d136ccd2630e7019be5c6f9ef6f662d52a954259500f7cc1bf7c684a71f40aacacacaad32b332ffcf6fcbed1f9ffbdffef97f7e9b2f0f8baff1df279f9b7fac8ebf9687d9c4ff1affe3381bfb9f73fcbdb2fcf5db3f7ffbc7afcfcfc33f369fd32f7ff6db5f7f6b6c769fbf0eff1a1d16f05be2087ffdcd1d6d66f054f0b9f239818ff08feee8d77c76a0ff6ec3f0f1699cd1610233fcafdffefedbfffeeb6f9dc308befde71f237f3f639fdab3d1fe734b87a87d5697fe6c8f8fd3b9fe3effc4d728acf0fdf6cbf761fad92ef87777b63f04af855f496f38141cc085d9829dd112403afcfa022895d8ab7dc290d2d7ff987ffe1dfe457eedcd7eed976459d6dfadfc6ffff33ffff3d7dffea04026efd73ff1bfecdf7f5b1c363ebe803b8dff9dce0ea3a54fc6d88a5b421ffceb6ffbe505be2ce49e1efefa1b4002ffb6adc263e1f782557c24dffcd761495eb373f6c3dfacdcdfaca7aef5f0cffceff0cedff38562ce7a2a5a9607232df7ff35c5755314eccf64cecaecfbb77f3e14737601b6640b10599655b01e6c20107fb95dc3cc04d33081f5f0fb53feafbf7d2c014739c007fbefe0bffe6b379ae6c8bfdb531c0dfed111802ef9eb7de4e3e7640d53fffed7df9e017284a13303d2b31e9fec820dff07afbb7bfc265f2c16ed87c2631ef0eca43ccad7098f96cd1f05d8bfb65ffb19ace47fe5fe0afffbdf645317b35fff312c9638c5ffd5dcf7d7df76646680603d37de8c182bc238d3d161c4d7b51bfd9a6d0fe1a8e19b64ca4c6cfe8fc97e9fcceaf8c0cd2c9eb77e0702cf6765f17cc0e2f6ff3f589cadd384c5c347ff1f8bff5fcfe2840d7f98cdff31fef237a3bfe5fefef877ebef9be5f6efa9acaf7a818b02abf8fbe3e313170685fc832405aca7bf59f6dfec5c37f73b3cfbcf4251e47d4627c9cc5f0898dfe2cc9fcfdbb94226e6e7606ad83f6fd94a01907fccfdce59f5f7df1f8b45cbcee56202a0f8f8f0bbfdf45828f247736ac617472b3ee59f9e0a2054ae607c25d548c220a412fa6312b7871c4e29ce948be9d3ff8f79d3982b60e8dfdece9f8b46ad698d6bd6e26de37f37eac3e3db6af2e5744abf375eac66af5c72467dd71f6f5dbf513fce3ddbcf8deabde5dbc6fd1e779efedbcbb73fc703e77bb8ededc7fd97f9ebf2e9f96d30f5879bc36e5ab67e79037f33d9540fdec0994ff2a5dd785d3d8ff3bd2faf7e80ef9f0eb3b395f7facd5fa3c1fb615a6f2e609ecd38df3cb4b6cdef69ad9af33af3e36bf7908331be26b6f73dd9e41ee0bddc70d0f65f2bcfbb3f06ed6f83312e6ed75f7bfdc9ae956f9f87fde2c5db3c9dc7dd1dc0d25e4c6b1f9fcee5a3e8d57adbd781f5f45e3bf8b3be07cf59fee45cba4c6afed7fb00c6ea17d733db3b8f6deb5158a31636ed5c65ff695a7b3218c3b15afdb6ef9dd78f69ebfa6390fb1a6e9bfeb0df5e4db6bdc3707984cfbd1cccf3f5562ec233271fe6debf6dbcdd98fcd7bfbc0d7a30676feff5dddcb0dfdc7b9de3d7b8ffb41ef68f87a17dfa9ed8f0dcb6b4803dff02787663bb708071f6a341e9321a38b1dfc680a7d1f9f835a955b7a37e11d6ea7f8f97d6973758e40086e3b03fdd8d37d5dca80f300d4a676f00b0e47b17f80de7bf44f6b1f6640ded39c051dd8fe1dfd3caee6b6c17fd96f8ccc0f5274beb826b1be6df1fc69ba72fafbb3fc01a1e606d176fd038f0f5b536c5eff1e6e3f1ad6f5d74bf29c703bc02ccf670533dbff54f00fffea1b5a96ea6b5de19e0169fcdbd968bbbc9d93a4cfba71ce008f0b916bfdf8fedea1af0b27ded7e3e0cfb5ece1b341f87401b4077e7d6ca3dcecaa5cbd83eedbc7289d243beb4986c7a5fd3ca6109fbbe26306f600c80bfb56a1e6791effdeda8fefee008df010e8fadd5f3e3d07efa0278619ccf65a3dc78f46a3ed0f46931ebee9680dbd5e872f8f4faf4fdb7d5f0ecf67b87966dc1dcb05f4b2b37ce3f3fbc750a39e732b1bc7eee71527bda8db76dc4953f45f8ea0ec3fde138cebb3bb2efabdd62b8051951efe5e07b3286db7d8eee9f9aa60f6ea597c8ab6fdd778bf02aecf5d4ee2d472093de06c2b31deb9be0a35f7818da3d5cfb393296f2f7e9ceabb73f5bcb82edac7afe18f96973fa1eda7bba8795dd7e3870736f7df773d43fad5f815760ef0a483bd3f2fc615cab5e808f57a39abff7ca6be0afe6d9eb57d7c33cc8d67e433f46b03740dff9f7cfd6e6b498e4df81ee17c0a7ef3ba0317876fa09bc45f64737ceb8d6b3bdfe51ff7bbf7799d8d5add7d5ce03dfb777dec65f317ed68e35a937bfbd7cd39fe41dfd33f9de12e82007b246fb0cec37d0dfc781e2a00abc05fba0c3e7b6bd80fdf0137fdf3a097325c032381c868326d2c301f61465e6a1b57af972074d4207406b7b0fe431e0e830b6db8b31cc0b7c033284c99a7ef538a9f516c8b343fb239043016d2a7f3f2c877df717e091e81580e51bc63c031f6c879b974fa7fbbce77f6fabc6ae6537816681e7564c0ef2cf4b0be8babd9b0e9c0767b53eba95b93da9cf1f87f9de7962e378a56f9039206b227c0fb8465906b8a734fee8016e67656b0bba278f32837dbe8cea4d22f340ae6c605ee0337737db7ce0e7406638ab12ca22c0e1fc30aaf5769ebdc8b5568d23c231ee570ba0e7286f05dff95f6ffd858f740d32e9ecd44b059037286bf2a37e3b370abe03b95f2370e27ca26c01de3aa17eb1018f36c851d0292eeecb02f9047e4779f338b5fdf5b436a7f0d5c37d6c816cf288fc3a8adf2dbd3eea5fd48dd6b757abee614ff63067e479493f822e68fa2853bc81663f97eec6db0ca33278750019ee1e81868bf03ee805f757cb3ef9def69dcac87c09c6f6899e045db3847ddc4f11c67cfb7b52f9b49cf287d6766032758ff643bf0cb214c67bed307d6223ce7ade6b398763ec1af540e6e65ca59d4165e6189fe9cd41ee7e1c9b9df53c324727982390cf81fcd6c8fab7fed37984b6c06ab7f2286dce4de574a386b628ecc53667ba77ecb7de63489b4477725a035cf49ede8097408682aee2bab9a1a7836d48fb74bf387d37a374b6f1be87d4bee1f6d7c3a8f6642bc70e9f8dca98eeee739cf770ce0cf2c2d9fdd109e784f7c01ef17cc5d88b21c87cd0e160875457237b4a6d73fc1de91bf9cc6e2e5e2b2fc737fe572ee45e412682fd07f613f2db51948f68e75c80e7f7e398ac14e445d753c88b173b2e2ff87711797172b7317941bfcb8bef2d9e888d0b363cc83d6bdc116de0c8f77a38576d955ccb29e45a4e25d75c855c23dfe5c5f7389c27b4b7fc800e4167218e81f7600f3e24b923d2b2730ee009e7be3814470b90e17c6efe1dc213aef14278e180ba2f909797087f90f7809e96208372601730b89e1680bf2fef22f20bd024c88cb17d4c7806f44d9fca526e7fb3f5e3f720eb27817d2d9e074026ec608d54266edd23e8b412e82a6bbc799fbfad7a9706ceb381f307d06183e80cd0b7abc362bcf10fdc9e899c3fcbcfa0df9d398cb3813fd02b1f733cbf80cd760e75ed27e2768ef277d47f9f37ecf6d2ab35d7c395ca1e6f149dca7b81d266a89bc97e84fca4b5ebd1560f799acac009ee3dc8c7964df00a72effda1b14cb6df1db4dd45fd01674294dfc8ff21dd55895d0334741e9f2d1bf6d91fc3b96dd4b716486bb877c0a3cb59770df8b1b6c8d780fbadd741fece9d86dbf509f07df0e477facda2b3041cf727c863b06748cfb0b7dd2a8ce5a8c63a6bc7dac05867d5582e8cf5a21aeb02639d9563d9cda25bd68df5a11a2b07635d5463b93518aba31aaba75ba30563e55463b5105fdda1e21deb3219a0bd18c206df7d839c3ea3cd10ca71c11e11e5771de90a7935877613f09897439b0ece6d706ea7bc80e76da049b0af3e1e85b32339a739f59ce83b00fe38f96fe8230099043c06e75aeb0b7d2304e66dc99a9663677ea0b9d36e527701eed86fc04bc55f317f40bfe8cfeaef319f029c35e08ce97f4f89bfc145d9b89ac239999dbbd1ee87f7dac84f0b90178771fe13f8bd74443e061e5a91f38ee43750fe6e5701ae29c0e1e7bcbe75696d989d11f2fa833328151a75e4517fdda8cb3c1ab7c7a9fc6f7e133bba565d8ff3136efb0bf69fff45e44ec7dac0dae0790f78fd1d74abff00b6c809d60567f150a7c059809cc147dbde05f0b9997550b6800d85306c3cd0c3f3c304ecb0c9f6fd918d1bca864ec102d9003299c8d9f0acb301dbb9e67c82dd90c7b37fa39c9be35a5fe13c0f6b213e0b90cfc43e457fccf03cff72291cf3b7cef30964e44e1c97d393c9b3c44791307f369bbf04bab4694deb6dea7f20b60deafbe03b38c3b816d224a3e3d5b4f622da4721ad7763cf129a07ddb4223074e4ef03fb8df244459cdf8733732f273e03fccc7f17792df2bd0023b359f8dc1ccee262dcff907f0b7812654cb0b6243f5ef47d892f89cf2be519c6bb49cf44fd7d917582ae2f4c091ff175523c79fd771937744fecde9af9310f30c642f7db18fdcecc8e82f3c40afd7b5e398a1fe17b694dbd3dd0d511f8f0121b5fe3f74c1e4fc451b83e49361e81df411f3f11ba93c6cba11f753868fa2887b5f2f622d09d7ecf1fe8b81a1f6ec218ecf7803754be5e9147d4bf077ca0a5918431421c8970305b12e433d311111a53e90e09bf8a31441e8ebf2fe030d44122dc025db367dd47585b8ec8e5eee731b4a98b63b4e9880fbedf9e46e4508fe80ae2fbf2aab97d84b663bf5139d3b7dbbb898dbeb5de7a90442b96f4be6aafd39ed9c01aabc9cf843adc95d7c97139e7b897610f71588dbc3bc37ddfbadfc41f867e4ca0ed717eea7bf5dcf7ebf2658e67b111f00a8c0be70bf73239976ca75c38828e99ff512eb99ddefbbc5bf3d1ff70f130d6559b829e5958c36511f469ee7bb499e64635ebf286e7a58d0f74e9fa4053ab49dec11817b5bbf3550bec2cffadd6fcf6eaebbd577b87bfe3de033e07d90d786ec37992fc7b3b0558de6af313fc9de1ef027f39f8b3e0cf7eab2de0bb456edc3f820d42e34e6f35c0a58de714e0d9cee97b5c3e1ddfeac01f9d9320074ed6b81ef82e898f8cebebd6aaf434aabcec479506fc39f0f70e7f1ff0e73d022d7e4dd12619ec7c382b1fd19fc4becb077e8dcaf3f18f416f3fb3815600bf60531499ff1478d8e26741b4e58e5358637096ab0bf6188f21ac80d6fbb8be13c63b0ad48788e7c25d70fec1efffe87cee055f5bc4b736cecf894d44d686e73c38bb802dfb341cf460bdbfef899d5cf7765eadf73d0beca5dd02e0fc96cec127a7f2ecffd1e77149c1beab5b340e167e87be69d003d523fafa847f07711b580be0dc25e7567c1ff62b37aa8bfbf0fc486838b453883d1ee243e577f95c791b6f23db82e46cc96c5cb08b0aa847e8efd637d0e127c5cdf43c1c009f80ed15f541ab9eada25f1ce15aa05e937c1517a742fcbae4dce1c1b97d7ab60aadcd02700ce7c555e4fb229cc957701edf8fe10c41e27de83b477b98eef3f7a40eb2a00febf840baf69720df76d3fa3ae2c7a4ef11ff32f073fbbf415ee4387dbef5adefd932f599cbdb00e98fe1ac0367addace02fa7d70bacfc766f790f07e0f6889d89460739d001ffe1afe0b72786aa15f11e8f09bc4fc6a0b9ffa2f4e9fc417377846da5f11d9ba8dfb75ffe853d910d831352aa3df023d25f0f380f9d8fb1ed875fe1ecef765228373a0c72cb7083ce88f3fc83eee694c0174c4807ddf392e3fecde766c7fcc7b1febe55bb5f98df6fdf87cfad7acee6fbd7e61dfeb372d38d3586f2f60376f098feee10cb96c837c1b764a5d3ccf3496f0b9defc467fc7875dfd9a9c1bfbc67ae18feb9e0f6b5cc1732d6fd0f31bcb53036437d8e1edddd07ed9bf0f9a3b3887022ef01d0be45e73e76d919e413ea2dc0a62254f17e0451e4b8073ec13c8594223945785731a9753f01ee07e41780cf83d37bec4f004e314bfd1af32b4611f374febd9e010f0925b7b017bbebd509fade877c027cc9ff67ec43333f0872ffa1cdf56481fe198cea59177ecf6aa15eaa1e318741ef361131a04f9b00239b260e71772cefca3ff3287fd3ac3bfb7339bf97b36384613cf9b1532fed6023b6241f82b7866e95cdc95633b17ef09e4d03a29dee0f68796b7fa7824f22adf7868d8dec6b9cc6d3986f3b69ae769ac57898350862d0b677a2e2bd0731c39cf594fa37a532fbf563d02473c6e05fa3d5fda0f07eb071e7b92fcf2a13e83f32aee51a302ba0ce4bba15ca73610e8ab49ed037005fc6aa33c5f4c39eff1df88efaeff349d11ff3ad231fae3a91f00f8176522a1833f804ed1be9845e9e7ab5599103c20dd01ef73dce6667d8c53721df51ed3ad30e627f2c7b46e5da6c06be3cd34dcab0beef110f69a8f37e534980779fc84b694e81725b10138f78ff370eeb65da0abd39eecd9b60d74e17e02fe81270e5be62b3d84348134027bd44de513b6cedca338d78cef417896c7fd3a9398299cf3815fcef8ced45ea06ff54079f3e301e16fe5a74013406fc01b1312f76e227ec9da1a35f71bf846178f90fda7fc7bb0ad9bc40ff34ed727d938557fdc8dda462cf63c9f82cd16fe7ba1d4d52d157d007e27dc5ee89450e70bb2614878f50feacb5b8d49ecd7dbcd2a42de870d762fd81c28a347641dcc87b36ccc23f14116f766631da74087706e04dbbcb799d681466a4f0be0070267a3ef1f262003603d5fd33a8bd1d4f11c0b675694c7c06340c39887926b6de967d53b43b4f9ea25b0a5051d7fd981cdd05e831e59002f1e61cf60edc51c838be0f9ad5b95f0ee220d243cdf949e6fd0987ba7ba1b0f4a2007dba8a7687c84e96c272fc5a1b7c9cfbb75e9792e5354b8223643ce92f8fc84f22ee51d7ba6d02d09cfe76559e25e7a715c7d94e0cc0e74d7295d8679b085fb85397cbf6e94172426c5e335ee655840bfdaec2ce2f605f52dda59be34d72505b633da5a43f43d0d24dc2d537177d1be9bb64f65b06bed269c6d7bf2f9249526dc8efe5d67d54ba1c1a10e4fa97ba85bebdb6aa2c013e6159298e3a251475bb9e78f97a03736eff3d75ac4eecebb2bff382baff713ccbdd8b61f23f4dfe1679476465ec318133957e7a4759edf5271e468df4dc111e66511f935bd820f13de3da7d2c45287a76aca5a27bab5aa7974603d8ffb780605bbcec63de87d35602d5ef9f9538a651f5b9529ecab1fdb4fd071ab91bcc67c8a1c5c6ade33e1d101b50daed84bd57ba774faf960f99c8babf853fdaec93e2ade5b16f22ada4992b591d878f7b9d0aa970aaf83bd889bd31b9e096d8c9f9532f325d83b9711e6f65cb397da799b29f3beebe735e14dedbc667baa9d3b8dafcf169c7ddc5f1837ba9d3ffd2f813fcf2df477c4f913e5b2cfdfcdac4b717c582ba5a962767be79c38bf01cf26cd6fa45b93e637b28d926130e063f4c50c98cedcde55d79edccae4acb29d3cbccf505bacb3db4e9e35ae9d3ebdab7859f36ebaedb4c173c1a8d6be667fb5ef1af1b11a4f06b6937aadbafd7cdb2c16302ff5dff5596c7ed35bcf98af05ce8f709e11f204e5b96bd5c037c863e7a37efcfd30ae2ee34c9c1f63e16de55931695e1a178fbdc77284a2f325d0afe8f3005b71c164961a3f828dca7ee3fe0df6ccf23a3d08678e22f20dfaf630bf18f8e77338403b91ac8de575c2fbe5d00e93d6cd733faf94d713bb8539021d255e4e4e659e677c9d02d73bfa0ef7b33be0473c93a19de0e6999d20d000a74f3cc373fbe4be78413b338d2642ddfd3373af353869e4c99e25e024b00bcad6f778732a9af1b7b9bc7756f3630a5d44f5dd0fcdaf3923e4dc4b3579ef22bab47037da8ddab8ef79ccf94cdc272ef76fc78f813cc3180eb99b82fb139cf525da25b19c51df2bdeefdcad854127d3ee0543269922eab3e0ccace11f9d7ebb55ae4930d073e21de6d69ebd7473eb65dbad7b833aa590c0bb67f7e2a7d16e707679eb3f7d8debfefd6154cb373eee8ee89da83d2dc90e06ef767de3394a47c3205b0669f46322e36e8613646d336dbf02dbf80efb6420e7488e65e88f94f887f8fccb3f62af11db55f0b749b82670fd99765a141e8d2ccb8a0f133946c70c7c6b77c483ce2ea37b2ef8437e60ce6cf618c3419aac529c776e96536cdfa3fe829f98379bfd45f728223797f7a14913bb8bed874e1665c547ba1c62b142edd951193bbc939dc5e7d6c9a2fbcc9d4926b07cf6202ea0e107e539fe567914cead3c2b669ed3c49ee2736a65d26d7b709d1d15d024b7ef9ed576c1adb0a9e512bfc79578366478fb53eda6803e0c64d38df0a9eda5605f027f9ed17ed03c787731a9f939846f26f8f222779037e2331f2a5f24c9fb9de0737670268cdc891ed79ef270b65f0d07aeaff2494ef13e10fb5d09c3b687f7f7cf43bb7af154bed43e856f988fe500d1efcf09bec4c1f413ef2c86795ee4be78749dfd97c89eb39c9a20ff7cd889d06a7e04f812ee0d8739595dcf77371fb6d247d98fe609d1397a89f9a949be4e90dd389e90d7545a38b597e350c29f043bc857982fc8eb3f2da27ab8b9c79c37456edcd1a93ccbb441c695e0a7e3e775b973ce71d825702ac7e2360a910542be0fcf7593685c82fd4473dcf8dd87da8ba4e3596e18b91fde1472f7d89dd3a59286a4759c588e4f4ace57a26f1bf3420ff17cb465e2bead8783e62fdddabc5af50c7b1aa347a7ef9c8697eaca841ec918fa7d63b991c3a47d3b4ec85de066314237b5f7d370d548e43762a7f4abe759574397033c53598bc9761ddfbfcbf3b1d5ff28b826fb178ce36bf9cea994d64ebf9118df2039c3f978ae6a1a7d629d0f8d4c217736157c77762abde570e398f01e19434b9b1707f4bebb4e94957dbce3fd24e0b601b8757d2f59a6c0babc6f1d6de2fdefb18a366bc382d3778d68938ca1a74dc011de33f948a2cdcb14e602fd7d89e0d67e3fb9abe764da8473ead0ee5d263a993970c16e7449ad9e386d5657c3ee30a7c1b9449b7c1c574f9b2b77ed7487c724da9cdad5f318ef0408f409ba64ed553ef289f43998825c2a923c539dde03defea2f71a643a459dfa7e7156ef26741a8ca397a30071eddd72cfc97274bc9946f5ef6678695564fcc87cc8de5be97460f07b5cbf6f8647b7ebe40de5e99767238ef46b2439e3e5a435527d0cffdd4d4479d36d801dfd72368183da097bf8af7fd1cb86973319736900cba01de5e3d5fbd9ad3472596021b527b4b0347264cc44bc4ccfde604d7596adcae11f9ad021ccdd2b327dacafff62a6fbc4b104fe6f2f48ce77c7c4b610d6a49775247fdc4db69f082c024ef2c3ee74e1da2fc638d1ef0d19273feca4ef0db5b7da829dd5b086abded2cd808b600c8d3c24e3f51b56923cc43a6ab30eb3296b1199b51e6ed0f66b64a0156affd1ba821abb1d6d4918375946d39c7d091e7eafc44886923348c74a86456937ab61a1f2218465d8f716dee6250b2ccbd1c04d38cf54c998c9b0f4d85997dbd9f2bd19b027cb89673d9ff911f89d07e5bd4b2318e0ac0ae7f325c0bf8ec331348543f3fe44f13e9e73e99db659fc0ecf3df2d6e99ddab390cb2dd7b939df2df621ce17e609df793ea33c6086d358ac230dc7d7e61d87f3b15cde5be6d1c5378479c43cd13be137f05bea624aab49514157b178c6fde069141c212798e1518eb3c6ee86fe7c0c43dc6f654cf56e304979941ce7616e611aae3bacc606d808224dd23a94b7e6c6919a1ba4364e78d723798e1b650b598710c310f04ce0f8e9586a1c06855cc9ba6e6dbc82d4d120f54279dcf44eeb95650add43212e71e7790ce2a4e15a93e409c1fd1d6210c23e4a39d4779ecb4c9650fc2b630d77e2a7480c34c4b54a8e28d7cdfcf1b4a6d6405567eb1eb224a8d9a5b255786ddd1b79fc84fe4a5813c503c8167a275d2953d46bfb197b2558bb9c3f7b133c49b245587bcc66e1bfdd1a8b64bc16d44556cb98dbf09cd16e89c3a290e137af3f2a6b8239f5b6cbad3860b692060760479c52e159de1f0f92fd12e23eb8df903e97c41fac4690b2d69fd206ba5116259d9fe47adf3f239be4b34cdd7ced3f2caba4b35e92ccca0e9f91ec4a3877c9cfdc5b96f1f348b24cb3fecc33991eb6041977337e34b22efdbc762f1c5d27fb94f02d7f0e4f3a5918ec55824cd4cd2df163ec9c17beafb0e3aff32785329cda908972f107ce80f2fc2a3fd35de7cf281353cf87897b72b5ff29367f823cbce7d9519a57ed97bacb7e5c6de7a59f2bef045fd45f25e1db440efe89674e995ed2fc58f781516b0feacfa3c97b43fd5bac864ee4fc4eea6249701af216d863e23ed660df6abd2f216f57c00be971755fbb8fcfa7b4f7ee319f919f8bd7258ae5e7a6e0d8d4de4e988fd597b87e9e24fb2d98476db7dd82df6cfeae90ae62b9b7f782275a7ba1cf6a4c2ee5da03a18e207548ff9c3368b8df29f6d76d3045fd5e21ce83bcda145ce7b82cb7e1b91df2e32cefed2661adcd073ee65d648d308f206fd877fe7e346817835aa1cb3bdb555bbaae318951b35a3d666bbdff3d011917b13b4b37c395248b245cb03b02c043a33edaba7e0e6492ba6edb0d76141b1f6bd387b558ee8bff0c7704f43005f734ee8b0f495645e696ee07dc192719ed261d5c3f8417e17e406cfef0ee92d19c549661ce2dd61107febe4cf2425d50556ec280d7a19cee491ea5589752f97c137b135913fbc4721a793d67f6fde5f3a8ae5fb280df9ff20c2656979a7ea794cb9d22d61e4da9f3ace81db28df70e71cacfa41f9193c73e24aabe5f2fbbb7caf31c9e3b362a480b2555ffaed3abb2e667bc2eeb04e665fd9b823a3f987f4afb5e86f9b4f433cfb9bc15e6e7205f399c8bd5d41fc8f8f6b1667fae81ef636f964a53b7e6f36be7786cd07950b7cf837ce37cd8eb2631df7325d5041773f385df021d31c0fd8be5e829fb32aa7bb904fd67841e2ccd6fd2c7a4bb5b0dfba7d56bb970712f3dacb77aa0b85b877b76a6772949bd33da132ad69f056bedd07eaec13ba4ff0fefdbc2fabd0a7d5bd4b51e5a797a8785d542a73d3ab1b6abd04708645b6e82f65190278ae7a505d607cf09bdc5e6c09b2bac2b1ff46c593e7f8d30bf7049effaa00cc0de0ac1b8e5e20e7b2b467bba697e0feaf846ea3a636dd840c6bef1deafd2fb40efbbc936ec19aaee43ea3f729925f5cb39287adb043d47c3fd782990fd54ce1fe9d7cafb986e468366e2fcc1988af9b4b019ad5f84a77dd3ba4de060e7025a8fb6ff2ef6f409fa23c6f993e5747695b57923fdecc43e207c4e2ee366a17c56d5ff8ed5e70e6937da3786d30cefc9f3da89cf157b4696792b262b418e933ec51d2eef3e50d69ddccef1ecac48cf582b8ebfb0374938af285b25fcf1fc6003fc91dcdebaae47a5b657564a5d67413fa96baa5bb4c700e9f727e39de63a46fbedecf5cf28684b7846e859135f7725a547a7aa263bbf57b595f688e679467b37a9e74ce9dd89f7fd167eabf65130a051b2c648ef95c1ddf6526123c5f68ae6e8a6f5d0e5365a529fc4c83dbeaa7a1ee68ba3f5cc7beb187ed9fdcae0be8c367fd9dfb4fac362167a26772001b618ffd039b5b288d206d5b37ab974a779835ee45c5eacf74a3cf2fb7017f9799ff779f904dbe57b8ab634b5bdbe9c2eed0537d94c97c3cefcec763ff6ceeac37aab342c905d5ff0ef5d128c5a9e607bc67f4fb80379f656cd8da3b0e7d89ddf23f6e2186fdfe377576579caf6c3886f8c6958d12f50336f78ceeac119a5b7d6df8b60f0772377ae364978469f06f6d8037998ca070ef60a321aab2df5704a85377d8f2274c67b4c8838f7926400d6c2673d0182dece6973cee5cfe1dde6e780cfe4b3a37eff788f73fdfe29d61ca5a1b0c778785ed99acda9d56bc2fe053dcb0c68413acf4adf6792cfdf000f894b79e53bee15e266f9acbcbf6e82affbe8c8104f8adea0d7f0a8b44f6dd3fe190a9b2f2e2b4c74871e16a14f9e7eafd18f893d36f85e877b9f6a5b0577f633d07f28337f6a7f39ced2f65887dba07e824ee60ae39bda002a7a62771e59cc81f076dcee88d55dd0c9ede79c53793fba950cba55b557fc2e67b2ed1ead1591ff9139f9399bf781dcc97292e18fd378ec79853f8cd8426fe8c3c6b3aed227a63abb89f0c5cf71d17d0acf743a9e019d9dc19e56ea518aff487f48eb1ef46a725ea0739f25d9514fb1bdd2ecb9088e4319914af7dca6dc9a8df7e3f6a2b17ca17c8f3d84989f37ec4f9432afdcffba5126f032fd2afbdbb57b17caf9fa3d646daa7da19ebb12f691d5ef9f6833a5c941bdfd6a6a8b47f627d4a577dcaf406fce75b5798c705735e1ff4c76872aa67235effea936b6210c3a9b3ba28b13ec29331b3c132fe8edaf7bed6f104349d85b254e0d6b1a75b39ce735be3736578a3f70cf7a10a6f9fc7cafff91c567a5f3cdd1f9e41ee412edf0fa51bccff44fcd9be4c3167178bb3fbb91435fb65b41ffd0bb85313cb7e327c118f6ea56ef57067f6906bf9ac61fce6a4398f93bb2d0b0c2dfa19efb5df6bde86d33b68656acd6432f09dfa1cc483fa39d5bdd46d159f966e3550d6dca00ee6b6c4a51ee30fc0f92e4c1e91b63f3984f00f6f586d588499eb75c923e3f073083fed5d56dd1eee55dcef78abe9fbc0e87d9dcdaf3bcb89719ceef426c44a1738de32691bd0a75ed3df72ea85752d6d64231d93b239960628f08784bf48166e2e5f21dedf2985c31d23549be208d9d2eee7d92dd9566b707bd7733f146821fee4e7b2de48f24eeb516bfa43ea3c1ba33f8507432136b4ca5f993c833e971850d3d5767f27169f68bd4ad3a27db50ac4ee515fea4745c6488ad456a69de900f907be37f6adb89cc93ec4fa2fb941a53bba06e5f838ebf17ce0e8671a20c746b6037093462e45332d74d024f6488ab717bd4084e439b29d697fd5a9bc960adf7f22b313ccff5f56b75747d8f73bf207bb3ac3ddd5e52c2994147297c28e6fa4ba0a19f89b3057583059b3729dea6c4dd7f96fefc49df524c9698e8d7ec3137a37518f8bb6367086d5e9d4a56fd988f493c475f71decb507ff9d6b85b50533a251749a83d9d1a07756a587f73726b5c27a8939d127f0b9ebb261fc90027e63e27a1fef6cd71b8f2f1ec54d67ba73b2968ec26093f1adb498029dd7e7a3e0e57f3e2f072630c33a45f43fbe9f9e855aa4be74ef693b47f57c4e5183cda75adb3db50189783738431aca6769432cf25ab0d65b6de3f393e27d1f75d6c285e673ffbdad3759a165e837c052dfd0bbf6591593f644b3158d096d2d4ff37c3e1ddeca9703f93ed29f65c3385c6fe4df13abd5cb992f66eb1a9487c2c666718cbadffc0b8dd789390bf14dc9fb8cd6620bd2ecec9361479e6921ea3702fdecac33cb2dbec27d2e722c57662fd34ee63370938c814a713fa7d5c6d2fb92467e905f3b7cf603b29f3b705f81262744593bca5339c336caf729f3d32cbc3712ece6a72f16ace7d72b729de8d6373522c9fc1e226d0dc3df29694301ac632d57725b3e56d9bacf34e311dcc032e2b7a6ee969f84eb191f8fdb1f435a7e79028e14c8fcfea695cfc2d55deff68be128763aeef15948eb7bbe5f70afa2b31ff8edf65d6d3d38fc4dea272234d4f65cf553282df20f61b3dffc5ef64ebe5d1cfc55559de65d67b25a4cf54428c84f6aaeade9aa78c3db07e4f89af9167d2e36bddf7a28b7b7ca3fd41fa5fa5c4d6589fad3be56a8738c810578bf401bb3eaee69cdf2a2f96bb3c822d32d7e46a87f0e9eeab317ca4e624816d55f0361fb9bbec9169aef6eab938b47bfe9d72b509de8defaac9e720064b02cd65c84372372ca66c04e39f9aa79dbece3ff7fe9340c3f7b95714c8bf2c6b36c8fd50c1697067514be3e26fe9f2fe27f38d381c60fbe87a08a6e3ed6ef9d9a2fe4af269b2e71264e28fdc5b93e4468a9eba22c7c804feccb9d96c5fcd64e67fda5dc4a0f76452ef4bdac3f2d6f37ad01f3325373b782eddf7db380f2f436bb8ba35e612f4d74cb9bb1ff6e1cc9ea36d8213f33b6bb19ea1d7dfdfbf907a2395f5bed51daa6d21093f3a7b488029dd265a9596c3cb877d730c96cf696c17d1fea677b28ba4fdbbc636a2f068d7753ffb480beb9f6b2319adf74fbe271ea5ef3bd94a17aff2623b95cc6b4fd7635a784d6c261dfd8bbf6591593f6537b13ec4654d0f65531cdecf76e2fb99663bd1e75268ecdf653f69e54af93adabbab1d45f6b9642cb7fef3ea3a047db11364687bed66c9efd906392dc7b70ade2b9fecb4f39ed36a22b1e72e263ebdf6d2cd1247cb0ea7d6cf24c099d9d7c46c1a0203c6b2dc732cf727e80d9ed5e68ac17573cda4c6e9ad32cf6b7294243875394a614ff5f4ba4940b59539c8a79fc1a7b91d61ca03467594f8fa0de3713be60324ebc1baa2f9d76ebc8f7d0aed9adb63d9f922631e93723d77a8b9847b344da1c97be8fa521cfe8eeabbc4389104d7bd724f189d8afc537bb7dc7c320d9ad4cdd1c16b946382305c34fa9ffea6a78d8131ec77ce5b23b89c07309eafdbcf3bea78c2e7067a3e9b3cb85bfc2f494e65dfd76b6a3b45f7e0fabca8b80e5967e6a91f8f192ae474326d18ec411f687b334db6b3564ee10ebeb5353cbb0559907a678fc0b332b0ff56cda5db1dde152ead5f2d84eb4eb14536de326b3e9504c78df61de04fef538bc2a8cba9e2f833b2ed9cfe3077b32f94c3e59be6500f2fadcaddfc69513ace9c5bc5f9a96438eef5b9e71ef64fb0812ffe8dbe348efb64d8fedc1cabe8dc77b2c3b88c1469fd328ffb58a23c65100fd23e6f201f35725b036f8a8cfab13a99044f64ff28ada4dddf53c274c73c64024f253d17d9607fff5d39573a59627e7fcff0fd6cfeb3ccb2ef3f2df7ea32ee57f7e37a4837404f4077bd4b6bd53cce2a870df6d5803177b3cd07e97b417a99c83979d8e766d03bcc6cef1be0031af31fbcaed0fb2496c3873d0f7a8fb05720e740d60e767e6bf5f2e5e2fa049d7d935c5fbaebe106fb8ce8ee5a3c61cf0dd25fe82d5ee383f4dda13d49227dea486fc4563eecf1e494e3f541b81c99d99ab39a280f2a87e3d8f6493f90b77ecfc6df27dafa44f173218c03f8035d837d45facd0bd029c8ff8f47807333adb07e27958f63137b9d94e727d297e4fc7c7eedac1fb1d7536be32ec63507f3d17dec1ff15aa9625f94ef46a5b183b30fe81cc011ed19b0186e613df55ece4bf60b5c605db951dfbde22e3dfc867d6160df041f757ed89d2e5cfb45bd8ff9a01fccc1035b0de0b968ec1c0a078d13c6fa0639abf5d1adcced497d1eef05b3aa621f90b09fcca0496b75e1f702bd820dbdc0fa26e3a50538eb1d601f571eeb5513a3219bf50cc57e2f955d1ef80eecb91dd8e1ed9db75d23ef234d007dec58ff4e3707fc2fe8fee2a511f84a8b421f11deebe633c64f93f3b3d0bf22a50f0b5fe3b220f170f1a2ef6112cc7d009e025ab7c23dd8067725f6c341d80fe57510f63581fd45d80e8057f9dd2fb0c7d01f0bfba25e87b7f1cf429f979db0c720db10eedc81f04459d873415fd2be67a4078b20034b9960907119ca2486978e75a6f57a1260607817fb69b56c0bf4fb147b9a9d59ff9d03f67911f7e46db358e099c6ab1158e7429f9708be67f1b18e46e32c75718ec4b9b94df740f28090eec51879024e75fef7c9b9f83924f910ed759407f4fd68d2de81732af0b99b8be4c524ee7726b882bc95d912f393dafeacf279c980737e4e65b649636f8ebf30ce92f95dc021f0cb4abc0f63b8e757c11be61733bf56fe13ed26e08bf9835bcf99efff6071c4de93787e653d87d19eca834c89d21d8ec1fa70e1b389bdc4b691f9892e21bc533ffc37ec2be83aaf48ec0e7816ec9f0736af0f7b0d7392ef176823031f08bdf84a0b80fb1bf517d84b3006e0600bf21a7527e8b1cc3db307fb2faa33aa78f63b239f0bfd2f8b60a3a23d17fd8c7815de6134b39ff67d514e301d38cca16e8ce987f0fbb0a7223b5b70188703b077f3197a75867dbec95e0c5176305b5aeab12df5177db1b0176c609f6daa600b3d25f64d8dbeff9e7706715c32bcf07eb2d23b4ecead679b53d7533c61ff4e4ef703fb013eca6bbdaecfa44c9309eb59bd5cdc4a1b68c5e2b44cceb9e3dafa17d83a023db4bf2795cf2f87f781e3f64bb904f6fbd36a94af5aa0877db5aec43b07e1d85eeda8b61550de86e37e8f83bb0b911e6f18430e78b8517f3619eb38017965f05c8e9cd5ba26f3f6f6e9bdee027be944ed9d1007a3cacb3efab9217d76a4cfefd2e70fe9b3f7a8a877c4ce7753ded7cfc6faf6ac0ffca7d7a7b5719d0eb9cb21c15795ce6fcd48afbcc09603fb51b1b6f43e7c949e147869c6cf8d529f3e716ef7f2928b8fd1309eff0df8c6592d649afe74f2d1b331e088f48454dae1aba1ed521b3aa48fdafca2b75d03d94bfa4ac2d81707fe175f473b860b67a5c54521fe7efc0cce7ceaca75b42af342ab165b872d9ffb35efc7f692f64b3d9ca6fda7c41e66f13aa7b457a28bbd4bebf89c0f72353cefcbfd57f1cc4bf7479261f609691df7ff32adfbc8d76037b8582760dfa83d81ade0ee3c3c4b4b3d1a1dd8cb96bc477d092fc0ff33fbb4c37d85335d715cfb7820be9e73097d6617ec2bdc8ae9d8280f91f3393f6386b4678df1af7ed88f504ec1dfac4ffa671727d8c7cbf6cf43fbe4cbf0bdc5e5321d2761ac09c86cf43945f7bbadb00d9af2d81b9027786e4cc31d3f839c9166487fbeb245cfd02b7e766e46f60c78653b05988c7927c677dececb83cda791e1c2fbfcdca81fa353025a998a677bd0dde87bff3830da56c24edeb7ddc5a48e7e9b62c0838c06776003ec477d37f2eea4def467672beff59bbf4683f7033d877920cf2707d4fb40cb7be2bbdeb6817fdccf964dfc981be0a1fcdb80d96097d08711c88ace73d8cb95f415b2d04fb50238502782bd013c077608f14f00ce271bd89f7a64af2fd37e54374df2bda32413f26e27aa3f58ff79c19e793e36a33444756da794236739c5f7a3d06f49fa06ab6441d89f9adb33412f63514e7cd1fea9bce72ff171efb0bf2a9c55b0c6ee17e27552cfe9e088d7ee957afe12df83eefd5a6fe19513d6c97e17d61bda6ba4be04f1d5aa6aca09f2a84d6209f05fd05b4ff8791db55762bf7f0a3e146e87d29ed430a7baf69fbcbf1ff0dfe6b7575fcfa7f5866407e9d70b7bb69ec17f2792ef428229f0eb04b888e037e2dfd6f9b183f547fa200f62bafd1cf8d2a2e3d3becaf1e7f9f8bbf1b6644d619d44b611ff6f357b0fb8b84f54579711e673fd69d8139cec178b8f9f9cf291ff7d399d75f4cc467cd91f317e0459719c0ede1f5a4b0b64cee701e51c9c9b3f01b63539db6f91ceb1b6626fd15a7d1ec6fda787b74109fb79f870fedf7b24f619cdf770bbef7bfef7b67276019e2a9f0f688b0e37871dd009c39d75c47e1b5e7f0163205e445cbe205c814c64b04a3036e5bc4acb2d1ff9df9743722bfdaf49bd97335aa3f2996c73d0385afada801e499c1bdfbb1aa7e598df8ee19af9e6a273c672594478c2d8582cd6b274fba46774b04ed97fc7f626e6a3e3f425dc6fe2f005f163193ee159d27f176d94612796df1cfed6dd85b91ee25e1af5a9bea99e0f5d4b24969b8bc0601ae332aaf59f5c1b86c551aac11e45e6ee7e22bd7dcf964934598dc42b4df91dce1478f7ffec2c41f6ac1a51fa8ce2c6809f1b7afc750de4c756ce250be12270960b97d740ee45e3b326f24182e7eef8499209119aef99bf97111ea403f4bdee673d8996f3302fd01ed8c0c5c140977b24c807de5b32cec7415f6b65beed361791279a3ec7a2fc137b668a3222fe8c282b14b00a755dd43077a4dc32013ff17e9a429d33cd7362ac419683bc77b3702ff696ded1222e588f2b85ec0dfa6bc66b1b86fdb2441da0eebf28ee9fd8bf4ba11b22fd2235fb1bed69a9857b9fa63fc4de5e6a5dd289cca7dfe7a0a764a863aeed6729e281f5cf50cc17f4fb8adb8f612d7311679a1e50e2de89fd41c49a7cf167443a55c01be6e568e096e943de3fb16f8848239ae71e12f698f7b512f25e6fe9ab25e282d41857ac91f46648a8afeea9c6499267423d72ddbe447b50a8de4f9065ca1e1732ae35bd41946325c9b178ff906bfb7488f23aa851aa90ad62bd725d8dd6a66ebca47d49a801ae1ceb216d9c843dd2d6d396f1abab0f9e3466d27e09b5d533ee19c8b5d573116c8b7cabacd43bb4b69a92678bbf92ec74e538093a4755fb53da83686d4dd5fb09fa4659bb5321fbc69bbd894cfb4ad635624d52633bc022be007a27f2a89667a4768b623efcfe49c53b425d96d838497c23d46dd1c9335a9b2de9fd045e11c6d7d3b5a61e9b72ac24fe88d76cbbbe3e9a4873e13d72054f0a3565b4f7e8ebbaf1126c80843a2dcab12ebbb471f4b680bee689bc07ba1a2e49633e988c97d95ebb7c3099b6b6d57a28b853a5b2f1d96fd384bb9053dd7889e71dcd7debbd66ac87b47192ce3dba3bd3f1f38af62eba76cce4f38feaceba912d6791fa3bab977dab3251f25a70a74265cf90dcee66c23d8452cc9e53df3bd23ea3d349b1bb49badff5f6b60696e4e792edbb384cda67ae39abca77ba2272c7c678e151c5f7187bd92b737d3147281a9fc21c0296732aee098b07a8ce23e939ef115b82c40994e79ae45cf8084d6e7bfb719dfaeb55be4f4dce3acdbfb91cc25ca2cab32fc1c7e3964a5d4ff24106c49f84be34b00dd9775d3ee68ee4b24963fe58be7554efb417201f56d7df4135baff15c2c2e3d815157cc0f702fe66e80bcc9760efc1ce27f1e0bd32e622e56991ef22b1499ac3f0086352fad886f149c0b9e8e72631ed3efa8ff3ee94e656cf0fa35a8fe7ea1d31d7ac655b18df0ef37bba8de3a4be78927c79648c99bd60b94118dbc63c4569ce0ecdc7f4367e01f40be583c1e1ecb15858503f02c681fd41f90c3446729bbf672853b62efa62833b102d0167805b72b7087d29207f7eb56c1a5b0fd78331ec8fc81d08801dde2575d0301e096b747fa1ac69d4185f978b8406c7e712a5d5fa01e451f3426237b5a75d90ef19c21be6b132b8c3bbd7ca18683067c4170cbc4ff2cafb4fd29d1fc53d877cef3cb1e13b92e380f2edf43d05d90b7867b0eff958b1fc0a9ee7c1739a6789f894720c412ee8f2519362e27c2e969341e5daa0b707be0479813d11380d7d9e693e0ec8d92dcb2f66397c4cde5ae33ac05b3bf9af5df1fbe03ee52ee96e5e5007fb72e07925276eafb5a47c620e33d51534de02f43b0ff17685cc12f0dea8b3f5d56fb8b7a4e84f18a3d5952897f9be2af3b1e27287df43eb923b00601f9efc613ec4c10df15ce55840c3cb11d0d60d31cdf8b8d7c66339fd954b7ccc5be3ad31d862353715731ac554e3efc9b6bf666ef15c149f3be9fd3f21361a9bf3df120b8de1a57dcf1867f21aef18af8cc99d78dc301edbbddfd8b7c616d5b247b8cf21ea316e53a4c195c05b616f77055f90dce0eae100f634f04769eff572bb59be84775d50071d261b6637f1dc66050d85faafa7b4a15ae178246f49b11671fef9385f223a598083eafacef3e9267dca6c9914bd1af4ed8dea4d1ea3a4b998cc4e4ad6d12c7eab1a47d49957bd17cf7589c84b162f15645c50434711330b6a50248e21fb3c0ce013cfd229f0198e5bb9ceff11c481b9df6a1b8193c738599e2cc5d56b37a126951803568d750ef8e38677df63394ad1bda071570137415d0955af4247b605e5f7e5f8a8016ca28f340536d371b3fa4da2b97e517d1b8993b27b102c176c9d50af241247568da5b29d33bfabb799c498b591fd447ac07bddc553ca183a5b2a013e955da586cf74dccc7e678c410b3169ee1b8bc24963c059f658c81d8ed23489f526f26f78068ee284c47f4d6574749ea4fd75cead6ea3e8ac7c93f9747ca67a562b9f65d8747b2b3f97352e1739ab28785788bf6692cd415c3b8a8320e69ba87f85beb3515d14c4b24d79383e5f8a8c263d9ef3a6f3ea74a6ee792d2fabe0d4d191ead97bc4c66599f195511f87bdf16272aaf82b79bfc39e62517c91587306795d34d4c50d901fdeda1998cca7d963e5b349b2ba68a28be5e7b2e6a91c614f4f34def4ac91d334b69d494e873d80a27611896127cae930461f5d27896b9bcae9e83c8972fae474171bafdb36994fb707aa67b5725a864d27a7e5e732cae90b9e613136e5ac9c82c68616e2ca19ce4842bc3e2ac3825876a2ad25f43c88ea8d20de6f684b2be64b3a33f1fe22a6f3eaf645f7bcd6ae56c2a9d303aa67ef10f38feebb10bfce7836667559a5f31e8fd327dbd861dd5ce95cc9e3eea6fb1e9f2ff1ac0cf3b62aeeda745eddbeeb9ed7efbb0a4eed9959f16c569bfb83fa303bc7827b56eb689e379075df79ed3dd97ea731f824792ee41cc8b62d8db99beaead8f3c97b3e3c79abf652b77e63fb5b09a356672b60d4d90232eedeef927b20c992eb720ca26b227542a67d4b75975ff57cda9d43693fb82ffb3dd19e70ed17e2138fbd2fe41ccce4ba7751b884fc837928a792df51d5dc94eebf3f6b6bdc713fecdb6ac8627cb406098f970bbe755a2713f663b2c9f1987efcbbb8edc9fda462ae49606f47f66b59c8f39c8da43178de48b817dafc11c9f667b5ffce020d74039ce9686133823166368dd7829d89b5302eadad0b6befe586f65c51972ebd069db666119b2fe2a32cb3effaf8eeadb57955774dbcdd98ddfd11ef0185baed196c6b7fef752c9013585325f63c97d173f6db0164d522a1f77bd15bf99b56967ec41784f505becf3158f4779222f80299167b9ee75c9fc5755533e7b5b0b34aeabda02c38e77add600d5c1f98e2fc4cfcf1ab9b71cefcaa32ee347dbbf07d58f315fdf2423e88dc7f0a746f64dd6fe5f8f35cf79af18f73742bcf39a7f27e45edf8702fd5f7b0f4fcc3ef64b15c43792fb3d526a7e7e7afc4bb505d533c739b201d7625cd26e019eb23dd8263eef735a47b8bf8cd33c99a305627d0bd78d72b38d327f029bf23c57c1166fce2f58d7a5fab7ccdc23e2aef9c25f00bbf7f469f95f7315b4f9e4f66f7a7ddfb5a67c1b752d6a9d7a0a2db047c771724c6713bce499cc08c26c35ca40c3d0f181d4765b170c7cc442ed25846265d7d69b2be9cf784d5842629ac3f2517a3b947925c0ce36c323ca63a5abaaf67a82b576b128fc9a42b55b00a7e7ab33d6671892b7a70086b96eed219d0a370872f1b4d3e1f8797f642d54bea7a780d685280f7c7f47599f63476ba93424c4ea6ae215d670b71af6cf6238f21dd86731a0730b31358dcc5b9d556e077080d757631abdd78762fdecacbd27332194e93730c83f3a7f4b522de9268378677300d64a37427d454363ab6bb793fdd768e203122437a6f1c5b7db0ddbb37d1bb7067d2443fd318563659f8926b753d3f4b6fcc64384d743385f3c764e00573273f6c38435f781c378977843ba7067662f40eac218fb3f8db6d67c33026634af3c63ddff5342ff73d36f0e908f750339d5d9c5573e5ae7acb7bc26b728616e0fd29bfce85c842d0cb811fbb6bbe06039928c438b3ca451a2fbc0de7615cc7ec4cadefe599ad1fafe80353f7824ef28385f1c12cfafa6e3da255f833a6d9d8bddb1ff3458abd9ad7d7ee47ba5c55dc8336df8ff78263bfdf6f3f82b895a10fc0bc1760826d11b9eb6b42bfc17dde6cbe730fcee30dcbbdedaca6e96fa9a7d73026fb633e22f49763ed8622fca5eafc68bfbef4734f087f46ff108bd1dea8d3588c53775f5a3807a4df8916688edfad0ed6b2c37813eecd508e6daeaaa457856887a7c44045fb1eeb390bfcecc35a0b48af0b09ce33f6d108df25fd4dbe8600a7965e586d7575cf9e00e758879adf19917a0b31dc919e28c5c5b81fcaa8f1a67af05473c57a1409fe68ac595fa6f7114558546ba2b8e9c199ba5dd4ad2f9ca32de29fdcf725f0d1bdf0c783526e16ee23195f755f271287c92f449edd0ffbc06b58a717e38c7d787783bd6748df31a44d6bdc2f4ab18aa7826ce7cf584f2d58f719e3af243eb9213dcb0ee3d521b8ef1caf854d7a9e5d647a96e790f744a8c17c2275910722fd9098c4f7ac26ca51a4bde2a3787f99c6ad831839f99c3e869a7e75f38b7c6c30cec9ad278c93896f1732dfb27d176b22188c73e9e9c61168516383847c807d6ae6b83692ef613e1ea367458e07c9136a639d65d20347596b1ceb925762f28bd7b6c7be99ca1e42bc3751741f480f1a7a5f3bce3b727d87b4f7d8ba18cfa06d73f93c45ef84077d08057dd064750613f204faa087a2eb657dcf52f748c4a7bc47f218da7d09f658bb2fa43636af914d6ac1f33af26fdd20be8db539965efff4c86ab81f309f610ce3c3bacfdea0bd1a55c4bbd7048fe1ddfd6dd09750d35b8ae21deb574cc0c610eaf9834de5af01deafd43c0bd06dc30df699c97486f0bdfe4742fc8ad1533cf627e33bb4192abdb5db7fb7e2705d1bcf52d55510f7e4a8b0d3517ff654f97160d3b89ba11d8ec16afb867278593862ff8e961dd4f608fada26e51ff1fc24ae07de56ec2ea6ea9ca1874fd1ab2022b3a4bc30a9360aaf4742f488e28e2ad52fb4e62cd02adecd25b989d87732e0af947d09fa7506fb921b76db0bb726f08a6caf04f63baeadb9167ba4f37e28c02780e3771d7f063a15785955cf46ea5be5215ea89f7273c23bdf9f20c3d65ebf20f715a1f51a587f0691ef80d7f7a34109f0ec0ab48232394b5fcfa0d605a9bb90d8576e15e6ba85b96cac170793e5223d1158083fcabd975e72a47ecb86f2dd4cd1274081bfa88e1d90de33723fa8bc532905e366e867c5ed182a4bcc7a3188cfa6e7443298441b3f7eeee0cf2e403f965271c06189f7ce09ce2f60439e8e627edb2c76cec3b99ea3b592153949207bf613f1ce605d19170de7e3356415725b182b88a9093420d4330d6123319bfc415547539893d57994e30a51f803ff9f30e7d9b1df4fb40e5a3867e097c91f5439eb91b5127fc4f610f373501dd3db7b15f3fe3d028dd177cbd11e92e25985f46eac24f675c4fec6e729959da093dfe33d2dd11eaa37b5fde966fa7eae4af81aac4fcb64f3b4d7f53dd2f4d5137a891e33c0ebed265ba1079ea65752a31cf637f981b16335219436b8d93e487dde845ea765139c4761ceb8eed8dcaa1a41f7f42900ded6f1feac848ecf63fb28d148565c44f6691ed4cbbbe6dd78dd0c55cfe4983f43c643acafb3094d94e55eb9c2bd88741cc67b1c9bf017cc29d6ae13efdf647857e8ab6b00a7a67650d6b963345bd1f576ce80bb583dccccef8a770053e94fecc92cdc41caf45e5057d30856752f9cac7422de7fca422719d718ebbb73155d94afd8530d8d665db782466f8543d7bb3b0bcd44e2a8422fedcc6304f8a8649339b1bee5dd6bdf8ff6e8ce4457655d2ff3ac7ca4ea696e3ec6b538e03cc5f8ff2e32f84a9a48abf5762b6c0ff7822bada77b3ade697c6156c73ee16df49532ff22fa28260fce403746144eb0dbe8797b83b568957e4ba1d7a81267d2f859fabf1b8d17b93b17eecf49ea61ffc23f5f46980bb9d9476d1d9411e8ef1b94105731df64ba1f528417698af8828e40537f51c0940e43a590e52c14f65bada8fc22b1331cd20689314f6abdf5bf96212db2d85661827153527f58f671b335db8b33c9e75b5575eb8eaea79cd56f1f87531a9fadaf9db837f0efb5e978acee1cf15df275135f5f65477ab85e338e885b69fff68678a3b5ec48aee5c75f22671155cff581b27f7570bf52496bf27a58ed3b5adf343abed247d0b9697c73daae5bcf244ede7f9963df5eafdffb6a209d969f3fa375a027766b502abc764ee8d3011eda47e002197f1e0d7688c7c538c6eb8ba7709f993f1f74188f67447c6a30f608e87534683fbdd7486c6f01f6d30a78cd1f2f51eebecf5f6b913ac17917e37a65ff31d8fb3ef73baa7c2fd407f7c74789f8f4869dd2659807fee917e658d7b1515e1cc85d5fe6c7752fc3e2a43edfbdd5315fbd989b9da575043a0068daf649fc3b55462ae409cf1590fbf4327e7c92d7969e0b91136b0233bfa1930fe3b9e25e105a029a69e27859df4bf18d6686233197428c299078c7b27081f5029f58028e688f5ffeb9c1ed85ba828f57a5243a43da11e8cc073a5bef49deffb6fd189dcf57f8dea9bc1b6eaa801307f73d622fb8dd03bfc7fc893c35025d20e06439b6792e841e7669cd7fe19f67493a457a3652277b533d23ddbe0eb4b6cd81f443ee16f8e7549919d399bc6f31eae1bad2ef7f1ce7dd1dcbb7001a3a2da88d7c2075de837adf585795d80881fc66760ccf25a0f90d8ad84f586b7e596077f583f8e8c1035b13c6bbd01e911fc6f1b670aca85d29d88fc40ee47113c986ff1583f742eaafe78783d431c47a00c138ba7a002663f1dca9d77391e44124e45009760eb12979de84a2dfc4fe8bc5af747952cab102fa0cf796d314f1f952f858dc5187ef15af371b8ecb7d9aaf1dbef6b6a28e7a83f6a5d7e24dfc779813c0d6c9fde947ddda54f004b8abab7a3aa4c0b37598ff51a62ff72f942e0a7b35bfe0b984e0702ed4ee65b5c50a743dcba3764eb61fc2b9969eb5fe58d2fd792babd72fe651c8e734feee4cd93338650dd15e4f444f1ac042f6bc21d77f227c754ac79fbc7f913863061c4a35bb22ef6f937bc727ac498489ebecec3491f57d354eae793f5a7f84c9a79046b2c9270d5e98ed91be579cc7a4da2a24ee9b85e6a33dfc48ef9deb711279bfa8ede1932e378c79fd3f57bfc6e560061a0fe33f99f5410c8f94aef0dd541da590a359e4a610bfbb4a6f44f795fa78b93f2038a74461d7d54c12edb7c5704b725e735ea7ba1b0f4adf133c236edf53eb25093eee5c948fafaf85a4833d924baaaf6374361b87ca017d4da5a0b717e51f299f8ffb6cd4bd87927c67411e4fc87fc1b949c8e90d7a7905f4c97361c2f7a81d1ee4fdf01e62d46611703510ded946de39c7dfe17931414ed2250a9bcbf102fa7aeaabfa55e97b2bd1771a35ffe0e5553d68829e07a437584bae0326be7fced22f6c11bc4bd6d87db187db75918e833d29ad8567d3fc6da7df2c3a9dd26edc9feca3ef5497b36e630ecf6e49ffbc7e754b734c732718eba21c6ba31bab096339aab12e30d64939960d632d5563b930d6bb6e2c4b35965b83b1ceaab1da30d6876a2c4bb74617f0e5965563f930d68bad7a6792af5a784f86d181d053ecf9d8ec2a7ace45717d8ac2665d26831ede4989f930c8788487247823b297c8b382485f212edcd898eee5438609f17c9ec93e8fd5d0069969bbaba1fc3cc1a5e2f918ac4ed70318fc380cdd21de1792c73d236ee2e37ec4c7455a1ac4707071ce858b03ff8be10ce8c58d3f7f52c080b8c8c56178d18e4d78241f1fdbed3eabc6b61463dbeeb28078b6e5b15bc8cb5d4f4117933cd8e479f73251d197622f1bc7387d5495f4e15c9e55fb584038843b1ca41f9890874a72fd23efacda05f813de69e422efb0fb3591772ecd02fc09ef38e7c83b9766ec1db7b228c09ff0ce7b417c077e8bbf03b0b911d88611d85c056c2dc0412b82837504072d150eba8dc2acf2726e893a0fbe8be20e3fa37c2f7ea3ae057db92036cd36432f2829775ae8a3189c511a9506d5c99d67e13ba6a775be30a003f4bb8dfa1ef6c02339f98dda0bf5c58571fa5d7076de606f43bc3fd8aed01c46ee4b6bfba09fcf43a65349dfb94193d81a40bf5f638c4d829dc27e9fe35d03b037b6da71b72ab8dc6faf86390f121e97a523cad6489e7725b8f3179fbb8e721dc78abd736c90bc6069fc5501f537d84b60438a771736d525e67a4fb15750bec973eeb9ff9ddaa0cc468aad85d9e3686f81ae88c108670890c16023d44ebefa77fa5b16fc45f37b13ee91af0eb15a810a3c467381536b0dcaf9c4f21e467286d3f942912fac5c2fcf2fd6c1c7ea8689f0053db4d4300ab9c8a9b59be4fba74a1cb29ce5f49a3bd2dd61f57a790f7b5d6d025a5fdf703f841c686d4d165ad3bd62b65621573aa916c0daed4e52eec52be1157a58a7dd2b97d6bf2d813c9a9ec95acfb4f7e270503a7afd2ade775f915828f6da3b5b79afdffc351abcab7fe736fe4afaace9b7a9c8dd97cf4ecabb1f82fc3fc6ce20c25a22fd2907e1f724cea8d73fecee4ed36cac81b78073b95ea6605db0da4b0ee30f207bbf613ed2b73ee871197e8731aef335e360aec988d6ac4e7e37ac1d14d6460ec78ad4cf857183bd16619c6eaafb69bf97406384fe414e3c6db1b7f1e42cd688fd0c7a1686bf5bcb6122cda68e877952bb71ed94750cd60b2dd2af5184cb1ef64f9697503734ec6d26d08358ef35ec3369487b8663d2decce86bb8621c5697f25a7a166a29cab4c3fb8f65a66dc33153e93c611cd2bfe9fabd16fa60c9f8a47521b3f3b3d07f5107ab016d0bb53f65fa0e74f5f5b24be80f25c118d6edcbced34ea5b476fa8d731acc26741ef6bf8ad3f998eecb55724cb83b25c348ebc565e76da16f93165603592ef47092f684d41dbb9ec6851e42327cb44659761a0fef96696135a1f1b02f92bc17619daaab695ce8cb23d37850c7eb0a1a0ffbf524c26c40e3e1bd3b795f841a4737e86b7e572fa663837a5699699ddbb1d334980d64bad03f272e27690d9dab7599d0a32561eccc6b0f7ab7c47505b903b932b55b82fb91311a92630d420c5b7e36c82d136b94847d4862b2488e2f84b1966d9bf461363e3f87ba2947fa95744a39bcf731ad61afec8ff9b4b6087c3cf47e7809e3963baffcbc2231d04da4ce11f73bd3de2e8352a115ef9bfc45f2d8ba07bc0795c3f3d138bc239e1be74bc11a1af5f65af409bc9543184765c1c6ccdadf827fafb81f1caefb5dac57bf1760fa146d3dbdcc626376557dc682df64fb57d803b19eb9b81f73c1e66a1bd40ed0f78455d67b90714f6d27c5fa4dfd16fa5ea74a9f466cef83fac9711882dffc543b460947f09b7cd696714074bf0207f8fd21d53e51e380ff963637d1eb8ab94dfd28fa1e86a97387352915b8e7bfb957d422157f4b8321d0450a18f86fd354ddd94ae8db96522350e6495e074fc913ecfe7c8ade794ee827f61cf3a3c93289e7ae2ae4e117d8038083e923f3111cb0d6dcd0fe405f02b98720f8627cc5da783e88ef29640ecfbf005d65c06fa9b4457338b0c616d6dd4bfabd8e7d939ebe3006aa87e97d8eb512937e37f1e947f45ebcf7823a2f3784fd2cc37e850f36d813561752d893d242dc73c5ef1792ab81fcc0e6217591b0d6e00b5d73caf851dd5f6fc47028da033a7d9a3427d8378bd83cb5853f94c7166b79959f490c4e752e0fc7f0bec7791ce35da4a5cdd87eca217fcee236ca99d4b9293f1f53f420cd4f87bd95efd14b73253cb750ddaf0f6ca5103fbc261ada0b4d793f786d3c328ea7c517ad79a67da7de90ea10a4cca1787ea4ac9ba09f3fb9971dc59f580b80d30ac9bf473a0d729aa3bcf5da0dfb1aebe4edb0ef2dbc4d35611e76deab377713226bc47d0cef0b083cb013fa33a6fab8443c70b94d645c39b2e74c6e4fc89e0abe739acbd679e6757d72d7c35c041dc1f770e103fc5faf830c78a377ed16182f9d6cf7e159ae4fea196ce0bd3ceccd0af3d6c8ddac0bdaf23dacd526c607684e922dbeb30eebae456ae005b92bb0e6e925d64b2fcc018bddb764f790a8bedb4ecfc3c1b3d91d4c589f9777e6f4bfcabb70fcfc46606a903d9c64b817129ed1609e285fa9eebd2c73417c16e8683bb964b8ef26be778edd1f46f8c3fa5141ee6489c316bb1b8ce3e86ae008b295cf1faf0d22f41ac07c90783d55b3b9e51a580ab9caf24513e656d478e17b4ae543867bcb1c66c42fcb6724b54b251a0a69a021d7baa2f9b6cb30e792edd92ed37d67018e30bf1ebebb68eaff08f449f64ac80588cd5f4ede032d1db1b9a91cf713f23fcdfbb0456ae30a315d1a3b6b2f92724995b9b25d219e49f62569bf1b8f44b675ac48dea978572fc025f571c4f39371de94da8021ae1744aee3bd9d20bf4347375d05ad75c89dc150e644f6632dc412936385ca5e949af1aeceb7d08c67eee750db70aa3149ec269f16471a3e6af4b9b43737e75c843aa446ee2beeb2f916ccf0487cf9f9b4b8c287eaeca95aef2d7917eaf5663acba79dd755f8cc703637c381e63e703591dfd8dd95849ed3b1f7f83d0559efa6da1d4c161df83de456bcfedd97d39de743db328a377ee750b237f8bc527d35b11e25bdfff1b67a293039a8934d81be91e51dbbcb189da3a2ad27c06461eecbb94cc89d8a984e0a7eb312e48246c6e299a622d62d7fd6e4e77f8439951b354e192de21986e446d07b1cd4979404536bb55b022cdfb13cc2803f76ab299c21504fbd53fa7d0cf5d7e7b2b152dd15a0fb8479c22d6dde20c913b49cf287b6af37ebdb8038f0fbe5f901c77bc5731b7906f3387bde6b398763ec1a75e0bb5a35e775e63917f0e09dd78fecfd0ddecbe7fd3bb0e6b2d79b7fbe753f8e4de081c81c9d600ec0411bc6fff8742bbdb5d79fecc4fc27715c38a720cdefb0ae3dd3e5f3c0fec8d3398127cf603f7e131aed1702fba45163f6a05e16acd18742727236706e479b54bc2f42e220fcdc4b73c9d3c7e1776a82180fd98380be68ddca14fa0a6b8aa5cdc7ead2a5d688369893d59a4ab02958dc48af0ba86ca67ec6587ef1b9d57dceb52a98eb6c2d18df703f8049ae2fe8ae660e7877ef117fa19f03b801af304fd95a027f2d46971dfd2fd012da9dd1774a701e3f7e4df2bd257df7638e3e56bc47c83f83fcd9f3fcd7461dedbbe08e629ed304c0f20930edb09e6974acde127d036c2e9c376eb752792dc274990e9ec539ad89a2de2f957d61de2e3bbf86efb173219ed7019ed5b07f0cea738cfb78463f91f33899737050c473a27d11e839877e37b5833cf723d804cc2e17e3972f676a5f713cc8be5a5e2fe53d1197ecfd5f6f9b05cec7f37503fd436b69e4be04bb98ac3de237213ef3defab5b317f6a627e81422130fe23d39362fcdb9976b7ae415cfc877ee04df02dd537a5f63a6c0a9f44c4e7bef8ee76793bb16893687f09ca7b3678467a6da7b8722be14b98dea7b86f1fad739ecf7ad386b111a89f28b442762ed6d894ec0b63b46f8a61bf12b927398575eff0a73d7a57ad45d116742fe3bbdcb22e1cdd53c27f1f3aaf115c71da391bc84afadfa39b7aead3321c8087a6f2ae1feb8fcac2d3fab792eafef51847efd36b1e953eba077898d85b64e8ed6aa471ea4f747489f8878ad738bdc61a135e6a3f608e63d0f9a2bb015515e7d224df173648c262ae8ff8ffb1d451f650b65490df4db4ab401498ded50c694e91d0fb1ae3deb7723d5cba7779bc6db1e9c9551bf6bfd9ad7dcbd097563a44786ffc57889d7eb51d2805043ff7c4d0dfde00eae1da9277466b5f543dc719f05fab529ff4b71b5600e7d8ec8066dddea7a986f5a635947129995d3c6408126887ef76aee19ce6fcb06f660d0faa6e2f71ce2f58f927d46622e7ca31ce86f098697d00653c4b986c0fb80bfd504e96659da8f06e6bd11e2739d76b1dada8083780d0019cf8df9b8b68ef6a744dcc5e3a47816b9e077409f9b21d6b483f3d318f8774a7cb53d1b6125fe6cdae72606dfab0d308a679ee04cdeb086abded225b9338c2697cd09f0ecd1d90c97f1b19ef6604f45bfab63cd3499efa27d617435aec49a7eada45e3c03ec05d3ccc5e009ced451fc2b6ad37f29e94479266f2bcedeac0642846ea41e48e5d2f7b87c4c7bc61aa7d75c8cc028f62b52e13ff23bd98bf49e460a18d3fa5ac4e70ceff2ebeb1dc4e749eb7927cd43eb8d4eb620b731b63ec05e7f55905984777ec19f78469468b8716a751b4bcd984bac55ea61eea8ddc31e8970ee7d3a6b782538f70f2acf6718f7dc88c9101a13f26a4fb8261ffb650ed1ae45d9d201fb1c7d139b1e9e9de91d3eb6e70dff08f67b69e99e9bb26ca0711b96170467b74be671578dfcfb12c7750167ed149f74ac97298de7e74b85a02774f779cfffe07cb17ba3bda958ff29de43f205fb96d3bf72e124f6e80d630a0afb40df230aec8ee61acf36806bbdbf91d58449e87115ef794d7af23493608fd5a3a4b87c3f90bc0bdaab90d9b65abb4371ae8bf5e988d6cc53f6af798ec4b1439b500d4fe6b89b3c4e3fadc608e6fab8e8270bf450cbb0ff48e827127359c37a5474bfafa9e541d710acaf9e4c2bd13bd5517c823cf9a6fe22b53f9edc25eb46e3a0fa7c5e8936b6987fd5cb0dedb9cab660ebc7fc1b120326f6c815f1fa25d8eb3bda6722ad6ef0739a6d2ada9a424d9c2afa78484e10e280d088b6866c782f92fb2850beb0de59f3a006d4968ffbfcc5eacb5e575bb70ef3d5dadf53ac6715e218688dd4ee3d30fad4d58b4cb093e17c9c2f818c9c7ec5fade067316b0ced8d7147b2484df89fd2a480e88d0ef6e0df47544b904f60ad649b6e2747f88cf1dd6f352bc4fed16766612eb090acf127d6c4dca0ad8490f3496fb406ba008bdda823363b43601ab3701cf204e17e3ca817c2630e473aa3904daa53cc9622c47e9cc73623582e49a1c6cfea43a609817f521d6843a933adf022ca9f242ce7b976a33c4fb2aa34e79be10faa677994f4ef9c8ffbe9cce7a4ff526d3411afd2ac6c1e59c19453d5b2e3f455a60b243ee77f762d0436ccee3f90adfe24734a7ea3261b527aaac6eaf5a3e0f55b0a97afde5593d28bc8bd0b1b0f738bd6f9dc7dca392721ce31cb2aea2d641ff25420f448e75c43e0fbdec7163915731aeb9f1d789f100f9ee47709f3caeffc23b2dc4c7c3e520cb87ca917ea3c047b6aae767a8bf95f600c575c75a82cd94c79aded8cf8ed4dd2b9700d6b6b64fa9f0dc6edc9f2a652e875fe50f471a0ec7a0f1e0b1dddca1df53a12315cf61cfe913e6e1edd9996d1f7ca66795ef21e911f9cc70730c6ad45d39feafe073d04b36eadf0cf7e1c86a4f3e2db18726c86b363ee74fc55935b0ef92d790ac735575dbcd6032abed7c7d0d784e9f5adc570aca35523c14d979d0976aa6579fc2bdbbd56ee2b99511dbe998d976dae6a2eb80bd13fccd218d12f8727385afdcb0e67eb510c137f0e4eb607f359d8835b7f9183407f118e79fabf0567a92f8692ee182f50a82b16bfe3282abd0ee89ec35eb1f83e7b41dbdb317b57b6fa8ddcfe7e1b55f55bc22f72c4c85d738ff2fb06b2d0967edacf23dea4b4bc087e863077c607dab9d8c87d7f0f35919170c6a465a37d4ca8fae37262f04fae4f478154e885fec567996a053104e3276dc371ee9c7a9e8c5adeafb0b728eed1deb3344d7724d2fd1609c00bec0cea575efc4731ab367cf4e176cd8d54bf10d7d6c70ce713bebb88f376ea306e7338ee7b70da30ba13e969e9f8267a36bcfd897273cebc7c7e33888f79e487be7e517ff2d16e794de05bea1ef0e92fc07dc2745f01be2bb5cb05ebb329f5595fd329c28ae857cb90f2dacda7e1942fd57365ea80fd8e7dbf5018589f37a3c0fba1ac1a3c2eee2f965d1e702df93116c0affc84b020f162d4d5c97fc96de6f85c239de7e44ea9327c495c37153fb45937131e7f702b259718e6b58c1782093806fe6224d86b9e1cdd4dcf0d03727f828fb70260ce373d1790cee1a25d67e23fe156b0fb232d7a8bd446268a975442eb11cda086ce3da7a3ec5b353701782d5df26677584cf071d19f6bbe7741ae6a26bf2d70721dcac07508853ecd7d19d2e5a711a216770451cffcbe9877d7fa2397fbde304e01edad58b27cf5949bfbfc0644a1cde2cbdcff89e0fc07a207edcabef24c4fbbc50b82e131b6ba41addd10ce050d5bae4671dcce97b0df2b0e2f99f4c06ac3da0518c41d15805a9f57c26f90011b88c626a015ca6f13469fcb4585a747c935a90dbd81ccc0ff32c7c8f3944d5647f86ee9e03c8a449de0deaa9b29c82b9f03ded133450d479906a6db8b5f79c5769afe3784ca9256193bb789b611c9fb43eda39db5af5352fa36b65b52fccd6aaa869e154d6c756f7b9185fef89e9c1ccebddf3fb4899f636e98e88b46656cbcc707fe33e3ab7fb6c39958f637ccdc9f532c87afbde42fd5e740f78cc2bb56e9978d784e1c0647cfd1ec7c775362fe761b71987dba03607c284773af4efae33ae5b7bc7c6687cfdbae3e362acdeb9bc1c55b49d746fc703885a7d122756bc97799ff5f641cad8097b1c1bd301bbcba9f80ada4cae41826b1d6e546b25354d32ae95d529eba6ad353eb67eadf1319d2eacf5328dc36c50ef04d7ebf58745fdbb4ed635ebee50198d9fb0eed8b84eede3e254aa0a3e36ba97b5f16a0ad912d66ec9b66e5e4f2ca0c11d3b5b92faba98eb907f8deb86a0161a39eb723858cf4275cd8a681ddfc6c55d224fb7eab1b10deaf67a285ff32af94aee9d2db3da239a3ac092bee235d08cd71cbbe3e6ae414f5f9cc89a05f8b2ded98dd7b18ddac7e5e75cbcee068d572a72eea4bb0313bc3ba0ccd160399e98539b83e7315757797e94733848fc89f56dd2e4f3087865393d4bf13e6ebabf3d9e9bc7ee62287882f85a74fb18d64489fabc987f53bccf2ed43b423f098f5546efdb1bd93841af2bdd7927fc3edf2e8e6b1f0fccf7a6abe5726c543477b785bd2167883eeb0f209dcd78df5380718d777804ba6575304ae7e49a705e7ced1dccdffea063eade6577d330a70dcf590813cc877d804d7271597c34e57eb9903f1ed4c588dccdf376b35a6f1de90d467a0258df200bf6911c8f812a1f8c3dd717f957976bb753e59915dca52f8c43f28ff6c2e7c07715f81970df572516fb9d1f8278f0c605bc399847709c6ee6f3b7ce33d6d0447fba2a8f5e97fb12ad17727dcc84ee25ac9de43395c3cfdccf1bf80d569fc746ad6a4d6bbf3f9238562063762b90972bc49753f73e1be5dc1cf686ac27ecebf17ec45e1b01ede0f8b5a78b4c73046795f00e2119279affb3190d16be57097265ce4e1def19121f38fb37e73b3a6774cfdada1c67f8fe8c756328bd919e6687f12a75ef7238e7abb21e7c51a6179aaf467cb14f6b1257a7b95944efdc722742b7a6f4f86734ff5bf00b325ceaf543846ea88e88d725e96af2c756697551bc888cd5d42fb1e3fb0b7a33760f2b38bfc5f337a3fd998f2da58f3617bf9345ecb0c81e92fc3a939a30ee16f0aa782eaeabe238d0e52ec6f783d6057bdb342db20fab549f6090efe7d03c11693caa9385bbd021bd9f2d9439409327a2c3004e0b7326937d4ee9be7c952c0cef2135186f1717d34d5abddfe123890b8636d125b8fba3a16f51cf38975238cf3243fd55459dd5f1a66a635d6ad1cf0736ecd770e02d87687f6c9b24de1ace476b7abf85cf48feb8e0b925de05f3301f83cf41e5c87de188e4521eaf1e87e78199afabb810be13f20832c3f2976127fc8ee55ba4c6389ccdf0ecad1a77c5a5d04f53ef5734cedd81f31bf6db2a038faa6815fdc2015d203f8a9f599d5793f762f4954b7f8fe531883821bd75cee97bc6f4d25fe4bd9f297dd657ad3b129332a047ff467af6953418c963fc30dc8b384f648083e631dc8e0fdd38ec5e5fc3983efeb5799169eb3a5812f09210df02fe6e6e1c4d8fadab6882f3f7d298ceaf9411e93288d118bdfb24e5ba07771aafe04fcdb87cef63ebf921fc0b79c6a5643d9159675bb25ca1b1a77c5a7f9b17455f3001972cf6758dee0fe3531974ffad3024e9da0ce3e8e4937e4d19f5be1e962bf4be73c4fb5cf7c261222f93b16fd6f5615c349bae57bf97aeebe3ef99ea7a05ded2757dd013ef9a3527ea1205fdf937d2ef357a5eb30fe67a5e01c7557a3ec3383a3dafa58dac7a5e0bcb357afef27c54f544bc8a1674fa5d4fdb57c88474797317dd6e3e6e76dd7e1dceefa0d375ba38a6d36fec6f2ae2f1c473c833ebf5300723cb99fe0e7024ea53f371743249bfaeacba5d0bcb35677a52d7cdebceef8acb647b9d8f7fb39e0ff381b2e979f57be97a3efe9eb19e8fe3cee04c2fe4295db5ee447da2a047ff467abe46d76bf6c25cd72be0b84ad7671847a7ebb5f49155d76b61b9ea4c8ffcbdf05b1565dfafeb6842abf3b5747ea58c489741f7d1fbc6e35e71a6bf09ff7739d3ebf576cc065803bdfeba32b730b21652673b62c337cf34169bb6173c2731cbb93eacd16d345646d960bc165e8b7469baa6acbadf7c9cf43340a3e8acd6e756f78e7b79e1358d9bcafc23dee7cdf07eddc9a9ccf95dbe14788cf43ea16d11660fd6f0afa5ccf3a938d7e985d4f94ccef6412f3c03d8e3bc94a86bcdc630a7e16bf4bd7a0fe2fa51a7637538cd68cf1bef6956386ec687769c743f1df032e1e96be462463d6f8ebfb096b5be1765babc613613e90da3af6993951fef77b6bf459686ebc1bb614976e2557a391617c9d23733d1e718f4bdb8e69c1ff427efc4f54a4c260f92fd26496365949199d6a43b9324ae2de35920e358a9b5dcb17e5fabe2afeeb9b77f824f5f098f67624f46ee7b447557ccf632c1bd711c5f392f3bc7658c51e9d7903137c57c9c6cb4ddbb223745bf2f5addb7cc80e38c367ea6bdbe5c01cf7df07393cf7fb89a1715f785ccf63741b7df86cbe43383a96cba4f6c5f8973b657a40e6b781e5f711a90f172f811d92bac699e6c63f632eb75851fe22ba94716af859d1ca3e1f7ce52cfde0a3f5a5119bbce1813d58d93d9f76fb88e04bf60d12c169fea2b2dde2ba67f067bd1f62a2ff7dac3643b1fe58c616ebed37d2e04f5a09261f10de3495f57c4f463b8d69e33d3e6e33ee08c36ad12eeec3e6d93318ce9d6bbc27e55e33f937fffcb2cb7221bef68fdd219e1b8191ffa71d26df99563bb9bf7532ba31cf4127d778deb7197398e1fe369b5ae5d46f6f60a9e57fb0f849ab6515ce4ef2e37ef11c7d7e8dd980f1fe6f6beafb84b1dc121e9197995ff9edfc1be352f4f334e461968bc0eaddf53b79eacbe7bf371d26df99753abe6f96ee54e7b686083dfeab38fc362e4b327b49cdd671fc3b5a9cf3e36df95f9784ab833ea2cb331cce9f61a7fbd1affe6fe7a1d3e33dae0c6fb99158e9bf17183bffee474171baffb9e550e66f4d59be32ed5864f952f77f1d3c7707c3f3ffdb572f31e3e7a8dde8df9e7d36b8d382b77ed7455f52122780cfa345fe59fcff75643bb77999cefe09f4f182ba34cccb426ad0f33696d59fdf3d9c632b0e94bcbe1e5c3bee7defe09fe79253c66fe79b17e4d46ffbc0af7c6fe79e5bc57fae7b56bc8e89f371f271b6d5fe39fd7ef8bb97f3e01c759fdf359f6fa72053cf7c1cf0dfef9c671b8712c77758d2ccde89fcf84cbccfe79a52cb88f7f5e89f3fbf9e76f90bd77f1cf6bf47afcee5ff53cde805d974fbac717ad6fc56a611fdf681dad2ff7ecc7ee0a0b35b4aeb88bcf60ca642f686a7b45ef5b0a75c46ef30524c1682e4312e0c97e3f3fd358e9b9be402d95f9c5abfc187e13ce08aab96ebfb7afa24933bb42b92e23bb424927e6f7f755f3a6da15d7f2e7157e05f371b2f1c05577fa75b064f03128c7b8cacf90b47719eef627c07317fc5cef7340bdf05e70ecf79fa13b7d0c21d31e259d57ae85f74e77ff55f47137fb03ebe1b62aee3ad0ff5bd66b0de56905fbac4d765a5be0defe0aa33a9c8afb03a9b53275b52d55e334aec91fd0bf9b390621d4efbccd6f91653dfa9c63edbab2df23d0adeb1a9f05ece9c95b613fb8bbede99f904fa0c241ef5afa32b22b847aad99f30a12decd1a9bd0c19f3987de107f59e8f9ba3b05a6e374f5771b626bb92ecf40054bf6bb05ba31eec1e737e41bdcc0e799ef1718ef4776ff840abebbe41da878f47ef708bd8dd31fe68629fe21150cf7f04de8f4b6303fc82152ff7581b597c17693ebe262ef137f5a733f15bdb789cc0e7b853a5f0eab3929c8f163337276257bc57ac04f757d6d49df5afd3d185a4f37a8d319e31ff63bd659ae1f58bfa6e748cf586fe317bcfefb63d00fb67278203137ac3fba728eb1fda1cf073d7251bfb11aa1a9cfa6aeb99c3bf3fada8db2f62e19ad1d5bc6dad025d677b8f444eb20533a0de582b0fef2e9735ab3f60c8690b6188c38ce2cd6878befa3953c7e80ffe439029ca97a0d0b73c5ed0b615f07edc570ebc66a9b832e3d7b83f66a54d1f6dabc8c30af19e4b6a3a557d5dca5cc7e1cd61fe938c43e3adbb638660e7b46cfe08ce3f59bbf4683f783501ff4803e16e0d73da90dba059ae823af590b805dc8cf28d9d3cb41ac5b5a005b8bd63316c69a75c5fabde19aa6a4870fc85aec5b64e319a5f43d8ad7bbe67d9ac35ab167d617a89b5acb39ac4b2af74a23703e29e069ccb106fc5877eeddb8d6644b7059a1f24cdce3e67e6cbbbf5a76582b1efb64d35e58bca6f3c7b1d9793e34888f6aadede12dd485cde1de4f6c0f6cd71cec11d6ecf5bf845ac686bfd39aaca00fada087d36a6e03bd3e321aa1785c62ce38e82cd08f887b150ede801fe1fbddb4bede6be0bc69cc484faf3cad95ce7084f701beb1c7173cf7a0d42f4bf8c33a498376f1ad4edff54097833cc6faffcb11e001f836fcbe2be107e7e9935e821b806fc568cb1f0e5cffadff644d2b619d70ac3b2cc8d9c3700b7c80fab20efcd62f3c00fe3f833e639597dd8cd4d496e9c0435fa59e0e57bb2fd059bcbef0a7b3f4a99c6575b3ddeeb38ff5c1b1475bc41fdb6f5a1362f794b0ce3cd04331370a7a4ace2faf03d6a3deaeee87e7b9e5943fe6af67d25361a75a539a9c917d294ee543394ed4c654c27682f39566af766caf90cf7bde6ba5873c340758c09e6bfbaf95770b74e5f6b51be1092e03b11fbbdfefcc2d5c6303e808ece09cd799e7dc7edbf7ceca3d88fa7494fbdad8cdec8076699fe9d81e446d3fe5be9e7d1dcfcbf01f5cd283b50474df063c7c7cbe758dd6ad7f2f4237d83bb117eb37f04727214f8efba2e2bd3b14b291f6440ff5ddcb97db15ea502f690f37d23f3149c64760663da437fe0ae528c0bbc79aec949e6438f7eae7841ebeac77e141a13b784def83d097523ad39d168a3334e80c17fd5ec731c866f445c4dfd39d05a5187d8a0f4ef1bbc1598cf60c13fb9ad1fc60f729be9678efcb682ff2e7b88d538fefd5a86f6d67d49e08f57a47e8939b920736137b7cd4734a9c7883c511fd385ebf2dc1f8128771a01e0364c45251833eda13b31eede701f482b4a2b457389d87f5fc993e2873ba57c9f2283cd96cbef7780f818e1027be34f28edd5efda13ecb818dd830f2f15e33a7b266bfaef74b384e60dba16da5e8376686e3bc8ce358af5c435aa1b24fe8411cf4d1785bf5245a4ef74786b8917a29770a17d613f51cf6217a39b39e368af37911e07efa9a22dc839d0f72b540fbee46fa254bbda17309fd9f55f70f9e633e70e17d76c665fd9345da0ffa1a0bbd95c37803c83fdfb00f23c3df8af7c40965d318d60ee7809007498f88a0ef0a9db7f37c34ed058fbe5f371ff5fdfe6b497b770fd15e04786367967c2ed1d71fd3a3e43c19cf4749e863a2e8cba38d7f09cf16bfc71bf12c6fb17ece09f11bd25f84f49358e0de635f19f485372a2f36e94742edbf13d87efcefcbe9aca37d9139ce45dd47faec46fd46897854fa5a90567bab5139c1e75266cffc90ef05e7c03372261f0c7bc7d81793479dc3e2785db0b3d91fc8819d6e2edae785c1467bfa1c939e8df844c9de18e034e28fa6f66396be2669bd8f02fdd951f473cad35e5d49bea400cfb5456edc3f325b22a07dc187f57ca0302de23de5390c83e4f9627b9b3c67a0f714f386b64638f72d7767f6920f7d2ff3ff5b2c1ed4cee0db8af48e31f069d1fc373caba37d26c809d247be5169263c1fab2bb837788ec63db43dd69a267369f2dbb167606f1d8f8f5b42efbaa0c7522ed26787ce45cf6d300ee9df9d35172d6a7b99f7d290decb182b9360bed537f9be8033019cf59aa04f494f3cd335de25de72e5f83406b8a92ed166cd1a87b9d3fa13ef831af4e988f59ed6f689b812861b6a4b529b3eaff0a924db3372cc9cdfc535e9af487a98c5ce4383445b4b4d2309712bc5794b8eeb1da683e7847c26ad8f2cf0ef385d87fa773a25c16ff6f189be0b52a780f5c694fd46a13fede5427d63ebb9e84b82731479efad9c43b90eb0fbe9b8585ad6a4acce2b529eafb7c2f9baec929e6c5eada192a7c4fe445f2cc6e71cb9bf1ba18d8fc730b6037645f9436563a1bea436adc6c6a23d11778b29e8f63f9265a586378eca333df3cf2c8670ce54dd238f9ce912cf18717f902676cb6ac43fa1cdb257f32b8563d86feebdae2aff4cbe93d7fc2676a14e37966fc417cdd9473fab1fcd0bb90e8f6302979f9b753f4fd8b38ec59d0e1ee00cce56171ec732f4c91e537caf47a58f35cdcf20fab558cec24cddf795f75d24cfc4fd95719ac59ccbc4b1c83d1fd927aa1847c069727fc29feee3d854f671c4f1084ec9d96af2e0949fd3f93cee47d8e0391ff0bb9b6d3e1ede5673ee9b0ece92720e3aca185d6c8fc24b9f992df18e6adb9f915eab3975de6539a68b447ff985f975e60e8589f50363f62bfaeff3d3f370507a32d5d7624c3c380fe7637e9aa4bcd0749f73e0c349ea3bf767da15d7cbb3280f28f553b4cf7685fbabb2d3e65b85f7be267d4b557d954fafcab88b10a3c2febf2c0e017c6ea30d04b609852912a324348a71c1cfb72ef65c5fef5a42be40244ecb7a5a63bf4fafb786e77bb45fb1f9333c56bc9e02ee68bce7b3e06eac4b520c3890e3c6f15d8c7f95c07e6a036eb2c79cc3f94ea2bcd7c4c1757e53a2fbceac478a908ff07be87faaab7da22afd4f657715f8b57716ee47cd937257a2be4bc5b38a7898c37cb506f3c9fe84683ed732ee2f0a7adb73dff0327792e26b6a1f1ad8a7139bc4b2f7fadca4e703f0de6594ffb9dcab108e92606b9674f952816e0be02a53fb9ffb576771d970203671c5d5f3bd2e278dfaea807edc5f34b78af901490fe275a23f2c842f3d674bc4c1cdfe33615f47f5f6615c4ecbf712d7979cd325e68f7179ae597f64eee467e36b763bca3573bf9269ee5afaf30355ccebd3d2c7a8a37e9dd43ccea4fe980aff149eb9a6e539f787ecd262d6f2f357e4b2ddea03ba676eed82c4d3f13ecb16e81064da90c818291eb7aad23ee84cee81bc0b7da491f7e8596d0236fdb87692637a41bc1c6804647591ea4dba6e12d79af62d69de97c479c618c3b67bb17712e6a1b65d8dd844408770ee60f965c027feac0ee74bd57a08dedae42c171d1373c57a36bc0f671391ae493ffb07a74f7a8cc7ed53fc3e3c3b04670a67b53eba9539ed6b9f0d0ebecf59c694d78afc76d1fb9ce37ea796fa4c163c0f9f0bb02f74bc95e0bb96e6f5faa7fd685082fd73543091fb68d27ac10e58e4dee43d047b09cec43bd8e3cb0c6c9af116ce61b6ffe0c1b9598a1753bdba2c58d2f73c5efde5923d4a5e5fc80f41ce448ec0d5a731d020f6b9cd29f7ebcd8c06d1a6f3c1a6fa9e0e5c55ae7fe86f8ad7c58fde095a16780c5e9a57ba9721efc106f6b112de21d1f2c025bc8719afb7d2b086abde32e2bb0dfb0129e101be5e4d553441e18173ae6b4dce89f0d067f2ca5e4bc1b94ab53760e306774b54b497f0bb38bf70bf40f4e1ed76a01bfc6970367a3e6be3d24bff49c91316393711ff9157cda971544d7e46a0ab31f236f19ff5db53bdbe403a6bae872bd5fe8abf09b177eed30cf03e5db8f64b7ed83d04b9afc887711a48e705053fe75d8dcc7d5b4d2c7aaf82f48f66f6d107fa4cf27086386a6890ea7eccf9c6b851dda046658c3ff575a325baf131d63ca9bb3ba0d7804708cff41b96daefc762096a39cefc6ce84f797e98e6c11ea827d525a816429fd0c4e6770723306e5563e6ae91cd78bfe040f078f93cb5d867a099338d1b54d56b2a97404f3018fae8a781b34697c3e47ec25ee3bda8d31fc6f517609d24cff484b5c7617ff77159307073e9f0be3c8eb6bd0bbcb39991dc31d827ccaddbb0e7e87dc547c63324272bc18e6079429f67354fb4c37164d9b53dfc37f0053ceb15c31cb1f70746eb70bee8e5a6760fbe53da465c3fa7d9391cbe530c3e728e633a6fa3b2b180bef36d0dae823c21fdfa53c74fc18fe8cbb8658decdc9f3aff36d3fc8cd652fddc1cb6cb1f948ebec07e52d8ebd46606f9b0e76716b097818778aeb11ffa31c273d8e94db0b7f15dec0d8c3e39cacf457f4c7260ff3ff6deb339756d4b14fd2ffbeb3e7d9644b0d1a9ba1f6ca20091a36eddea0209238108cb028378d5fffd8d31a672c6cba7ebbe575dbbbc970dd20c638e9c6683e9b9de67ac3e272bf7342e36e6e8ca8bcc189a9d9fd188f59db373a2b5d8353867b077dbbefdde6c58dd30d74363f91bc9cf60eeaa12febe15f6e367aec585558e98b63bb6173b403f26d539901fb51fb9237519672fa11e6ac70054a7b6e78177d9a3de208f0339cc56389f9d72d34956fa7020a176cc8e01614e4b8c6d10d0bfaffd1a93bfa9b47fe482f0b7deb567702a069f2ff8ac4f1f7871f25cc3f384fdf3ae7ee3fa785cd873cb09d50cc4de1dec8c6bcb2bcd77afadfd5ec3475bc80f87dba5d7bf242ed73988d729790131fa7fa4a62f8f9c0ae441ceedfa06d76ef0adc57a073df69d073d93db2cde8dc1bc07b8d5fb445d77b1f07cb2411d2cca5b1cff2ed0869947d6866b631d98db39fe2cff83749377436e25ea2c91777c79e30ece915e99aacbc59c7ddc98a2c72bcfc8d73731f50bcb087cef26f055f83b82036017b51f54cbd414ce8c76782d0c57af0ee30e36c0cc4cfa3e9337f960bc22bdc28f57c6d5e62b4eed6320fe28612ca6e6cb6d26bb3a5c57d348b69d77178ccd817ced696bcf97803e4b0f0f684c179e605b908d11e3a78fe0319d059ccd61b908ae1b70ed04f39ed5da05ed41c397f3096bd740ff3030efe91b75a9a1fce8630ff07ec6616e69060fbdb3fa9e58fd80f95de10ce4c5a888fa6ebf40314d18076d30ed0cfccf58efe2eb5f536c22da1fe8ce11debf2ccc28261717034fb517dd9c53d07540f6c3fe898fc78de39e2ff2f04294ff3eeb8fca2d1fc66fe42f425801afdc2d91a6d9fe310fd80797ba2f769c5643c2fc1cfdb83bce8f2c8f1e60101b2fb3e593b90ae5a02cd365541c9f72fd1df698fc3ac5d797b61f7fbec21fd4c450bd710cce67f0ffb71c326ce4c255c57c66f215cf0e601347eb3a3c9ec0ceb405fcd16f67d836afe3c7c8b05d223e60c7ef10eb637c305dc43d8f6a20d61a6bcbf975ae24ff87bb17180fe90060735680cf3875a09b688de035febdbb06fa895df739f2d18fb337fffac33624e157ec1e1cdee1cc17b0a900fe9936502b3027e01ec6b57cbae28e706b6be3e036b1de2b90e7bcddf65a765e5ec2f84fe4a7a6d0a6a3afb61d1cf5e7b7011fd807f506e4bdf9729572d167ce7c5eef5ce632bf6ede19cde6f3bfdc82e73afa920baa298f03ba7cda5a1f6acbc03c3e6657f973a702f2c0c561cf2ea825d7dfface139e558fc0a3fc7a77763e4ed4ef4e7eb9e52e981795b077e0df17845d4c9e02d5e5c7bf3309dba701187ab9680ede92ada805f00a70ef2817ee8047f773909e870119e2d99c6f361d90ed794bde4f541efd986f74e7cf6768c4ef87f844d436a41cb8f8f30ebc0fb44febb4690e732aac24d878344c7ccf072bb12cd586a5502e20e52284f305bd38dc36b6ee508af2d25ceb0efa7462ede5989cc4e475a73e1b17cb1cbb31251f9ef4505f324331b82bcbfde303e7497eda05d60d06755d5f3e808f17dd91e68de8b34ebd0ee0556b7609da274e4e19d30f8097629c117945a61f39a8172ace7a9077f1eb79d95e13ffb53c20bf2a61ae91a9ce79db4f59063d04ceaae8d71f66be353afb177490c906b381715c16eb958f3373dd0aea1e61bbd799cfc697cbbad006dc9dfeedce61d7beac5a208fc7fc5d05f98d725729c68fdb7b4c8ddcfb033b638d63370d4b6ef5583eb0932b5874fcebcb42177e7abb65fe71e71ae8a472c278d367f68d7b03bad1ce80df09e3498f2efcc07f4fac4fb8fa6169fb2b9cdc5b47b7b57ae3fc678d6bc23c19f9383a258d2755f38fa7b6d42f67bcc4b3aebd3d73d627acdfcb1c73f7c49873f94b65b9540738a347c2be4bcfec7b0db24329cc3877bd8b068f39e6a08f3e36e19e4a40cfcfe01248a5874c79a4005b181fe4f21ee01096e7859e5e427c2f7c67ec55b361cac7c898fcb760c0f0d4a6cfc898600d9610ef1fdf5927e1ab07db885d20eda6cfd0d341a65838a3571b1f62c67cfbce989c5aa8f8702c8c03c3dbb770a0a981bc99a68cbbfcd6b832d81394a70763ac80a78661d07f8666278d049eb7bd12c77b8ee7d9f0e4eed1bd7e63bc493b616d75475e14bf033f294a3b77871e7b567e1a9212f8dbf7f09a8bc10ff109dec37109bcd1a561c9fa067f9844ed7918e91b67394b38cbe1fd4fceb217b59538d0359f3ecbde3141564dbec34fb842f42c9fd149b862c259f2fdea9f9ca51a39cbdee43b74a965e971df3acb7e2b594e3e7396fdc50fe8adde5996ff4c27e0ee52827edeaf497fc463a528bc4adfa175295106ecff883671dc886c9ad4af749e3be5097cab27e9cf77a9f6f63dd8c5c8001a2b67ef9cde63e9f4a208d2daa16161eda3bb8645825d67d74ea860ffc63f9b6cc705746d9fee9a354e10ff7d3680a3a7ba673a7bc24e2bd975aaa093ea59ef4fa3fbf0eb9fd5acf7e3ec309f6de4c24274713d97dde5b30399aeb6cd7c3fa06bdbf6b35f87cc3c8b00deda6711d21733c7d845c708ea8652d63e4a31fb88e8afca6286b1124339fac74be78971fab56d63fbf795cb2e0a8d755aceef879431f8b43d313c61f89e3246acdd136787b8b0f1e17eba9d136b7fb1f3aaa68df1963c06f3b1c49c79ba1d133e23dbff93639c65da3887757166b93e002b794ffd181a90c6593c204e5f09eb39f594b527bf2f65f2af78bb23245bac14bc8ab733989e9049efa97834493b2f314a9b9361166f88b71bc2fa771acec6da09b6be9d79cef1764108d67c0aace3ed0006eb42266f9da4d0db649902eb18193751b2601dafd787619d424bf17abcad0f5773cbf5345897f2f0ed185897bfa39778b0de3f277326f55bd67cf17a778487dc52f65b4aa60dd02d33717b9f07b703e3e4d3a31d7d3e53ff09e9cd91bda7f2307af70f72e763e31fd62de173ca198675047df311bd07e320d5f018189366b99bb076ee1958c4e83e6666bc2461addeb9c7e06b203e129d23a037617e2d8cabb692f4c125c3093dc24398ce548dd5d933d69ca5b3dfd2f4f98cb173ebf3669caeeed3bd32e611f9843dd87ad72de5bbccb16f0967eae85166aa9d9075a679ec846ac6f7997893694784f710d6f13260b4cda377fae8e8f6c4b3dedc1199982657d2e92e3e8e93365732ed05e336fa2dddaef1ceeafb764d3547aca79abc17576fb3b2e64ba6cf84e77cbc38878e1a1bf3d9a6cf65e3640a1cad549bd71f134dc1c3041a8eeaa039eceb243a8ec42963e82d8a8729723d144b4dc693d073c9fb8bf11f70f7f0b8a0c764f1873cfa58e2b9fbc78fe849897a356745d699294772da7f49709da4e17fcad893307fe232797a86ad980ccb14dc8a1bb317969d936196be95d3ae4ca23d8e4bc1c794b1393e7c2ebd4cbccc6983269ef734e5bc53c69e2ccd88bd9a25c3d3edd5c4f3eee929bc2466cc5e98274e94acf3ce69db269e77314d26a6d077297c2efd4c5d2ea71d9c78dedb94f34e197bb237233673d679a7dbcc89e7dd1f3fa72f518fb6b07d9b09c7ada3d73f52eddb641a0fcc11f51b26dbef52444e901d9db1debcf6f82dcd564f5e6fbaad1e5d6fb61cbaa5e915d13103dfa5c9201af73bf74724d9eef6fd04d93130f7ae813fb2d1dd9ef3dfb4bf33decf8c7fc5ec378b47fbf4f67418a4c52402b98619e364c6bed2619069ef66bc3f4c8e65faf4db5438e6887ba5afe1597bd45d4f58fe14e36099643b268c913fe6e59e6b0e9f73c876623ea4a10b971fb0f992c7ca1fef4a82c913365ae218cfd95e89b07d2ad695b496783b299d4e4b796468f2ba53e35ca9f4254d9689f22a68cb249c7fcafb52066d67c5b89ec1b95e3563ae479e38400e9a898f6fa5f3b05a3d078ca78973a7bddffb961cf2625b8973c6da035973e5b1ed95943953e35a1972220f1e6f93e74e79bf9f75be1931adc439e375f02c799653df4ee14fe9f1acd4bda6e9b73eff4d0aaf4ad1bf3116f50ddd3010cb4a9c375bdf0df4c5037dd7e97795a0ef7afd5afd6b8d9c098b4bf9faf7fe61de97d7a3f6bb7a6fd65a32634b996bc8ccfd8a87dd77f4dfccb564c58332d79223ff2b6b0db9f4e0ac751462d611d649b2c6783a07cc872b7fa20ffbd695e82b49d123b1fe6b99b69627f2c0bcb3fa09bd38654d561a7c02f18bb4353d910b96b296e7f4e394f53c1573481927211f2c137f73e9c969f3a6c50832e9386f4e58ca79a6ebcb99bc280da7a4543a8bcf0bcb944b39f5e614dc4bf7d367f3ce9cb96129389bae3f67c13c95b704dfcf971f96297f73ead169fc30d5579e09f3bc3962697c22559fce8279aa6c09be9f374f2c6bcfb9f5ea94b953c6409d38730db973c552d690a15f67f3b82c7f731ade3d992f56b7fab5f7dba66abc86e6bbc2b858834ffa38ec95dd157acce35b86b1617d4cb72f3fb3c7385f20e2a3e1ece1095c0de572e55953a6bf39cf5a627955c0279808cf189e6fc58fe5cbc5cab3a66c1f749e35c5e81d21df609eb57029e7eef99373ac27975f3ac738fd5aaa7e17b5ff42b8f4077950e1f5fd81af3a634d693ea8a05d183ac3306f4bcb210c8ce78b8fa4afed09ff75c6dac45b564d8ce77fce80571e9c48c4fb3ff269678c15134b99d4f3e1798ebc00699c397f9a9f3b0ffda7d464f8d6a167ad23357f27273fcbf07da7d3660c4fc55c993c7246caa3e770997493ee0fcfc78bc7d967d1cbc489d4dc9a7cbc3ccb479e7e16313217f358f29cc5348f3d5bcce6afa97ef33c6751c84317fd4c1e989af792ef2cb27ce9e96711a38b91ce9e0706a53cb96ae49f4f5f43967f3dcf5aca7968438af2def05a52c6a1dc935c3c33dbe79ec12bc8b7cd0bc3266fc84d43135bf67a75ec3d3cdc769a3ce290c7a36b8a955e6f4dfd074f729e5c93d867bfe3774f1f2747bd35ad67d51c7d57ffcf783fd3e79ef17efe7a6baabf696afbefeafb59ef67fbdb33ce2257bd75c61839f4fb8c7d3cef6b77c7fb937a6b775f7f506f9d38c6137ef6c431be536fede2ee1fe8ef29633ce1634f3ca3e7eaad93c7794a5f4fdc53827f3d8307e4abb74e5e7baa7e9e31775edf7a225e65d45b67c8810c7d3c79cf097ef574de90b7de3a1967d3f5ef0c58e7f5a927c23aa3de3a83b766e8dbc9b04ef0a7a7c33a6fbd7522ac33f4ebbc723dc3979ecdb7e3ebad9fd74bfcfaf4533287fce8e9f3e5afb74edc6f96fe9c01efdc3ef464de99516f9d816f39eaad937918f39ffbfa2f45fb495e9cbeb3fd68cff6b8efe82edfeed8bb6bd51dfb7136978bd81ef06eefe484f7aeebe2f014ea1d7d8e7b7f5968984b6b6bf7e3e5b6ddf19bddf3973b477b3979f7786d52ef6d6847fa5dc985c60de0f38881d72d064eb7307cb0bf7ba8e7d571b97887f3ed3dbc1e5a9e6dc3eedf76efc4b98973e3a234054b6d36ae6aca58dfba772b69af39ce2f665f5ebf82d03d0b31bdb5f8756b74a6bb5c16f177e684c62e387dfce5f928f17e90bc3958bdc7b28c7660b7f58ef73c709e3d5876e0c8e0e1def16d44f7943f1675ebd7b4602cca81b717ef0add9da40911982d64380383ea793616ebad8ff019c19901ce38b81fe139369d808ef6f65b6cc118fa96dd0f80ebd8ab9a52dd9fa3787cde018c771de06762f5b455c13e86df037376c646047ff0b9d51ceb42f04e69b73f76e49e8ad8f12631f7651ce3d69b3a2fe3a52d33f80ed0656c3f6d1f4c9d3ea65d76f7083ed3427c94aace5de5313809eba41a9b31cbf3883d977cef3e92cf94cfdc6f2fbadf7bbefdcea2fb1de7de6f21bae67adefd1663dfcdb9df7e74bf96d3bf9df52bc975ef91778714f56607be5330f04e8257fbde13bc73a3887780d97f3fc0d6a2bb82a509bbebd8bd534af7ddc984b4efdd3ff2cafe76ee733a614f9517e07f486bde9d2e05fadbbdb72a3806dd2f7376f60efc6c07fc1e644bb417b22b532731f7a1c57c47f70aea9cbf577ecc3c6250a6f8740be7ae825c63c0b39d5a50ae74167163797a818af3b1bb1fc273035d36ceebc5fb17d8f15a18460aded58e7c3dbea73ee1884d0f06bc7f461ee9de05873e2dbc0f658e77d2387818588b774758ad145d632dbf0e03b4466b0acaf8eff7c4a1b54cd8fcb6dee3c039225ffcf0921639626bfee78fb96a3e3c384f7aa939b41e0c73f61ff6ada5b7c8551be15b4b7abf7fdf5af2f5cff5ada59faf86c0b7162335d7dab796bcfd5f7d63d7efb96200fe734dee95cd72dcc7744fddd7e6b97be592e96a97ff0e61e0b5d6da7abbae0f8dc212e441e85ea41789dd1f43eb13abdefd5a69f7b6b03189768b40bb9cbc48be9b473e2c7df79bbd6b52b37e5bc68d31c6bb9b980eb93cce90a75d3b2e4d8eac75f17dbf2e3ab0e3ceceb340fbc7cef81637de436db5e1cc55777ef7bec056dcfc6f88371ceae1f9d6fb06b6e04c0fbf13773f7c9f743ebc8fb0c2e432bbcb6fdb3df6f825e091dac4bb7fea8cd757cbbaba407897cedd2a3b17c673d513eaed70460f750e7c743132e3f6e8ad39ef3de2a867abb69e1db3c64359530f20e3751e6c84329f7caf16de97ce0b1963d01d7609fbba2e17b2bec4feb160dfacdcfbd5cf20abdb5aa756bf759d9f6aa9d849381307cf0377c3dbf774e59af761df37a5c7e29416d98bff4eda71be7772dc5166c9bbf641daf9ee9ac5339dbc6b0ead86f6e0dd0119c683c9e50bef9d84e7caa02394d3dff7ee4a5c132f31b8cd84eed74e79e77e0ec200ef3712c026e9019dede91ef5785a8bdedd96703e09f794b9f753115c24bcb33796c6a2f7b7b9778ac59ef1dbf7ee213bb68fcb43fd843d1b9c9feeae7e4e831d831bc885e66c1f07c74c78a4bcebde2b9b1fa6d1fbc2c6f9e113bd13cf7fef66fcdd780e4ec7dd8fe77de7972572dc193b674b777f07cfc680f5a806e894c00b66487f47ba330b7821c88e7bafd6d8cb73c5140bd3823c918d9efe06bc8564d6b6f7a81bceef1493061e9e122fd73655decb3fdd6949fcd45b6bd5cfab7890d5b3309ccec0a78fa097d2ddb6c89f605f781722ca7ac0b3e15d6ece8eb046a377c0f5c33aab37ba6f50ac29f7f6d8f91d7590e9f923488760c335f08ee2a4fbe06c9aaa07ef7c3bc68c61fd98dc76c6cb2bb7ede763e476e25a5365b6d59b4c4d6937e5bb3591ef8da3721bec7fe089e673721b6c778471a6dc66f2f38f64b6bbbebc32dbd315848c71a272dbdb5786dc26587ab08dca6ef76c72cbeea4b9e365b78ba74fc8eec4773265f7e3ed26d52e81fb3f0332c05b7b5466b37b1593e575e4dd1cf23af2ce53f2da476319f23a344fb2bc8ef2718fae72cbeab875fdacac8edb4f5e59fde4bbb1b23a1d9e59b23a153edf91d589f7d8d2e7e9ba987dbe7f2ea791b6aa28a35df964cb69e9deafd50bd2e467647564bd3f26ab25e00d6f24a71dfdc691d5d204f6b9ab1712e4b569df379d7d07ae77977881ee343fc68c53fd3199ed8c975766dbcfc7d9da69eb4d95db7caf7a737e22321bef4e5fb5864fcaec3bbbe73ad3d676d6fc6772db5d636e5bdbbec33a24b763c68991dbeede32e4f694eb3a3f5199ed9e4b7e999d306fbccc76f1f409999df84eb6bd3d2dc913cde8fbe4369deb4e2a046582bb87187bdbc68554f91d7e3f8ffc0ebff394fcf6d15b96fc0ece93626f135ca4879c4067b96578dcda7e5886c7ec29b70c7feedd78199e0ad32c199e0a9f6fd9db364ec7dadbee776199328b3be71fb0b94707b9a6edfb636673dbf2ebc7edeec87a7fceeedecb876149aeed99dd8dfc18f690c3f6de2f17edcf64fbc1e5239e8e658f1dc4ed91a61c4ca4135b6eb779ca53d1f78e6cdbc3d837b92914d7361f74653cd0a86c619f520f4e72b3613d617bd31e10af49362ef2add52f835d7ee2d0f16e88763747b6626d0ab4bc3f678d912dc763de6961cc45b8cac598357fa3f7660c4c3cf986389f181bf1f868ec3e536578e419c4d5cf6c982ae78cb9689c14191bdd63b20c67cf36eb3e7a237d324547b0717aec8d4fb94a59b24097acfe442c4bbbfd6b54478ac7dba8ed6d3f9fe62f77f189649c5f4679efec82b60fa32b8787c7f0fc45de77d36477605d767d8570940b48238da7601294738e4c11cd8c75fdb0dc8eeec7d12562e49e99136651d84f52f0f67b32fbb97733f1da67d71d044ba6fe5a4fdb7cb426e75cd7cdd1776dd7bbbcab9725b071c87eb5f50547def56a4b4eaaf53c99f7fc5d9ee1f3ff77d8dfcfed214827982f827934e534df9f5c6be8feb85dc4ffb7c0dc495e538efb1f93dd0ae657cd1bd6267fccdbdd4bac0c4f5973ba0c777de7e4ef8dc8f08471b263df09efc5c9725cfb9fc9713f6cf2caf29bd41cde97bbb690b8dfd41878ec7309323d0c63e59c63ce2cb91ebfe764d9ee3dff8c7cf7e3fef3321e602c96fa73292dce13c0eba7fdeb013ccb2fe33dfafb9e9c8fbe9f16178fac3145de67c3278fbc4f58df0fc7c6e3f79557ee67c030afec8f874f7ef9fffcfb397400caa18a91ffc1dc2a5bf6249cf70fc8ff9b54338cde4162b2b3f6be97e6a2e5faaf6bef46af30d37f44fe7bb8f06ff2c13fb18f883fad9c66bb5bbd476fbf7ca4c5ffc84ffa63b29f78b4f58cffbdfc994be6dbebfc23791f33461edffb3a8fcdfee772de81456e7b5dda290fb9d91362f798e1735fe7b2d733647bc238e93ef1f2677e9f7bf9497bddc6e56fd8eba07b17e45a6a7e898babcfc7c91d1cca2fc3191d7d4f7e07df4df7b1af73d9e9e9b0c823b363d6f4e3bef5f5376df41478e595d351783ce5572f7ffef7cb675b3614a3e7fa13b6796fa214978fa56dd78a45a930da7976ad54e81da6fccfd8e6be38df0fdbe64fed212897614df257b25c166ffd79cf902769f2ceb8ca87ca8fc965d8eb619d5f2ed3faf3c965b6ce3f93cbd131b2e572cc3bff1eb9ecc022af5cb6409fdbc9f39910bbc754b91c79e69b72397e9c14b91cdd63b25c66cf3e25976d5c7e5e2edfa589769027c33459e4e2eaf372d9c1a1fc7299d1d1f7e472f0dd34b91c58d7337239008b3c7239664d3f2c97a37bc92b9753e095572e47e1915f2e3ff7ee8fc9655b36c4c8939fb0997bcd3ae8b90a9369bbb7f2b230335c5bf321967a85e50fc9653af77f8bbdfcd41e82707ca8f31ed6b23e5264f343c6dcbdb49cd905c89c42cf508ad2cff9cc8bb31dece3a1e497d1ee5ef2f9ccbd35ff99cf3c7e9c6c9f79c27bff1e99ed874d7eb95d18de7bbb772171bfa93ef3d8e7bee9334f1e2b4586c7ef39598e7bcf3fe533f7e1fe77ecec667bd79b2cd3645800af9ff799fbf1ec099fb94b7fdff49947de4ff39947d6f88c8c8fc02797cf3c7e7d3fec338fdf576e9f793a0cf3cafc78f8e497fbcfbfff73b29fc99e043eff1376b974681ca4836dd3ee7a7b69b2bcb9b2f3d033a49da6fd8ccfdcc5857f8b6dfed43e42359fcb45fb8afd69526b879b43bef708e72003de576fb1f5bece987172d0c9579741ae2bcdd197eaf43c1abbba02e09912aeafd69443197b5e6c95e2c85a1770bd36de1c0c9a1bc608d51f36ac357c27c7d4a0d9b9e9b72edbc3b5671989efa6e4b6bbef476bc8e3de9fc6e947669ef7227b76f80fc2bff6c664e69fe908de99e5d611905e47fbde440de908de5965d496c73d17af23f8f739ce37465aed77ec5e53eacb7df8fc846ee0aded79dd00613b2c810ef62d9cb565b69606c7be2f2fbdbbcb1ecbcbd34fae1ff7c1f55bf5e7e1f743b2dc4c5f6348862fbec1af9ed02132e7ff999a740fbfbfa14324acf1591d221e3e4fd4a63ffd7e5ed805f0399c371f73ee411ff35eae4d8b09f0fe811c7ad066e64bab5775ead691572abe1c7a59eb3dde7ea87edd3967fb7c0bf77d361ffff7ec239c2b0ab67b3a9fdb49a5deee2d4dc7df831c3b02fdfd643efd552eccaca8bd7d0658e0730617bb0f3d573dbbbbde3f8b0378733ea343b87bfb6fa869f7cd955f67a0f37e1792f61a92af66e67cdfab6bf7cee899fcfa8477e2eb8b9c3d3d5527e7e1fa7774865de3d06b8a3971d3f60784fc64697a810ffe4fd4c8f969e75b79f68139d37482d86773d6b967cc93946b9f3a5f566d635c3f2a696c3cb1aeac3ab930ecbf99731fc499907fe65b6bf86fa877f7784d1c3cffdc7f80e3bff1bd5dddeeedb27cf46b9edd0d7ff3c07b8a3f9573cf60f8ef91ef526d5a90ec5a3f5b1e7bf27d37b550dec7caf7450fe6b89ba273de004fb96578bd9ead685fc8bed7bf92f5ea74fbab7ebf47a40f5709c62af6ce66678e3adc7eb3f0f7eb757b4697e3d76350cfcf3fe8bbeccfc7c0fe98462ef84c34ea75ecfb8ef5daa6cff990bcb47ba2cf9d9a72df5e83f284ee28717aba86d791d473ba5f189d95e2bbb95cec5fa87762d00767f7828f1b73ff37f5b9053e81b413e223296b09bd7774fad7224d2ad89bd6eea7dd3be199af1e611983779ecb363c46e1feb4bcd4924fc9f08b3c7f976aef8595bb67be80f73ead8b17e2e5008f2ff5713ec167403f6587b75cd483017f57b6ec5fe1d5bb03661a33fe9b61f7c70df4c1075cfc524086b27e92c02bb197309c4d1ffb69db63011d18cbc3e58cf7a4003d5f95820c7c907b01fd4e5b1f7b706623432a807e389902bdde2e787f04d8486718c302fc7dc807c1025dc27ef7aea9cde943ae6ed973550ee7007bc908efcbbea389e6b82d915e8f23bfccbf4ac5bcba24d06fcbef7f72e1ebebe7caeea872cf6c6eebbac79ed71f78413071fbbe7fd37eba383d36d0cfeefcbe49c47fdef66396d11feef834bfd10738f7fd5eb05e03d6bb3729871e601e597731dc0777fbba3ace1e208b0f9bb1af1ff3c1be2fe0f8677b717a2f3bfcdcf327f3877541e05cd90ff80e3c37d477fa2dda77da07db756154767b16a31c033a777aad2a05cd225d3b7897c0837a0dd7bc1edd4a61867ac897bf477b7fd7a3fb14dc35e7e4ede82b7570d51d57f7cb19debf9672e25ad83b26c2505ef8d7552f05dfc19e21f217c884543d7f399735f9504f86c3f8ad40fb1cfeaffff5d73ffe3aaf3e37c7cb5ffffa7ffe1aecb77ffdeb2ff8a8b73a6cf0b7fffaaf7ffcb5d52fda75fd4fe574f8b5bb7dea978d625cd7bf6e9bb571dafe0bffb57fff0fed72307e29a669fffd4ff81507d58f1f27fc57dd5c56ba411f1dd9f0bee7fef197a93fe0b342a550f9c75f87930abf970a1cfdfa9f179d1e2f708597ffe0b9ffe08509fff2af62e55f25ee9fc55291afbc0a85a20c43e8e67faafae75ffffa5819e60646b468b2dae6ebaf7fbd94b942e91f7f8947580acff325fea50cbb34f4e31efefec75f124d5884b160f6a9aefef52f98ba69ffbbf8cfff3caf548e7e1fa9381afc32f62df7ddd8b3d59738e105ff3c297b981abe7983a5e31ac61b05e68175960af03f78bd67e227c592f05a787979290098a5ac47ed8dc2a3d5fc8fc2daafc7abb9819dfc6fee1ff0dffff92f3c536df349a04168fdf5ebf374bafc02405f8d4ddc790264c5c3f9f47919ac2e5a003fd897b593c23e9dac3eb79b0bfb7d0463b2dfa4d54581d78e57c300b05d56c6c63d21fa6bb45999a7237bb6796ae8c6c6749e66d3ba7fd63667f7f7c9c6bc849ec68f426f48b429426db6f63464a6b5eab092cbe7151617050e2d10860cc36c7bfa27fc46dfce369fa64ebbe1ffc917897ece34b3435c190bb0019b455630acbabaace0c9ae75fa5dd5df7e8b0d94fbf72ff976da76b6f0a3bf6dc5ea7b0d6470d9e6c95b69f776ef8fdf6bc85b15d4199a1afa1f066d0e78c7a16cac9bb78e583de1cf76d2c4bc4cd007e6c32df217b4ef54785eac37d0fe3f811d27ade88e991ee805ef73bcd711f512b1265e2590e9f46cb5ed3e23eafb575a67cbf852c7ef202ff65b9425626bf680b1f05ec587d894b12ff7565e683b79f1ce89c0bbc426ac6d01b6e8bc715d16a65b15f59f2a3e3ff5d6382ed19ae7738117519e8fdfbf96a42b02df1ebfef100662533888a05ff8d70dfa07876ba27555095e27d0236e7dbdf2a516d562f7e0ecb50c3a9402fcd59e6f217d4dea466d342d4f4663ceeaeede5cb8cde665ba9b466cb50d65313be3fd3b62136b2d1b37056ce16581dd1502f2b8086bb2e0f323ca77b0b7b9b5f50eb2fdbd88fe45b245aaef8f7541e6d07f2b0ff14cdf5196836e66a03cc677f835d9b86178bc833c893cfb453a84fea60f67d2763c9374b1de7e9f72c6a4fb46632fc6b39138e16753c0a5f7a9d17e9f18a3f6089e9df0e276c809fd51dd988ea7427f6abd0f46fa3b7cbfdf8e39a33faadeb6a02b7328a745d0c315dc7b51daae8be27635e741f730607e764653cc3f8b9c03e8a8f61e40af3240673cace612c2e82a17679a0ce72e17c8e6677182d63be8383ce070ef4cfa093ec7f60078823eb406e9c8008304f894dcf31a1de80cae803377754e7a11e80692097398f2bc81b0241d09fd0f62f34c6723cf7ba63a6fbfc9051e70ec86f77e5c5c3c6ad974689577008317b1d1e6d7d86f7e4e71da32de3584cf756fa70ed0ee765810aea0cba21e0036ddfb03d72e8fd9f7c3e6c5d8cc65d0cb7903f682f76d98eb8242773879eff0a017629d20cc51286d3740ff88cba0a76fc99f65f79717abcab15fe43a84e735916f8fdf5fbdbba04edb36e8b7edc745a812df78e363dedf76f5926f0c25760ce7998fe1e9aa166678dfc8917413e02fe847c2589558746044fae795f5c847db1bf659ece179bf800e83f7a3bafb899dc7f96e5e469f0c9d79775ed600d62fde3984e7685cb13e91ce7967b2bd347d9fe9fc5e5db4edef4f5b6921bd3298bcfbe14c3631d848f69c3dd4c1b8befe8eb9a7f003383231f3c2e082fc027d315dd0199582fb1cd2ac33b7a7c3ebefec9e82db59a86e4f5f80f335677fc8ab01c7d066035aa874bac7280cf0991cf077e1eedd6d70dab23b156260b6f3be4bd833ece56de77d4e737f015fbaa98b61ec5ce80b043e7961771493efed66ef39c7f8ee3ef00e8687472fc08b5bcefa3d1b4e6cb2fb25aa47ae53ddbf7fca0be300670be7226ddd31172c4e83bd3da906c91907ec72b1e5fac8b758cbbbdc6dcb4b8f962c9796483f7fc33a55bd5f33761e2da12dfeeef9e55bbe1810bc8f710a91c52c80aef7af006382573f7b6ef21b243eb3cd094f7beffd43e380fe1f0f867efed05660ae1bf6c62c9daabab8f33d13de773ebab56353d80f157d3a3968b5f847b44afc7814c697eae9abaa975dde1bc1a76acffd6e0df24329348eb066a4d700cdc5e1b89bab5c0bd34d980f3c7b16c0eb915672ae3b244f5c7e944a2b513807e948af804e4a77a468eb05c8d326ccd1da6fc14ec79c2ea02b0d7d1a4ce6176616facec4a6a6614eb78c77141d1a26837d709d14a7acf218af349660b3a7f1a9004c17ee5ac2fc0f63bca6fbfd3896e7daf766d978e2f15576ee08b731f6ea1919debd98ee383eda5bb23b6876a9e3e0bd6d87d562eb977d8c2f54a547ef316b0ca722c138e3fd0bc161fcd43a8067aa963c575186e01d64a0e778b200f0a6a45641df681a47a437dbaeb0cfdbc3359bc79fed7bcac1ae18c6edc55ec3d2595fc48704bc15efd832d693c83a8fcbc59bbddf98fd716209632d439707c9401bc30bd3bf80c7340d0e7ddb4b87a7b446c6a635bcd8fe6ef8def685a59f9305368ab15cc4edad57edd7b605a92e46f666fb7d818f978abdf9ec1291698b1eafb4de2da441e01bc97bf49de10fef11ce17e8d1c35fcf1f85772cec14def69559bef5fb64af71555a78d7779bf41017776c9f9958539df3fc71df937e5437f77fe2afe97e27df738edfa9c4f1fc737ea732c70b659e7fdaeff4f2137e27b6dceffb9dca8552a550e62b79fc4ef646f3f89dbc47ffd4ef94ec730a3ef43fbea77fbfefc9472e9edf69506dd4275c6f3a9fbd37406e73ebf9edefeaeea675c75ce77f7c52ffe393fa1f9fd4ff453e29d421303e7e203a0df8a4c68d516f42ebac7480768186ef4027bc39d89e2a003b4dae956c1bf50e76c148031d1fed34413cca6779a1ded6c53627eaef6e1c71306edbb1491ee8533ee39d86b6ec37711d608b9d97f3db05ec1bd033a6823416b7dd873b07b3f926e585ad4b6e67a03f826e610daa82fd9dfbac6d87b78d758dd31ddf1aac19746fe00b2de3264f389de9ac02fae274e053a0db2e05f1d07b2896a0abf3fb6539e650a7b94ae332c6f6aecb22d089fef605fb27bd65d064bd52801e4cd81bd81d06d2d2499ecf70dfdc067440f89c03fec0750b382ee017ac49de713a8ed52d06fc81ba58adfc6ddbe6158c83af90a7b44665a58930251ded6b85398e0b8cfb1a37dc8b526cef608edfb0e6df40bf8672dc5ff06ed0eeae8e31faf3da5efbea28e983ddedcb19d7dd43cb192bbcdeb3063a3ec638cf4a6ba88bad9eb57c703a8cf3e5ea7387f343dc952adda23d06e0039c05ecbfb1471c61392570fecdc643817713fc5dba6f2dec33b0019705bc2f56827dbb9fc119197bc4a1a8cd273a70b3cf05ec60dd9b97f9071b98cb64c17a3bce73def30d0be8684739b2cebc07cfdf15cc93461e318475e5f1d105d7e5fba9804d5a005981b1f207ecc93706c5be810724be6bc3eaedefb03f02607f73601979be2a007f5d7e633d9463a3278d4b3f8dd9157343d4d6ec8a762ed8f2a6cd337d3e4490b5add197fd39c8a436e6446e577046206f288e0eb8f150aba5edb47907db09f86ced6ee71e2b7fa3dd0a3f8f550d70d8ae691ae8b61c6ebdd339a9cd0ad036c9aee312f3669e85058cbd6477197bbc248027dbbb0f464e7e34dd750ef47e966b3e1cb77d31e2cee557956e617b8f81a30dfb106ecfef781733a3af028c158bb7413c77ec698469778ef17d9229943f05bcf8bc39101d98713460f34d848b8f06c86f04b0bea5d09eeb6f49c791f0d840d7c09377c077bcfc86713acedbe3808e07f8511c86e9f0bc3ebef36adef504e028dc98cff0bd0070e75136c0b9bd837e49f573c0cf2ecae3f6057a19e1718e719127b2fdd51260ed3c9770b60e3e226e24d2741c9f8be18759ef2985992993bee0cb3bdca5aedbe5d7ce190670dfb5f37b65cccbdb54739cab0d0f07c6624bd6d6ad19ca23e7bcf581e1c0f4a9b3483c63f437028d9d287f67579eace8ce710d73a9e05cece773c0c1c6cb2fa5c56497ed1b03fa393f506e0df6e7c9842b3d3526f02867dfe96799c1d7d3f12b91de49e6811e7043d8804d9545db36ed25e1418397b177aa0fe7403fa3f80eeb1b877979533da4ebe8a8d3821e6bc0b99858ef0db82091be5c7d071ea43eba7ae9ead7c7fe50e6b9fede0cdcaf2c7d3a8d934bc76887f5e809ec29cc37ede7813640c6b577ab3cb4d160f0cbc003edff93709837cc752e18f4408629dbe1fc6efeff120e8b774d459d27076f98361bbc529866c895fa4ff3843fe401e4f3d57df0759ff7c97fd7365b1f6778df3dea5568bf08e2ee0d6d10b04dde604d68ffa27f87eada0471cf834e38dca28d097608e64380ad87f57cef98236ba05c94ab181f7d3fa08dbf8633011bffb25a0cfd36576e38c5e151e83392ffb6ddf277f59bf60dc22f840fb1388aba2ee8d271e75359d544ff5cbe3e43809f132956af8b8df7edca357f3e00da79521c6c5a230df431c3af272f616ca627b3fc7fdbe64fc4271883f279e3e0d19d0bbcda42bc49b6adba45f67e1c3ce03b5a5fe0bb909ec5646fcfd12fd0a675c6039c60fecc9f8e4fec32f26177a62f1e811e7d168f28f0a5d752a5c4975fbf1796602ee5f4a8c4ab1b9528385109fea522149f8c4ab030c4b7a312e572e1a5f45afcbf262a118e42e473a0bb7fa7052742d3d8818aff9d3ec5ffc91fc4f8df4e76f5f684affd4f34c347846e14836ded8769fcd7eef775f369fd47f19fc57ff2ff340dfdf0cf837efc6726f127bde5b0841741e05fdd1865f125c40c78e13ff8c27f14b80957f9175ffe57a9fc7464b21217992c70a5a77880bdca0426c0974ab16ca0f8ca551c82ad087cb9ccc386226ca0fcfa522908afa5b2f328174ffefed1ca42b9fcca15cbaf3f910cbf4bc985dffd4f2afc7f1701275358202f5e139be7913a9fe1dd4a05db5fbe055be4f772b135ed7fbf5647d47ddfdcbf41ffd0408fbc2ce7676d5315b096ed21cfb4dec4fbccf197bbefa8d88fa070ff5a16ec1cb32aafad0e8d52b7286b8a2e60bee64e1ebbef17960bf14b39327bcf7b563b29faedb2c6fcd4c53bbfb6e3455d5e30d0d7305bb44d78167ec7fa5c81bd037fcb071975cb47e0777e54c4fabac06705276f6eb69fdabe20d4933faa6fa7a5b5df8e0d09e34e189bb273eb58bdd9ba383a51bf01e6773aa32db32eb61f62ebb65d397bc05e6d9660bab133cadb6a801e58b6eb9b34a3db1a9e37561b6d1af2b7add017b53341e76aff26bfd48e13c82e980b07b1c97fc92d58e37879100f82be425db52a0a8305fa0e665f32d80ba0ab018ca657f45f600c4a7908319ff146f730fa5a1667178a4d15872738074daaee5fe4e3ec6aebe4f099f1ba9a2f4fe21cde6d0a3b95faad0d4187d6b0be146c895951accf2cb013ad4eb5fd7bca62301813baab73c3c23c51b00f8ba033eff1793b1e0cf8e7cd8db166789e5317edabd8c2751842ffc8fe853129262863ec610ef6ca84a738cdbab0c4b1704dc541513dc333585b4afef06e53867798feda694e4db56abc8a478a5b3fe89c8a43bd4ff8f77e1dcc2e6ab7250af218d657e8f1540f5ebd9f075307b658bf3f1ac2fee06c09c60359bf7f0de6258a2f2d0b537339e1af54837be869f02e4fdfb5668f55f5fec0df57148fd7f03d73b0b808dda624acab656ecd53bc17ec8cfb6130c77adfc6635254af53eaef36b3d4d6de946bfca17b1c7d4d092fcac76eeb4d90abe51dd68e77eace1af7e6aac6bf7e8cefc7c1dcdbbbd89c9e70ef2e6c0fbeeffc38d51a1eda7afb0a67aba31d158071b50c343a3266f8ec8233d51defc3dd77a383b85b6c1b6a6b66c1df57b46ff0fdb62e738309cc512ddb67af0addd65ed8100ca72fe2e4cdc4d85d5fafdfbacdf2d7642eece15d03fffe985cfc735cd819deaf9dc505f9c3763506f88f350e70bef2d112cf007bf45173f2146997f0f304b69e13233cf70bc6a1d3ac5c29de5903bc6f96ce18afe934f75bcc39d88c8debbc60a86d7d795af397f302ce13ffee4c2e1cd68c2ee7c3eb72f10eb02aef8705edbc6e0e4f6b6b7f55d0a701f83b39087bc01faca5c53ae71ae0a02957b5afce22002b1df61181d560c21b83c2f413a0fe9240cfc647eba6fb68521ff8ce479e08e6bc205d81bf9aebaa6674667ce5a3dafe5a1ecee433ee1f7de32c08e74b83b108fc5eba4ae3379befbafcf7b702bc4cf1fe06bbb3b1f7f8f0bba6000f9bd779c9fbcc81739087634ea89dfb1a920598078b3928013960d9b15bf7d90df01778e68279d0404ba63dd6d7a23043fff54c39dce0d95901e10ffcdb965bb3838cf06b49fedfbf662d15e36b81cf9cfc6a793e9a303ff3e84bd145537df051deec3b3379013ccc2a1fb02e15f3a36dfe2274eb92d0e5cbeb45aba7a853b931dacbcaa65ebf2dc697ca9cbf3f16ad597334959b8b96f62e35b8cf765538f68bca553e940418efe6f197a9b0299cef249b27e752977297814f1cd127813d901473dd9c5de522d683bf99f0d9bb726c6bf0ac6fdd5bff9abf803eb475f386393e67057bdf355543ad9d03fb04da025e3de2954369bb3ececc758d1300b742ef826c98dc6e03987bb5e8ade5f185f1dbc3dd581f546ed5e05ee839fe622c8057100f6cf43017e3b37f08f064581fe122d0b072059e8bbe417b8e32fa74904ff3ec4cb6e72e3cdff7f6c3758f98032a0f6c1c17d87b770ef89f81f93fd1b9dc778bddc34c5b16b6f65c77d83767021edc52de617b3cbe837cd6262af6dab1341887d794aa169537a0fbac751fcf05996bd326077c8770a5837d6a5a3dd20902f3fa79750b612c5dfd30441e867c68ddda3bebc7581593592de98c7b01396929c5614086fbcfd686b1a18cb737da3bc89c202e6ca37b0759dea972771a7f518fe80748d72043ec7328030f2e1f31a765bde33ee5b1796238337cc1fa8cf4fd95fe1e4cde0eed43a9a2d684b58d4bd7c58c7b99f3dc19cf17f4aeacbd618e776162eb4e1fadbbbd87201d7590665b78a6db17757c7fa04ed43fa04c172c90350f96fb357d013ab77f9fc1f30aea4f98cbb347bc05daf5fe9e07cf916062cb2dd02f4c9459dd665d403f2fd3a1468ff9e35d85f5a1dce60793ba093a99ff3caf0e9d779b37419cd45f65a663815e2860ae53508637eb20c3357330afa33ee21ba7b7565126b440ef684d3f3bd6de4c90331aace5d869d6cf206f4e3006ac6b6faa20c3070b09c7d6e0593c33531d7317908dfca025bdaa63f3b33347f95a06fdf2a6815c3606d867c2d6ede0fcf8c578cfe46d6b48f21670e60acfacd506672ec77501deb106f319fe7d26195c5d1e147d79e8b4b097606fb8c6dc95bdb366cdea2cee1590ddc270d1b6960bec81d4633c10c6e9c05c034b633fe3fabddbac086b182be6d96b67225cfbc605e4fc19f5b943fb183b1f7bee887a1bad19f9b5b1698d101761ad70aea083c0188575c1d88bb5370de4b385fb58ce2e1ce812b8261f3f6804f0a8d3bac058684f8cbefabaab3feb1d4b2b7538b449b657ec37027aeea553dd9fbbc7b681b51c809ba72e7faf770b8a09ba03f048e33105de26b7f6a45b81ed6161be7d8456e3f476e0778083a0e709b7303f82cf8106310e695c1d9a4ae2e5c0dfbc7d8eb79a0ce7241a174717d93aef2fc622c268e7c81619ce0ce125a24e37dfd3df293acf690d3aa82b97aa378afba3dda432bb4def806d047a67488eec81af0c1d5e0c678a78fd76a6fd71b38bd21a95b3f6d7c77e31ba66e7b94ecfee5e8136bddfdf51be82ded4d6d675d4556e9133588f35923b40c3bb55480e337d83d926689748b5cb59d6b529ae03781dc66f70ff862b736b176b8070d76d3aacd6efb6decd6c84e66cad18208bf5bb312fb655903d3ad6f2ac8ac0835a4e2ea766397b225b6f023409f076cf6776b116e3bbd565639d3bf336eadb9f3e996c001cd196b9a4c8514fdfb544bd8f3a87856755c65a118a7377ea12e1328c057adfc5b0693604bf3be7d8010007e4efebc524a4bb54eb02dac79dc6f0e49c29c16f22a0dc3630bf03648d291e1dfb2ba86f0f507f999c0da069de81c11ae007746cae8f1ce656c6e85c747621d94f3c11c61efeea4eea2fa8dbd099c073b08f2bd33d48f789db239d35932325a1531c7af200f1026d54b0c95d5db02856d6bb8bd5b1cc339cd7b9635d54d8139e574017719e073a33d19e93e17c945afc3e18cf265c84b3df0b2083cdf583bba11c037bc795f320233498fb6bd0acbfae2dc043e4e7e30687b8b7c677acfd59449c8433598d31efa177b2bff3eb75ecdc176f1158a0bd66d304ae83ada135c5f5906f628676cfd820782941daf81ab444b6a6b92b9fce701e88d35f0b92c9a533f10b775d7bb0176726fa62988d58716516b36db9d30a68c3db1b9ce31878cb716adbfc1ae099878fcb7159535aef26c89b35ccc713dd17676719fb2fb5a89ee94b390e5f562deedcadb67de710c0c9c9668e3d7e6e28870187e5eb9cef95d7d883af5ac6be984027c0e30c4e581b17e7d9ab53dbb926babfbbbaafb887f70aa82b97b61327cedc7068e1bde9b3596d59f95ec7bc46b1d136646e5652aaef8eeebd1d3139b39d160deaf92bdafa925addea6215e552d417b36e98fabce0c8d7371d70fb5334387d813ce738fa9a348522c8af1ae640a29ed609fa03a601fb615cd7982fcc3ef36a1b73f28db62e7b78003ad8602ea25cf16861aee0df00cf4601c723f903b406f02aa35e3598972b1fb537e283288bdc787cd86f721cfe2d5551de70778787da767623a01306e524f263804d05f49f3bdf057b15f4885db7b934e5ea1de86b6bcad6bdd06d01ae8fef7ab7b5c5d8f579733c03df103fa5f199ecd011f543b8170741df9c3944fa680c9147c01a1a00c3bd39d9f10027b4a54c61399f9e001fde43eb3303be22b04307e4c7599ee41d877e41536a71e688fc4ce5139ccd402d9487d43b78d1e636e3fb847c69f59b30051d7c5d7c33573b7ec2eca6ada94cbcdf270ffc9dd93fddc69b90c5fb601f5680d7154a156577b922af43fa075abe2e489e0f3d99ecd345ba13d0b9ea5bcce538c9d8176a3eaa38397ff8fb12f3f40e3df877c6ad41c62f8bb3c747b3c1634f43ac71fd683a392077f81c7d9133eb03eb7ea93664b6ff00bb436e627d31e81205d0bd5b771375a98f26cfaf5bf05cf36e7cc058c02f2b287b65aaf7352aae8f94d6d3439fdf5ed4efedc1b8bd5e34ee2569f7ae2c5a23f8692b8b66197e6415be1f0fc6e269f0382bf08cd23ddef1d9621774fc395f56a42a0fcf6b9a04ba34c85773b803ba6adcd7a27e69c3dfbf3b55f173aa9b2077b7bffabaf8391ec3efbab6ee18a5fd47b5f1f7625201fbd4f91c9f514e9d5ae505e75a54cb3407fede06deddd1f7c78fc64def54e13903be6fdcae1f8dcb869e85cf41efffdd99ddf40fe0051d5efc1475d0ebaaa0db727bf81dd7cfe9dd3a2788bbd309d63786f59dd95cb7138c798c1db7aa022f3b9ddbc5bb22eadb201ce859f81c7514dd80ef8d4afc18dbf39c877f0166306f95e68567bbfae90ccfd11abb0d45201dd9e5791ac20fd60d7a293cdfad0e43df6ff58503cfe28dc1cc5b934af0b6d781ebb7cfe3b35d15c11e53e03cee4de039866abd8fe5b9dad8b4de4ea251b26175329df303189a9b1d4ff40ffafe71b4d06e60136edcf36d987f778a65f5a36aafc53d4b5873a31259f360ece100ad75ac22fc7e0facd03955db479c7b3609cd5d07b9d558c6c202f1ac8d32a0b115369c51ef1f22fbdb79f808cfd57bd270d69b449f039cab965c1ca175d41bad98f1ec67da95b97556d9988de9ac21bec4ad6f08e34c1bbdc66c3a1ac48c05fbe7704de2787a8f5bfb4bc7a663e6731955c897d2ba5fc1c6aa00bf38512e717178ee8eb51df9845b7790e5cc8e71f0a15375e14ff12be0137b19ec6f38c74fa469d0a580ee87bf417fa904e91268d6e2558f9e4e27c0b37dc7029c1ffbceae8a7f238eed11c7c8771007b7c1e304ef6c9146f788e32b845db36c006f7a0c597f99925a3b27e2ddfc51fafc30f84a3fb08fbba12cee478089e9c02500abea9ed12ff2077b0f4837ddd95d91abfb5fb41f3dbc97ed2f90331b844d1be914e43dd0b9d06dd4852e8ffb38b3bcba9693273faad0dac957dfa838f9869df1f06b35becb83b1b059c13cedaa0067ad301feef1a276f8fbeba225fd5e342e58d3413d9e172de5ab5b37ed794ebb4ee3a2a870060473e0c1f82fe229f016e0c71ce8c1fb7dd7ba77608e35d224fcbe8ad238f1047c47919bf58b6cf0afd2f856f8b0cf7a00bcc48631f0a5d299ce664efcc290277cacdecefc7122f0d5f712bccb75274bbe37910a3e5ddb427d5c39dec16610b8fe51ac483561a2525f49e52a1f413f9cf640c6b76b18fbe9b4c4cfde6eca4b8fe5b96f387a62f900b2ff322c689ab2ef7dc9cd29e8dea5bfa5c97ba537995afdc912efe5b0244bb63eb047f243247fa30a7a45d7025e537bbb2c5adbbb6cdc4bbd82ac7e344a97018ffd32ef9bee78e4cab24dad7180e78a4037e8576fa25f1d618e7e8ff5611aa73b78fec05a45877974b487e509fcde42ffbfbc177767c3d13ff08e0bb0cd4056828c055a04bb0668b56101ceefe529da309ede81cff963719d5abdd08133023df705cf11608d76b0153c97ed7953189e70efabf9545883ee15f60778be03d237312eebea164c2fa53eb53ad921e85339dc35d04581a66d7d737c7f959b86d5d7db4e7fd93e9e1be80ae46782cf41d751d15fa57f800e8c35429b62ddf5254f1ffcc0f5a75865c6abea6538d3d983d90dac9729c5b91612f0f3de3afc9c2f06705de37333b25d048cfba3cd41fb9cf0aff69c2f53cf4ffc2be60c7f632d2fe84d65d44b279edd8c3eee347f805fe7b70693b75795e27553d2115d9b58373f413779f5d9b0c25ae7ee1f0bde8f4fdb7561caec4ff2df3a3edd8aa98eef66b72999b27e3f918e6ec73bbb07a1883576a3802ece636cdd0793e94b7f423e1d6bb0685740275181873d6cda0bc45ee9ace733b043eae82fab012e94916e87d6f2204dd8679d8547d314e7ac36c8960158fd52abe52fd0d10d65efc5dad11fde3f2a648f2b60b7c8558d8333b663a96f6077ec015f619c5afdeeccd5692e8531fa3c9bb31df951c606aeff0bec87b534e3ece7f7025b0fee6dab759a37c18e9d37008f69eee1d168535c74bcf7f474b0cf5187473b56aea1dd55f17fe7d8d426acd1fa9833bb9c9e47ff0eccb11e27ce71681fc086a802dec0b3f9c79fd17a64c3b41663c3e5258a67c75bc067b10f3e3702f962fbd8ab9bd9489339aaff25ff65d7de57a7591160acc782d6538abe376579c213eca9c5fc9681fd285cbb3ca4fce55e1f7bddf9cefbeed208e067e67bcdca99ed117df5a5abb2e8698027da8abfa01f6e0b3a1fd8c25b4d0599202e9628ef413ea35fc5c507866f73f42ddecb608fbd827ca5da75386f2f5745afa31dc6f07d4af080b104695d54010f7b1a9c1fd89ec66ba72939f8f1aeb6909fb6c1de9a02af33f668270c0a92b0b47cbec1d6c8407da43339735dec21546f704ece0dd8b5c01b873ae65560bf67157d34355e63b8ead2b8cb0b287e31d7d6ea0c6c0f5dd23b0509f40bf443180027fafcdc2fee8555b5fc7b5d30ae1dd4d175a2f34e17fbc250ae0c9ea78af90fa13c0003e86824e0d96fc606a71cfd313d8fe782fd7fe9da39425ece511b6beee03dc49f7680777eccb11f57c35c63eff907daba5bf43d5cb15731de3b315c8cdcde01a0979d65d0bb919f79734b27d9ef6bad0e614fc33bee0bce13fd6ae78f40ee08fa0ddc3816f9dafcbc11e322f88ecfbf6bd8f6fd69ad934c3c0f8e22fadb414ecc24fb0c006623ac61e3b087c4bc30bd2a7026f211e4e30c748306f268c24561b5e03d39de0ae451f0f23818af06de5e02fb5f93aa6067fbf7d0827d0662cd6f57ec598dbe2c970641f76475a3a387bc18ea98f31138b37183725c3ee63d4d6da27fd0efd3aedffde702f81c90bf24138eef9a8279692427a7de79d9792498bba3d678f22d7e04f3454cdca73f1e803e10ec35b65a607ecb0d75c033e617793e6015e534ec797fe902ef02fe803422620ddb1afd95c6e5bc98a1ff2a30cf2e32cf6208e7343515b0338147503c0170c7cb031aa3fce13c3a67720ddfc1f8299c49cf1853ced3f6023079ac8b336b5998b5318f6bc3e21956446e354b67873e418e5fbb078ab14d56aca7e091c5184be493f2dee5eefe18c4e0517f415f8f1f4fe5c334a8630563f5beb374ea71443bf6417a0df1cea84f724fb467d7ea804c990941da1919a1d8875f4f0fc42f32e671d78d34ed5bdf157d926ead15f92b4b67866f2c8704f5c8103edd2274e3d3a3427962aebea9d684300e839c70634cd8b384ea4964d0b5c1a6d3e5f9cd7e2ff477883650270ae54ff9c6c2fd4c5f82e3bb9f9d15ced38f0937414f0a7f8eba8b38479c34887e990d00b2e3107a77720aad93647068def0f96ae1f3459e143e639f1dc27d625e60e8198cef393487be60a0318a115c7d3a2cea661ae82c31345e471abfce0b7b61cd5f34a06b8c0d50deda605c3f29862f8f0df328175c88bfabe13d00bf5aead43b88781afa22ebbefc82699ace03709e0980cf5f708e0f15655cb1cdb15cc78a007a34c6d59793e94d48ca1da1781dda5a45156c9676ddcdc56cdd2b206b7dfe6d4fc7f168bfaee1b9897b6d0a73e822c34de257a45761cca5c6d60667e28d5de3c33950449798ff4931ae896044757ad0934187571ffe180fea3c6f87f68e7460cda6e929eaf140939a3fcfd6855fad72524047e982eda1547db9a7753787d1941f9cb6b6b41bda608ebe8e790ff83c9c91063283a31e3016ea227bd4b36ef8dc8a60b9bf626fbad142c6354bd85b48c6fb6176c219e4c25eaeb3dcb949d37860ef607921824c995d598eb7682ac0ff45b077fb302ec84bcccf39b1b1863457e833d0db04906165ca1704bbd3221d18f4cca54f2f511efc234403417e04b637d59172761f9d03fa771a70066a44df5bfa745bf4ff74886ef039077ea307d802d3e55ca56706c84f9b89b16983e9d20087ba7d6f47cbd3158605e1e2e80aa89b7a6b8a9f0bf445f4d3ba348b79346b3d5d3f1f4c1547e7bec58c5df3c185e08ab448b64e2d1da6a0c3a2dd02fc64e4e4a5824e8fb6105f827dc4cd45eb01f9a229313605b353b492a7576b3ed8b7cbe3f9f017d82357d0a94c8c4b76f7463d290e46facc61863116d44156dde6ccf0c74e13fd24362f8ad379492eb578a1cbd67095abe5f6a81693d349b6724a6e79aced0af6b2e5e8c4a524fb36b087f54e00bd0bf3d7f01eaafdcbbf0b36ce9a908681f71cf02e288087e6cf6b88d0d1d496e787283c994c667655603ffa12735f9d1e1218a316d0e7d5a57ec1c3eb783a8cf3c9918d98285bc2f634c3235897e2cf85a11c3ad439639ea5d82ef9052cc3b6bb4bc2da0adb9733d23d1c5c21bf807f2f732fa7675e10d43ec8563599060cb4a7fd3ea6f5833fcf8be26787e7ce3fb5860f47d7352ef03b77f6e1f57438558438be326b368eb4c6899092cb9c1ff7436372a9bc0f73750fccbf403a1ee5b3195e8ef5dece5377f3e5de8550ce10c5a16d7c461b047522a0eb3713e09b3d37d135f0df1d83e5c6d5974aa827515e4c87e5375d4372daf2ec577f9e6adbb3a19b829fafd426f5c664fae0af19fc1af038ba3fcab34aa6552e7b4cc5e7c71a66f89558bed96642b02c509c1eedf763e3b19cf02dc7a701fc9ee59dcfec3e334dc1c27e13e44bd3b53839738ac84d38832ec0077526ea9f57e3f441b34ebd8ada96f8b9a99a7afb51a2fe0ca1fe394751bf94c0febf50bcbbaa6cd70bf449dfbfd4420f6418f29f298ca1fcedeb65e3c478504f3fb271dd1e33fe1e447a176def585cdceaf302ff40ff32e89ebbe5e21d616a2c76301ecfe93eff11d87c4bdb5f08ef58e74dbb81fe233bee5aabbcb42db5b2a8b61551a7589b91e4df118d8bb7ee867f0eadf2d1f2e608c4ed617cd6576786316bd1898382ce147f66d5f67a351ffd8df15d847977963ccfc78e4b5fefee8c3e9cddaa807cdba7d7b4ee05876642cfa4ad4b136d3ce858a7c4357575e9dae1cdcf0f03d6467c2f467f43bb625e467b419cd57909f68abd484ed82b6d30568ea22f476330563d9bae0a38847d52765e2f2dea018f7d1a104f0bbef80be094d36ba9fb08e2543fc3af64f7f8219f529ccf92e41fc566edfc11cae39d466a8dd4ea1eef430beb3b57a6fbdef45195bd978cdf4c9f0ee25a109fd7c427ecf8b261fe5ee8a38ab828ab8307be73a37861f2998a2f30a60d5bacc772ec57b0a5753baf2ef88c09f8e23b0f5cff36b26f66f779e78279cd12fa97e7c97bedfbec7b3fae027ff7e32aaccf79e6167e270577c5dfdddab9443e536f5f66573fbd301b646fa26f1deb8a9605cd5078ef7d9f7e7f19ccb7de33c57b057474ecf1f1b95a8c7a804348470f1f0d82bd89cf0b2f939871810681070bc9ef2d7a31df31bd037df191dc64bd1cba9fc15e538dbf78f9da37964fd2e4dcbab32ef01bc5aa037f56d5fe718bfca4ecc21bce0c6cc21ea3e3a500b0f37030948b00dfd93ef776856c2dac191c273f0fdf859e1f0a23abccc90bccf9dd52af57f2334d3d1b7cda141e2b564f067b1005f43184de61fd328fd2795048d44d843ed3d7fc3ed05f813a52cf967f9151575c0c99cfdeeff7f2ebf4a44fc3b955eb2711ff653117cbafa7a05d8638e65be32f3833b70f8e626916e85606d5bb2cc86fe7f93b1ade1da9e8d7ed518e64fa731dac6dc07adc041d06fe2d925d49f598915808fa7a39e63f7663699a54bb78bf835e3204dd330ece14878be4f52d191fa8315f8db2e334f21b1478f22ffafc34c375b177667a02a7b17a80946776de39605e44a7563fc0de85149f14e890dc2929c6da21df51166c87e7fe8eea6d34ac33429fd9a83923bf8a3c67b89281bf27b60ede1a14c8e77b83b9637c5643fcae083c50efb41438ab3de5a3abe8db5cbc83dc1d46f748df292c866ae17e2af7fe41fa35ad6a3bacc7ebceb0361e3f3fddfabbe141d9552ed2e4744f88cf231e18cc67ecc554595ee9edd6f7d73bd879ed413c62765fe8330df4580373aa075467c3e2ce2b8a99fb62bccc177e1b58dcbdcfe070c338c16a82eb3d7fe1efcb8730ec603c7fbcbfd0fee85cde08ff569350ccb8493125c0cffd2be61bf4fdf6851ef47177e675f4893d803e0eac8ee882b195d7409c23e85b3fabe332bf3ea22e2d731813f1c66f602e3fae9fa73a8e05d8993e1fa4b213d04fa0e177dd16abd3e81fdc778a6cff33ef6fd837e6f32ad5fd8b02ffa28fd69549e935b05f142f62b50d26abb7c0330e8de1977df378da4ea12de47b37c057664f606d61d517bb6c54407f00fdbc563f2e284f2d5ee6c2fb473bc6cdf963dc1bc491c5de91c71cb36f22b16ffcfccc726558edc160ee93778823686bb650ce96aee13b846c598dbe627f6ef6a1bd637915c17e03fed89a2fe7e0c8ef48f656c9d6f2dba118d380b3bb53dcb0db403af0749dc10e6b906cfec3ee057fa4e57ae73e87a668d3333b6bf2edee6270c5d292fce6febc13f467b17a7e9271c158a58afd1138b4fd66d6b0807dddb767acd718cce1acf66e1d57e83ddc57f43de0c567b6e79b265b7bb0a384b35c7dbbf96287fedabdaf41a182759f9acf67f3401e2d3f84185fb4863c107d3f49fe35d4df3136f7053600e8b7bd33c69be59a007fb31eaffd03d2d2dd3eb319e051e316ceedf3f974e10c4cf443fbfd83580770c57e19f61854d717f52d8e4e78679cd8241daaa11cdb585fad4f8b06ea1225b1c9deebe23d05c736f60d3cae0fc60bde29817d43b0ff34c82eba4f0c73dd1c3c50b1461ff4f129d6ce26c7542cf23b34f7c8e3bfd06f46f21d6400ea9cb037d4e3eb2add1d81b1f6d903f339c4b95d934b35c26dea7b82711cbadb016b98c96eed610dcd6908fab78cf98f4e9e478d37e6850af9e35416c7c39a1df4659c070b37f7077385dc7756941b62c7d929ef04ece819674a005b8ffe24c1e67b98ef07f2cac68b06f56d4d8a71613db48eb111db9765f8f53abb4785936b833cfa0b70ae8c32730d3682c47c565f8e2f55b173108335c05396a75b77ef3bf3cbc1f077acbe0be423c0f3b05ab41faaedc3e917a6af32e5218663f0a54f569f6908bedc9f87b3c65e38bfaae9e8c60ccf9dfcadc0f9d8fed2b56e0a2ba2257f2d1f67a20eb6a63c11d7ae790c16971de94853a7ceebc4034ee15df08837437896979b9517753e35995cd8beccab77a2e731de3d8177c4513d2ff9ac5f3645908307d663423d4c5f3696f837d8884939950c56b59386f213f386602cb099c4173128cbf50fb4932cf16583fe84dd49c77b36d483f10576a30bd3c878c7927f3cec73705e17d17633e8ae3fd96aa3bf1d69b1096785f780be6c3896e39e1c2f99619e9e20cfccfbc29f03d438014c91afc3f73c2774e85f1ef8f46cdd6bd8ff523f91509da6f35eb58dfe25ca35983fdad43f05e6782cb07f38e6d2e2ef2c3f96f92758bf9c13e87c2443869cd69ed48779d71de1eb30ff95fa684edc675cbed0ad4958030eebea618d3dc67e0dc6eff0b377ac87837f47ea00fb13cc4cd01f05f87ba676e81d19deb9dd81af5b1da035bc738feaf1689f38de0dfb61ee998f99607766e3cd9cf18a8bf105fed6e29f05fb0cc7419910b72e38dfe1949bcd46f5ca4b0a2fd1609ec202f9e1ccb4fc35b0f36ab936ae1bd2a8ead8b4306f83035b9674b61776cedc2ff61e4f7040bb01d78feb05dc07fb509e397ee1b54efe5f6e30afc3efd4238a724c316f097dbd787fcaa4b03ca1df937431275f1aecb6ae2f3fa94371695a0b9ed72d705ea037101e620da31efcdce6f16ebeb664edcf1facd705c5d1805e3016f2929c5b1083bb29b936a27eb273c68584be18d8a3e89c16c7f6720f98bcf2fd1dc5633766e9d6bf24c404a7b335c9fe6a5bf5f743c17e0d7816c1da0cf1b4c01c31aa9dd8eb1dea3164d7591c6f7bac8dc1f318912e9fdcfbc4c181a45a69d03103f9abc118fe0c7b1125c699e47124ff333616d9a9b6d998580381f8a9533e8b399c8da6d35d560db63fdfcbcbb761321b753511733183315bbbff0dd888984b42b880fc1975e24e73ff093c4fef56b1be827c13bf988d587a213f97f319e684e06746c9fd0c63a712c9c8bdaf2718da8527bdb373e641df8c7cf6d315d0dfdf68af8bfa3030d6caa39fcbdaf3a9113e818e467e7d3666e544f9ad8580ceb8803d801eb767b9af63ec6704fa4b5da05cbc01e6d6e9ed8acddfa90e94edb30c7261efe3e5fe7c25f3de61b08031d16f6ca0ac62bc28b6c69ce59dda67f23518337e6edb47015e8ef93858f700b818fefed26339bfc0679717dba74e6bf668e48df2d5140ffebf1265ceb861046df259d0f7c5d6fd7073f8c275bb0ffe0bd7b704dc01fba7047aeb7979b8536e3fea12d89b06c6e1c14e753f279f68283f0efd9e2acb074fe51ddd262788d49fa5c1a32f137b51905f4e37dc78b85b0301bfcb35848be35300ded8b8800d4d7e3a7e50c8c8db265fdcfe85723fe781dccf402f8c558d3fc2597ead0f77bb961a73061b27cc2f0bc3c48d6f234fa51ccfad302fd47fa97a48273d2eaf543366ff0db6a00674728173d00763ed063f26fcece0e7003ffbc162799e1749fe9e608fa505eb67739ec31e1d7b653c1db1ef8dcbbe0bf8b0c27b1aa646dd7b16e5e17d0df02199c56a306473fe68a814b76f608dc07d4fb95fb0be25c1999d9fc25f6e20931ddfe81ef4824f66f7c20fc29c7ad8888252e36ed8cf6655a59ae913abadd8b29cdb6a30e7b6635d74b4c56d3fdaeb92fa54f0eba185b80732a1c0fa727c2c9cde1e084fb2777458a380f51298778ff51c78f630c67a837a5e55db23ac305f0fe65fc3bc80ef06c640da23f6fd210c37f759d40f1b17b583f21cf1620ab878b8535d48d7ee45b2d48defc2e1a44e847d468e2c9e4bb9bf23fb68efb7a93a2eccec7a91aa8d1ff3d10fec9b13e69c642e0dd86773c8f2929dde2a9e5feeb4d4311f8ff9680197f4f6027913f6b812bf945d49905a981794a4eff96a9bf4bb6db3f60c753efa623dd6ee1545a79c46db16683f30a73e42b778463ebf00e53d1d9dde1374d7e0cdb199486f0ff42b421ce17e31dbececc5f66aa53be8d7d83f13f48729f66d31b19f53783ddd83a6299c505c1befd6baf88e773a614d574c4dc8fe971aa98df37a3e2ad47373cf60929e138b36a530451f5d53582f010fb136a0d32c93ef937265311f36457f5ba14e047ae65aa7e748d774e1fe38bfe23d98fde32c269fd0d6d3c8cf51a27e29ca8ee73b4d7f2ed7760f72772fa1fee5d3391403799410ce1176e4e4d9c5030bf6be203f1cf3fd35f726f646c37e287252adcb186bb51a54d7c26cb40b4f30698da80606fbb3606e5a08f698931cd005d13e015e6e620f24d7ff4bfd4feef677ec33f2d934851bf6eec0dc47ec6b1a0fafc4fc784f17b36bf07c71ebbf9166604e2fe6f538f3a979a5e8cbf0f5cd9a83be27a3af2821ff1d6b7a82be9211c548ec5c8329d654022d9fa91e31a0374d496fa23c2d980be4db8b9a56efd9f0eaac6cbd368b4ec186a1bba6519f479b3aaf6d13d9ab1dc37c959b02d3137620b3a9c750e32a3fce5c5cceeda6c99bfd03ed4befb09cfb98fa2dfb7bcf8f6dc739226b3a3bb9fb4c27f3f7c9a5de5017d4f31d3ceeb0184030be125f87e2eb29546767d11ad19d5ea19e97e1ba51b747b5dd3bc6eb598d3cacb0f5f1c412d5f2b93a30e3ef0033638fb990eb82c0a5f4c1a238d8aa85f6abb05317528aff83c5aec04ec135177cfd6d295ea154a90f4b137df6984710f8dbda927d278eeb58f7c5b1d831c606f19e4f19f0b98cb2545bee999dc1fa91d9f919b533e81b5b4d421af1f22f5e143cdfc91be09dd34765986673a7eaadfd84ba1b8cd760af698ce52c0b17436e61bdb393ef157cc6d7c305fb69da719fd93e0df6915a964338d658f672b3eaf6ddb0d5facdae81f2bec3f39bf337b515ed97fdbc5fd560b50f1e0e577aba2f5e3ef1c5cb316667af37799f0dcc6504393cdbaf8bd2d55ee789c507ecfb3577c9f26f3e76fc4653bf3d40bdf2e8ac527ac74e93df455b9e0318fd3b7026540b3565b559542b0f7ca935727107fbd4514d7c528fbff8398ccc7a309ddd1b1b989ffc5d760f38ea6d18ebdb20daa4de382003d89dbc760d5a0ccf84f5dbfee2f32928f37cfe290374715b06612fd85533a257f9646c798dbdabd8f3b37bffa085e5b4d37bdb7d7ebdbbdd0685d227f603b1df2bc8f352d23cc15e4eb5cb75d01abea2bf5fd20d2336e7166c6e90ef00ef61be3127b9c734a3708bef7707f2087b625c3b00bff5cebc741722f6a67da5d8108b1b59817c7c8283caa5c0cf5b33c6347c30ec5b6013edee5cdff2d6ae84d7be409da76cebe902e8d565bc473a48e70bbb472cf5592dc3fb8dfd6a5ea17ea0363fd5d7c52deb0f7a007b6c72c29ad91bfaefd48260c935fa1bfb621fe9bb1677067dde9d735e04fb672e533d9dbf17e6a6d8e39707feacb2f70126a02bd792de1f39effbea7465aa35fe58c8b87fb013465facb6926775052de0574749f08f8579d8981339b27dc0ca83f93fd503f5e3ff22fe01f283e297133eb9c726f613049d1af46753b1a8af833031dc9a78d28d9696e1ef2972eb1f249fff6bffaa227f6849ac3f223b134b9edaebdeb93e001e7000f3ae34ace11ae9763f8516f6f524bf22dae9fc60e1c54956cc47eefaf5407ef380c30f17bf1f98cf4d7556e8f73171dc8d7f5ce23f2501fd842c0705fd4515077f5fed7b3a5fc0be201ed43f909f236cab6cedfaaac0dafcfe46eccf87b8bd1cbf63ef3defccf43ded75de409b2bb8df357d86fa89761eccef68a79d56acef2bea8ba0c3da3930553c1f3bd7b275b1eb24ced72ebb2bf365493d23597e23ac3f6b9d942304b4eef440f59dabc07bb6ed991f147c7a38ea947be23d8f8e3ff6bc08d6c46fe6534ffeb76c5b1063bde1be246351ef1787d8af93c31e760af02d197398c64e1d883f172d50c37bb16bb5037d7e58bdaa68ae58dd32c6a031be8cf862a1df147dc5e1d824f9882dcc9d1a06f288602c5bbe046a47a86721f540f162b71ce521cddd783517cc53bc6bbe1a6fa3e3d8b19340eedf4bd02ec15ed992439b4e8f953dd8d3b56e6ba6b27c1bc9abe9409ba081bd6999af286a0bccc09eaa63ee85e1fa6dfd79882ddb4fd68a1dcfa971f6dd7f71af2c99aff4807d646c1f12e7f990ee3bec89a2c6f9918a717ea43baeed3c08d7abc6e83e9d85bd36b4bb6a175a37ab61c39edf3deaa5ca7c936087db7d5bd60df3cefa22fafcb3cd118bb1352866887bd9619dd1603e34e5068b0726c0ca776ec13e39cb488dbdafcfecaefe2b7ac6fe38cc34a97f01d12af6b25d8c35bb67e63eb1e772ff8071c5e0ba36cda9d73f96c92b2767f48679280073d75f02f217f44bf1b363192cbfad45bd73cda00e510e8caf16dcf1a99f67502fa9a01ca29eafc803d87d3b2e0f30074e3f5acc079cdc1ffd22f566a73ca27971a6dabc1bfde6a04354ecf1514ed47d792b77d3ee3141f24005fe19e83d1bacffaf79bce3cefae85afede2422ce65212ea38f48d9f1f83cf62e37b19e589c931f90e5ce4c3107724f7d7d812e43fa8edbd3348e8e55bcbf06e524f667f1c1e338980b60936ad8e705f30d7e8b3a8ce1e32703ec238ffd98d1d78c354ce306e542af01cf81571dfb47ac6757300e633059bec77aa7eba06020fd7d0d0ad397550bfda5fb5f30ffcb72722ed87d27d0963fa9fabdecbca7006f05395e84fdc3676f5873877ddee92c715ff6fd3d115fdbd2225fdb81e419cf7c6d9bd9e5362ff454e66f93d74ae3b2c31e1c32eafb735fce4fd3a0fc38f63bf6dcb9119c960fbeeceb67ece812bbee983ba31f1fc6dfb1de4237072f0a38df60be041d0bf089e52995994ff266e2fd1fb9c7a4fb04f698f3fbd56dc2788711ed0b7d5cf65d3926f3ad36d6eb86bd2727f680f12ba08f0dc58aeabf3663e71e05d06b603dded9ef5f36886394a3c7fc874bac1d0038f78f5ebf1cfc2cd2c3a061f7d9f0d136f117ea111da0378d7ce76e8ecf6c6de71f90de43343a6fe05d428167302e0adf3d70fdd2e474a3734ff3e7d93d73f13e07f419009e022ef0697ecf21eb058ef15dbc17006beedf289e300fe5c323cdbaf71f01cc97c81f58ee29e62c9ac41baa686396ce01ba2948e42b469edb3f2cdddffd7cc2e93fd36d1a02c884c7a05979552ce75e8712eadd963cbf6be4839a5d803ff554d66704f8d41cd78a7b6c633c1175d1338ce1974f5784bd93ab312fccd60acf31fb78c6b9e7ebe4d3d1d8079e7aed60afb90ef6f6717d2a7ba1635d1e80d795f5036b3959ffdfc8fa0a866f7d915ef5c8d71e20978066ec35b7a4bf258027d3dffcb927d2451aefa9d6027d819efe79c29c26960f5484670c7b3f930ad6c5bc887a1b6b81627dfe242b1e24f7a7c48f9dbc969664ae489787bd014f9ba1ec22bfb5fd3df004eaf7d3ac1cda47c360f701f07bcfd6f5e727b27d0568a53e33c2f52b3ef9f777b89ffc6047398a7e1d10ef2fc1de779897e9f0f603c6bc9177929e3be174a92a9acb1dff05fb437e59623c5f017e799f128f473be718a8613e61be0d8ed9ad4f416f310527ff427d54eefd7df80e0ce4e7a57bb75e12663e1eccf04372ee40716a21f04e931de0fa0563f213ac5db7ef07403e376b5c2ed807708d7798b01a053c9703d6b5e27e964053b21eadefa05c02ca05199e649d720f30afd8d38d779877ba75ebc055d017bbcd06f5d74319bd294a0eaf3ec0da1c5b64873c75f8e01b70ae57eae187b52e8b25e0e912d75506fe6c226e2c9dda33e0c9f02caebbdc79f0972ec9320ef941d9de837f4d9cb326580ff6142739cd6c22cdce2bd5ca70ee5e1f26ca856dafd7747f8cc6fead6a25a0777530bd3939d80fcc9fdd30795982f5f8fad24926f6635b5aa83f20de6efdf8057fef3f03388831f5703e318eefeb05866375ea92301ae35d7a0ad04b5bf0d1f675509c51afbbfe41f0f53e9b520e29aba541bf079f7ae706e9274c77fb1acc2f5ece15ebaf88b07272aeec1e73f72bd91d113db6047a2ce97448eb00ff32f6e2a178aae29e61857aacb83addc4c4e73f91564773cc47bed39d36583fead553cc4235e9522047369aeb7fbffaf36c02fde66b5cf2dd7294cf75bf81ae85f8a7914fbbeafac0bfb04ecaedbbb0c39e54a823dd58ae8d77ae76af0de0b98b77caa3501ac1dcbf4ea07601f5d0767b44fc953b811ef66079914c56630fcc885d86ebb4f0cea0801c78609e862b079cbe9460e375fcbd41c07ef2e44d2fe4ffb0fb6e90cff15ec1382b5b8be1ebef71d9dbb990e13e594e7ccedea7f6f0f934aeb6efc5cb8d5cf05f83d916eb470cb9d5c3bbe6c99feceb37f84baa9d1f317adb17ea6d4c6fbc090a4ff75a5818efa47b521732f02276df24d375dc1c05b299e59ded63b3753b96e7c5e358be9a1a3aaf689cb17e72e297744676ef31c49523e27b181e5417e7f4936c495e8e3dd80c186b12e9eea840cd6e19e116ec17eae984e44f00daf3e5bd085e4d0bc66cc93e43fc423ae2bad5c611e5af88bd4ebdb5531c91ee9f0bc71729c6c9ee7c71eaf3a6c511c5c8068badd78b0f6b61bd1a84ebea209cd73af50a423954a27e3dd8f3106873d442dc32b0aec7501e9c26ce31776c4b354c54e7501f1974c71cd6aca7f62c7abb63ec2aab3e10e8d0c98b77fb7974aaedc36a0ebc02731ab00749527d7da03f524c4f8756db9077dc51b4ec7a7a1dfb644aba1d2b0bc4bee2e2c9f43eddf783b6cfdd8d857c34598c126070960b186b57cf726b74c25cf938bf3dbb9fb6744eed0f04cf8a0c3f759b7f44f370b126ceed25e55b2be6d71d9f858f813c9deb3e58ef09bbefb9dd83897264827173bb1608ebd1b1be5cd49db8937c568e3dceae898f8b1f319d741c80a3f399197b970e9363a863b83d0afc3d1e937d50fefd624e6f03efb798d35cd938e4deff14870bbe3833f57b005e81bc4decc6c7526c3f8eebcff5d516b33c7b15646cdc19f7b1eff23ca9df915d0b08fa29d0b6b10438019dec0745f1eafaca517fc4bb7a9a82f131a61ab6a2ddfb087512bc63a7a4800e311afbe5a464b23be3deadb985bdc29d3be4defdf11b7cc6f11b4e58ff3cc5d58506d3b05e04731d59bdd188e551535e4a97dd99b79c4ceff57501f5247887c73bbaa98fb7570f389dfa6bc8697d0af65969cebc9e0a3bbee1d5a331984f76e93de76dddc95f4f7775f5a12af277f79ebfb218e92beacb7d0c7ece6a71311fd662fdcb144bbbfaf3ed1c3b48f6d769b5da02de7793153fa4deb660b3b25afe407eea598ad6f863ce80c6e2cef2d59b37e827ecd6ebde19dbfa05fae683f72904ee35aac7dcc1e38fa153ae42767eee30faf982a7b39b3eb8afc5ee769a17ea978d01faad71fb5ad4defeee1becae19b571b7f09e19d9e07e83ddad6c6ae20def9f518cfb15ef9ee9c0f38307dd7d50595405459ae09d14c3af55f07e26291a332e6d650bf307f1fe35153e7bc37308e191e8afafd0bcfbeba674775d87f2e9cb02f57ff6fb37f00e5dbcc3ac9a5ac760d79e3b639cbcfab9601fb25f706647d4ff70de443f4f4bf2dde9ca72be1097414f38f78f8aebebf0ee79e38b6e4c2c837e54d48d43fdd650efc69a9c35d661e8149ba4de3f6ebcc3d59796769ebb8f7608e6ac375b5c8d34f60e47dd1263397817a6bd7ed48dddb81a9da70317dc53125c581c2850d78879d41fc417815690b670ad55ed95e688e691783e3c8445e83e598c6fe15daecc0e4be899efd3cd9dfb60d1ce22bdad609e6cfe71b06be74ea1bb64195fa871378ccf29767c8e6c782bd063e38cb1ec2ebbc3d62717eb37dcaf1d1bbfdaf7a506efb865fe6b8e6cf3dadd827930e6e09c11e55dd2d854fbeff548c6384a583e507f804399d531b6d2737ae2603171ef2f9ed9f710d4ef743771b3fc9596e39373ac1b1b0bce2b314fa9aeb9eff9ce336c830ebd9ca233d03bf2da1799d963d4a3d4b589302fd4a929d7ef77bcdb85ee616a49bf3b35b04d5baa32e3b9cf816eac173bb0c58f37df1d2efb73bb5a79c53b67f15e5fe00d67b59642abe46366fa4704e7e873387faa953925de33e9dd95b007fabe39758c378a8bef4af6df179f1f9bbb2f588d95eb33194ca45f7356d7673a39c18bc9f98eb426cf25e72e556d45f591584fe2e94a58cf047cf6b77df7fa2f8abd34d17fece0e2fe25fe4e6ed4939086ca7bb7e75ed3f0ee82a05ccdf733deb7bc04f831deaafc62bced4c3a4af0ce6fd465d8ddf2a43f5781bed07667f7eb05faeb24df692192ef1c686be2d8cc6ccffba47b7d39e7cec815cb5be03a73e564df397d5e603ebafb3bfa6e0cbc27d8be4b9ad58da14dc93e0fd186975780be1c2bd4cbafba991a7b8217de53467e7c769f3afa46d6d63d78a77a8dbb23bfc0cf3e02fab74f065a8c869cfcec10df1424fb7d58ff91d190fcb59a0fb712c9c3d25561f706631f21b4a3f71bd26bcf60332924cf406e7cca53763722c902fb1e79ca47f2dd5b0ffce90036f96fd05b297f89e8a45e71ee65726cebc7470beb9a97f8d90cfe06de75c73e73008b8a39adf1afff6f776fda9c3ab26d8bfe97fa5afb9e2581ed053be27eb0318d686c4c0f376e9ca0b141209a5af4bc38fffdcd3167662a25045eb5f7b9f19aa8a85836166a5299336733e618aa8fe60c4c996097d85f822636ffce3e9a608db89fea9dfb3177577b0ce3ce6febceb32fc8e37295bf8beb9f6b3c4b0c6f67d59d062dda33526d603a821b7b869cabd440ef486ccf809d7cdb8ed26f415cbb3dd603c2dc017a1fb4ea5b07a9efe5b396ad8970bf92af9a0ef9310b2f565e49b4b9594778a17484edfdeb8d356485f795b53d7e26f1d6700e30e2771c4f35ae61e77ff435469e39701e982788f1277e348787f9039b62e5e3c258047ce9a23df3d3e081b0ff99dc4fec9db15f3e6b712ee53c3b44fa56d2e3788ef9000d70d16862dd827b98ec1f377583d3c62f4c0b6f8d59d7b48e429f51f6c9f67ea8f816eb29ee897e32f6d6953d20fc39ce9d2f7e85e4143ed6b20e3b29e1a80bf367efe845689e66c051ded5c94af49362bec695cd46cc896b9fd28a0f6923fb3db8c5cb38c7ed3114bf89f9c90d3eb6dbfea1ef03cf62b4b857da87a2f76b6cb7e5bf35b9173c8a27cc4ff533aa1ce9946b69f0abb867a0998fc7749b889635c5ddaccd4d36e436d6dae269bec1e7064e7aa5d3148b593e741ec160b9c797dbfe56cb677ebd180f058d7549adc1d29bd8d1d76f7aa1f30fccdd17c5bb7bdce7046d9dcfd21d2e061ed3e355ff66857ba368eceef89e4de1074cec7d650e199c334f6be3f5db31603db3900b83b5c2cddcbf83fb7fc71c4de6d3e073f0793b3426c32bbd76ad3f1d3b570cc30fad35331601bd139a77d79c79d19858bfd7c49e60ba26ddd7459fffdefc189ef16ea236827c22f4b0c6f28e27e6ecb1f7d7db766ebb8ee770e5bbe15e7c7bae7cf05c19760767e1285dfc108ebe47873510423eaca78a3a96ceb9437fd6a4d8f605fbc4b513f31df4ae88169dd26f478c516c477b6e352fc4b5ad13cdec546712ad8d3d30d647f9f3f05fa03fae7d52271a8395658d81a7d9aecbe71c577c388ff633608780b54cdc1fceaab692b6fc09ee7fd7fe9dbd47e1d876417a3cafe3bec5a69acf44ea1743aecf0c02f8d0e81350b16934f62c2a5b29b6ab0c5dcade75eee75735358ee05adb766c0efd67dbc69d1f0370f170bf8770edac9bc843f3fb89635868ac0bacd5ccd870ba478cf92bf23cfd25d9db74ed8a3b89fcaa5b5c473ff8be5a9bb4edf3d19a31bc622a8f20bcf1ca3f42cc386939bb1ae37aacfbcb8518137008d2dc3bd0df02ce43bc3ae0ac9c59b537b7de24bfbcb7a3182f1f6a58997eefe9af4a9a7cc1d007df788abf09d8be50dbf011fbfe7614a83805bd48f075f2d97d635900579260c2ad5e5785f5de7c61ffea424baafc28986987fbb5f85e1957da47ecb1afbbbbc9bbef899d856f4d7b6ec42e45f28d19d69001461d7b570575f3f019306633abb722a2edee95c01343efd7cc8787c83a54f8a5153838ea568d1f9855eeaf036f2970e106ebed91cfe4712edeca6f45f276d0741ffbacc544ff322708344b578a6f8efd7afd2cd20fa7e228687476dd03b89e62f99f84794d7ba58a33c926dcc40d5839ee3df2f6748f2fe35579f6c9d8d50c70a49c27acd0bb0ceb9e0f5cf71ccd8fc2f5d07476e8979518d2d926c72f564e331db94e807e5de6ed5866625c80645b4b8dc76fde3baf116b8c0c3ff3edef2db27d8393a079891e7075ef76dfe08d319e8de8b811ed05c3c8de66f3ec001f2c6b76b4223bc8989b4c5678d4c271e273a43ab7e775b3a0fba6820eb857e37e52747fd73d0823da972c5c83e13cf961f11321ff06bba2f11bdc0b4dc75f421bfcc6f9b9f19db93612ccbddc9b890fec79173e2bf6f37b63eaf5ca59db3e938f19b1e51ff1dee82846f82dca13d889e09d3be7103f1c6211452f4f301833e16e2d950fa36e70415e99f6259dc7df400769b4643e44f24b0ab497221edcc006ac607751731cd2bc055f63c835adf66170e1aababfe66f14de4b83af3823feb03513c1e1a8343b753d6a0b1f00bd54529b69401377fde9a096895e8fd81cb235f1c8d492cf77a0715a7e82b31d7d4a29d432de2ee4fba5ab0bd62af0b1cf92adf1b56de6791adde3d61ef930a36ef6fc093ce06b79522d6c51c7ba8c52a794f0c1f338f9c8937d20662c229edad19c2af39ee415adefffce31f4dc1efadf53d9ed04da2b81b3ed9e11376fc07d0f0ded65bf1b6c139f23f54863fbb283bd1caf58d7e0378e399ee89ceafce85b2f4f8049a937cbccefaefce54dbdb7bb507cee0c5a37d7ff19f1eba834997dc6faa423ebe70c2ed5c7206aabcd1c43efb7e234ad856b325756dcaaf778f3c0113b0ba0d526f858ee633d0eb9572a6a6bb44ea6bdff5831258d5938c72a571a8426dedc477da6a87f3a00375dba0c6e3e8e63079ddd19dc6dbdd6ee80cf6b1dfabc987c5f43f111f57a48b2317a0d4b0f5ecadd90ffc17b75b89ea79baa35efc193002e0cf2f31f2b4df50e4aac49aae6b279070ef04812bb2f9ed0c7c238aa73b937ec35d6341fb5cfbda933a7cd26f2ce901f03079cf44c9c74de7137e49e58c4c18d753c96d19aa6865baaf51cb1837dd353c27d1b89ba4ac6e7d5f9b6522df27eabc5a89e9d3e9e6cd656f26cf9f5e4f574a8340ddf316a73fa1d702f81e2cb8a8c71a517e169e59c41a3573e2357ed057856e9d3f7b8e7bdbf53e3edaa1ef9cd7bea287a7a640bfa4b59e3f5b8aeb4357f637e35f74b55573ca65b9a0f5bd8e37aea18721f17f36bf07e54f3f0ad17db3e30683496ad26f0588b27daa37f55d04b19daf059857da0530eef24fcbe13f9fe877f624d1eb6b19dc9867bf644330b31578e717621b6003d359c4bade666c08becaba18de37955d1634cf61ed8fd612bbbbd5ab7e0774e1d5123b7b99623eb15f1453fd5110e82c5ec304a6db9a6d0287696831eecdc07f207f3414f74429ac5ec6188755404c60df9b45d7bbc049e105abbdb539df1d1c0ad930fdb7654dfa7cec9535cc2bce6ac19a76da83c8ff4a72086fb3979cdcec9c778425e2e721fa98e60d4523b6f94cafec275fae7c70be741ddc619f83df8a7f7bec3be0bf375769c4e3120fb907529ce9c339f5bef376c1af76420b671c133a9f27c7970c5d1988cd1e7f963d8dae4aa826f60fb21be0faefb083b96f83db21d3f26af9b1c7a9246d0e7667ed147e437d77de0f551b367fd83ecf97de108ae2db4656b959f91757687ff4763c4a5766e62cb0073173da65fcc47bfcb46ecfbb233eb735c743346403e1e359f2dec09b836c9468c26e8d1eb38d9be3cc7167180fa395e9b3ed77b9d2c8fcfd90d7ae0eeb43453adcf2f579fe33d04ce9efd645a77b0bde01f158e932dd6a38c75a9c1e3ce3e26c6d8dd31af4f2f82978eeccfb4ef3410c3fef2025eaf7e4fed2d9cdfbf64f55a5575c453f25e5ccc8eb836d8dcfa320fca13c4d57c1f6c07586330f22e47f08945ab55f99719f4a36d11534c52b467cf6f600b5e5dfb1e22713573549b3a17b44116887fd5f9a3fe781f791c7bfe3307a17b9b8328120b938f1130779bda87854f2cb2b7d8f58a5446dbdec8f8bd2f1fb6d5a68b78637665fb38ef112016100d8e57e9d9621f9edef7a098dd734f3a3da770fbbe1d46a501ff8d9e8773efd55cc1a970be183a652a9f057d53d475197b08bc21ade7ce6e8f7a85fcab7c781bb34be7143f6aba8962e3831eeaa023ac8926c74e33cb4ff8697fdfde1fc31cd6f3826bfcf90ef08e9966173801e8229d326df48ba43af42fdbb9003ef157e1ed11f8b7cfd249b029854eb3d90c140ffac7e13dfd8d4d8ff6c3282ca5e0e830f686ff34f588fd98f6d009bdaf07e427c8c67f185d01dae39813a5acf55756f43f38d152853370c3c340ea42f6fca2582cdc83847fdde15c68fa6d364831efbdd8f8b3e4a129e6905a6edbfe9e1be73f0a5897aa40f3b2db394c7a5736c719d2b8930fe2c0075379c3626cff585774ed159cf1ddc6e335efbcb11745e557c0cfa6784a34d5e1ff5d71c224dd3faf2927b41bc06af466c80f93edda6dc6ae5cffddcf9faa25f9b9932a8097c2fc7e954f394f1566b4fd83d6525a3f837c0fb9c5e0d296fa38e705e8becc75eacdfc113817c131f697d7df7f400f5231696d76d39389f0a32ec277a5d64ebddd97f5328fe0ea9e38a7c13e0172579dc284ebafa70df91801b0c7e4efe55b8efbaa73e62d68f9b2a6d5e9493894cb8809c12375adfd8bbda04bb16069cd31a5c20460cff405637e0a260a3b3dd638818ed801e6b8e66ba9ba33783bd438c0af605e5f701225e92043fbd87d9b93bfbb1dbd66afe6633836a29d9aa8694c6ba691cfbe7de8da441ecfd2609db14fd1f3a27b6b248d97d62b7e620d00c44a854c36a1fec37142957b9b3556af9d655fcdeae1403ca07bd746824da6d858ea98c20be471def433b5808691e289684fa13b4e7bc297aa11b9d8b3271d609b9e999ba462f379962cec46cf937e6cccc3223017dceb3cc3efd21b4ebe5f2bb38e62299123967e4fd6ad40df4ae923fb4dcdcbf8f2829f631bc3f9548da1794fb733e3cbce05f6013509ce73d3b8f5e18bad3c133bf0e7253ca739063ebe1d73ff20df6c0b2e3afd9d4a6b73a903abc0d8a813724607f2c13ab0fdd5ae7ba93633dbcedc39549bb351bfe93e91ef7c185c226ba77f85ad658ebf6c07dac8164ed6fe4ee39a3b2ce410eca016daabed623a1cdd6a01fb13dbc9c16d3bf87c8d71a698ec5a4f0cfcd9f95fd1e3a2f9bcc679caf93ac603f46647d641b9b8824367ad83ccafc6f9d1a539f3ab9286d667075a9ba76a7ecc7b2a8d01c7854653a175d36f42fd3c7a1dd7b16bfacc85c19aef05708d71ff7814a794ea8c548d1fb8e8d988ec017ca0f7b0aeaff5813fc81ed0b3969d4fe162885ef77c32dc35b45eb0df2e87dd09f959d0f06b3f810f827be64ac20b37b9511757985b0b4f433e736a66e9c8266312691e6dba6e1e3c8ae4a73efc545a18bc0ec0692158b260d4464e92fc3ae6edb072b243d42829b64ad2540bc753adf357e1fbd6383c7e470a9f95f03e96e565c21877760de4997aa8df76dfe6c8dd7c579bd63956e1a6074ef0665d133878ede7b3aeaec1a7d0332a6cc85345d723cd67cc1b1ae913a8a71ee87d229fa3f6a9d6e66e9f263873e4bde9b99e551c31111ba93089f667d0ff7ec802a7f8413e4cbfb7003ed0d46b2aac714c71426ed6808da4b9cfe347ef143c193f8023413e3a5eefac24f0af8e7cf4d03680b776688e9c112f8d0277f215e6a48533a074625fa3c15c7d415de56d50df12ced66604d39df4eeb346d76869ae97741cd7c8515b6a14e9feffe5f51e194fecef89f78ff7f9a5eab14dc5d1d5389f2af52ee7b64ad5c25ae303c79fee6e3c714fbd5e7333feea9dc65deb77b2e98beaf9140c5acea1ebe477ddc089db7847748ecd1cf68157555cf0eca30ca4a6ea6b7f0d1aaad8071567df0fd9bb37dc2b207a09de0ffaf7a9eb5bf86367d36a390f8cf961fd4c6091a18763d9e4d1d2e29d51da619179297ca7d002bbc2cb33e702cd6d6f09bf29bf633fdfda77065d702d3b8b32f71c5ef3bc26af516091937ae258db51e5c028fee5f91aada17cb5d7d1356b7c91876c828ed69eb153a14f0e3ed23cf988b33b75cc8a7044f3f3e29a99affc766fe6a7d2ae1e24ccd56b7cd37aafed5b78bfacedb31ba5074123f91c9b4f67bdd7c7283b4c7b58769170df5776b2797ea4f702dc66cdbc73f24dbebebfe6f6ea9a2a16801e7c589b4af1b8dcc5842abe13830166ce2ff03f9da31a66d77350f1cd28db6cd5d98153a9306e997986a4a79bce17c16b7aedadb2f1196818ab7758e0be466875305673eece127a96f7e859eeabbc01df4b137d7905e402f6a2456c6b64a03f1073c1f135d6067b6ba809233df8dc0b8dfdbc030eafc5e61d637cfbfe182f6ee533ad9f6fe4cd695f50fb0de7fa62b17285639710dfc99cda3d8dfb2d26d71595eed7b2bc8aecf7d77b168d599dd6a21e6fe01ead7eccfd7bd2f1ddc720e958a3c3a5fc04c13944f3e24de671ffd075199eb38253965e035a078979b4fa3caf3421a11364ede5eda4797c8d095575b068bed15e9f72dc16365738314f11fc0f3025f00b93e319cb16db9a15cc0900ffb5bcfc443ff4b90cac15f7cf8ee76e55ad8340348a1e847b7975ca24f80ea85d9febb42e627608b8b5d930d5098fa3b911724020063a9d1967c0b6ae23d74ff47f3ed81ff39683076f6ef8abd3a2ddae395e16b86f4b9705dc2e0f214e89f3d7e37d2f2ddfe1dc96b25fc2ad1fab919cb10eedf307d9f733f3e3863570de4317128f46af75c65aaf32bf25ade3d554f55f034f6e699de662e743dc5eec6ca10be881b312b8287a0ebba604bc0bd9a6193077cade05a3de0bc50c82a7a8881d97b16ca36eb3bb24f9dc211fced697f9d3e13a08d9086b6fd91a7f76e4cb1e65bf63b2c33f351ff475bd1ffc41eda9850950b58dd89a3bebdc0efb0fa3f07eda3eadbb09f745376fec13ea5dde7bbe5aa8719cdcffc8791ae629de0277877755150e936d726efab4070649dde33ac4ab0383b789f8b72a2678ba6773cc7b383faaf5676c29ebedd2e75fd1f713dadaaaac5df6a7745f3bc5902bd6ac949c14d73dd00781def5c83d9f19178b7ddbccf33bfecabd3de0deb9e7642367e3e037cf6fd67347db25d67910bcb3d4beefbc6b5aff14e30ba6354f313534e602e199c864752d46f7279afc15342422cf03dee3b2e83de99abed46551bf65cec9afd6f637e692a9d90a274acfd82fed0fcc847bee21db547d05a2d34163a76db15abf1ec743dce7c39836a9a36e7f31c786ac3f55cb6a4739ce582b6961d51190ff0efb05c7cb36c521749e8ef3837b068b1f7ff5728f994ae7b4a89ca73fde835dd0e8b8a3cad99dd45dfa2c7ddc54cfe004e99c696d931fd2ce8e969db457a07176281ecd4dfd9e4fdff367fc9d4acbcd549a53bf42ef19fdbb95c26e56edad835e73bd29e780f9616ccd81c66ae405b075f4595066fe0b70427c2cb3e037dfe1e756b1b39ce4cae4e715827bbabec02b0147c4f10cfab344d317b6f471c4bac40138f9440f59f34d50fcf0d9854e4063039c22ed6f5cebb6b802108b5ef5e0551937007d27d495f85ce01ba0b5c71877d42391237b94be854e90c85164f18256853f135863ceab8cce3aaf52c0bc635e1d9a6bdb4187f96ecc7d339769af81637e920fbb0fb1b6ed2df66cac21d59f7b007735edfd9bc8b95aee0ce778b7f180d1fef68bcda3697070f4eee077d7e6cfa8039e237af691bc8e47eb0bb9e7207b13af58a2f14e6bdf41f4ee803d91397c643edf51b0bdb076923ab6b5eaecfacbce9939b6cf1e6b8d7db2ee662d9b740ce2e17149b4d646c0faa66bcc453b303ccdf63d2c548f27b49dde5023569c83bb4bf5e29cf99e686ef55dd49e4ff37a7307bde4534c9727addf917adff34a8ad6506e7aaa76691ff7e95fe8d4f4a00594c5f7f76fe4b7d65f9da3e4b11de4b4e7d58b7b784fd5feaab73cec81f2ded4fbe89f775beee543df6d6137b17c7be653eb5f9c5ffd348d59fa2df3f5ca7d747bf499d653b55f8cc5ee38bf6acdc55f8c2d68ad7ff1bfe4bf888d610c3ed9b3236b1cd15a80d6f11c3ce48875c9f7617e3e95fb85cdfb199b3773959f30d874cef5c675b75183ee46781e709f989bb11c22defd077ae8a02ffa28f39cecb8eed5207bd8a7398cfa4c586b517caee1da74a26b1335f5980e9da919a9f3c207ce95f7c07a457cbe66d9177c01e61c781c51c35f700ddace8d0c546e24c6c1c1f515c1e86750579945788153994cbfb53ba0b65091bcdfa117da31c6ac33bf67dcaea1cf1ef8c4d026f13c18ca3ad77efd39669f68bf9318878fe3714e38778b31c834169eae89a9b9f868ce05ce34c1a5f6b93f1a760b391ce4f4c041a1ee6b16bd2f477fbea5bd9063b031d9fcf0993cc6926bcef5d1057da7e1f868ec0a9f4be9add27513eedfddc0ce45350cdcab1e5af2e30f93dbf8eca5f1d1d0efcf79c5607107cf698ee71a7531bb1aa44e777c219a53a8ab770b718cda0f138b53fc304a8fd7616f36c51dd00b32bf27fa6933731fad6c5ac5941beb5ce167fa7c3dc7ee9d59200fc9fbfb52b470facbecc357a98c1ae8813951c84fc331f59caa4975769fcc99f2fa78cdab725ee0f8b59cb391e92dddc3a044b14f937ece65901bfdab4f76e58becf2a89bdf8c536fe721c539d514c548a976f633a5f471e69b51ad79f4eb5a0b27f7b29b744f0e1d0b7f7e35ea7568cc039a9bed157375cd8f07c319e8972782a32c2cde83ed89e675866c1cea297fc2e7a8e7b2a2bfde7a0027ee7c943a3e752f38c7497f9ee9e35da5cb8749ef597f878e3b910f9e75c7af0f99aae64e936b9de93a67fb3af552836d99beb749b14ce3b0f833766d67f04acf79c673aaf3e11ec85e7ed23d4c4a9eb9dff19caf29e7e4f3a9e7099c6d2fa5f9ab3ee8399e59bfcea3fb229bbd827f43bea5ba6f57ff8eb983f1deabfbcacacf83c38862f281e040f4f3abdf0b1a77b152d8f6d92077929fe5bcf42c913d22a5f632abafc4e2b66c7eaba56b38dec64ef9b1532cac98cbaba5f4e9430e82cbc72a68f7bb13d6b30939b7174fde0a589f0efab0513f8ff4905755bf723bd46247df3e7afbe0a39f3f3b21af0cf7225be7e5beab849a5243e5551047eafe6260f4ab8adbe45a5f2141ff45ef9966bf780077cb1ef650dbd001e71ac98ea64e078acbb70d5a4f23f8d8cc05a862fb5283b148de72b21da5cab311e38a8eac632bd772837a3343cfd49f530c90ae5cb68748df31f3ee1a5f96f5122c2d088b3ff748beca6909ce01b3479b9ec84671bc2cac64bf9e0a173474045fc1593f8d68fc8d5f4febf7f433f86398cb51b8e35f2615a3a996e1be7c6fa9ebabbc07fae01cadf4c2f1a639798871add33eb43954148fb3e888b2ee91e8819f8161c867153f2ef0cc01cd4bd5630a7cd31b301b8ea55746f7d0d84c7ce4844f2ef45a8d9f49f782bc975e7f955b5aefe4ff0e503f662dcb49500db2a815cf60df805953da7c5e27efd6eae8b9275f8efc438ac1dd6d1b3c839a9309cf9e7e631c66ff22dca2a19f5a839f3a03f6d0d69a7d4f47c66a46cfc79f73ff34f608602ebafd58afed22a621f891052fb4aa151bdee649c8cfc8fc6d8a87ee004cc4c0170e50836b7985cf713adabcbef4ce175dcc01c5358c5c18304aa385c631423b80f5a337aa0720e49d4d491e98b91b5edd083f1cfd3dc9373d707eb0879a459ffb71e0fb2a6e42c6647a2b607982e384638fa3e2aace6f042b5783fe93e12d45bebf7fdefeea3503d6a8931a5b26d4eea2f96273ac8dce36c7f520eb75937b1dc817d3631ade7b1ebdc813c57fb9b8ba77eb3b364f26f9727dbfc27d95e071499ad7c2f3e881ef2491d7513827d10fd3ef921d837f8c1e89048d73da1fb88f0dfb97d1bfcbdd3c7e2fbdd5475fc6ba7df54cc8030f697ef1799db72d7cf63a6beb9d0ea36547384e99a7099aede473b699cb4334fe586bdbf0559a7b63de0a7bad35b17fc35f04a740fecfcf57ecb3e1ef345fc17b77f8906bbeeaf3d49bf9d9e0fbeb9a35de61bc47275bc9299c56691630af7c6837cd7bab962601b041749dc7eaf9f41afa6a2eedcd6fc157e9e53014ac68a6afb9f8d02fb5ea6406f0e1d2a705f0276447e7e82d1b9e33db4eb1cd75eaaefbb047ce867344b98775d5df6ebe728be83e00ae753b9e6fbd44f6b1d6b21de9d9ab457147bf60ef3ed38df3a7556744df08be0fdc9f7eceafaee027c5678e6a9f7c74db71ec9ac1a1574bed9f57fb8285e7a8f46ee5f450fb989deb9c137c80ae91c9eba99c8aab732aeafaaebabe2358af4c946783f50514ff07b06c4bcd05b2f8817e40e6bbe9867566f2cb35872863226ee051f675f421f6b87613de9f6f3edb57adda35d6b6eacdde54ba8b2cbd1b8de7616eaea1c5ed65737b082f24f7cdce10e772ffe2cddad6181c2d8701eb2fa0dfe55e1f16f32aad26dd60c118f87467332889ee0bffcc767a2278aeb3f46924d6ccd053c638c90f8dede7bad904359492707e082fa16041ebbddd8ad6a24ffeced3671a38a1c622a9f6adfd1aa371239a28219f780e5ae4d3ede07c4ad54d7d58e1a94a7de06e50dba7757b0ac6bee893714fafbfb03e47bd2a63f8ccc9d793fa51023761e88b7aeb4fbac7a1f4cdf0f8711faffa19f52e1ebfdcf657a5795c8f2f6e4a3f3b8d39b06b977aafafc6ac71117da6e3badfc27133e0a8b68c4dc05e4fc7e97b8de431d96f37b1eb4d5f7d72e63d3a603c6aee117398e2e00eb0d433a5fb285c67e1319c5390fe2433778258ffde939ed3a80f8d5e1df018aeb95608ac88e254aae417cadf2a27e39572ceae82f8e5e2a0d7aa1de6031bd078d9482f3473bd6d87383ffa50d45e4df133b8ad69dff4f68c8b646e25f86ecf58274b7e076a4e2a7eddd9a8b03be2fb9873e4e3ae87adecb22af8a515fc62c13b6de833e0f01a829181ee79eef4edf9fa0a07a97319a2d1b1a1f99925db36698be6dd58634bced5a27acf3d0f9a783c6fde970f6ade9c423edff9067d4a312ed7cd466a4bccffabb16137f9dc799e2236c37beda2963335bce155e6c486af755c0fc07faf7cfa4a6e064ef0d1b8e06459f3db1a27ba0f173e26c5424bc621943ac89bce84eba8cffe03c5a5610f1fd6710ed862e6ba48c4474b7f02f89caef1e2637c17fac03ef72af1fbe5632dac711f7d32e48333768c7c6fb15b8c139d9b35853d5cecda5ed935d139497d583c091f7bae057727f0cf5e4769ac89ed2ff435d3359ee89acaa7ad6da42740e6848ef5307e8c89461fe06d6ca1d6c50a751262f11ac5625b7aee75b538de4e181760e396c83e2daeec1dc62562571813f3efac4dc687b5b31dd8fd1ef9886770139e264a9f608b7c45bdd7f9bbeb93ec723f6bd99bcb187e5a57c64eb8844e32f7e89ce3f96fae5168df810786ee97ef03fa1eb11a59a5f098e9158efb4ad3fb45b6ed2fdaafc9972f8fe15bd52feb7d25388dabab23f86e7f79fe6c8c9a5c39c7b532da7733d0a3b81e27e87075295e2c20077a9c0db9af19eba046314d793370b536a0e878cbfa96bfebfd167d785eafad7b76e6d2b3d3a17101ce3b805614b84960afe7b63607fb25fefa2fe627819f942b44fe2e1a6e1f57da07e8d10cb9eee87ed9f73d058314e28db7c3047e6dd3c17ad31801e8c2185c8c858b982376ecb3665b841b78cdf16497f68c6236cd9a90c24db6844deaf7ca0bc47bd679d6d552b8cf55848728da7bc276e4793bf1f51a87e685c19e2abba5f182ec1f1ec53fac6dacb966631e8e9534ef33b216119b706f51022ef62cebcdc6d079669f7e9173976abef4fe722d93d7d81d3ec8d06e2c8307e6ab88f47db09f2335debc8eadedfd37e4fa413dac12e694d8eff28cafe16d643d05d089144d0eb576d57ec5c761ad925fb3215b1a398ef635955312be50ae23b5f23f47f3643cd67bea01f9a10bb467ea5deb7e5df030496f60642de399e8be153660eb75857bafc17503e68f464f02f35df785ab2f6ff92662fb8daf095bdab6ed8ad90bc6e7f8b127a9dd59b90df885930ef2783be63bf07a1fcce38ad8accf7b01d9ee00be1fe25e608d02c4e0f309f7a53d3ae659c897c45a1a35993fd33a3f7092d63db9bb73ec5ae511c5968362807de7de7581d1e5781cf3accfbc4d85f867da96ccae6c09e7ccd4dc6d534c06cc64097b1efc12e027798f31f9538febf90b8a856789f3a58f7c35f9946c9759bb09beac794e68a25abef622d453017e8a624bee996fb27d600d1e5ea3bd37e4cab343e9eb70aac2a5873d2daf6374ee81e53ec4ecb15d2a1fe8f930a7a4e6c23c8d26f6239bf872203fc39a3bfd25e6d328fde684736d1ae5cc44ed51f14be07a0ac72ecfb1aa7d8f91159d67c9a75e5c27c17f024716f29c584bc1e495d7c74538aac2fc286b38e7ca2a37a07113461b60afb5e8eb7370f7aafea8d4917d7be12f3f428f27ced727f5a8504f377e4d8ad719effa4bb8af2c8dfb16f74f6c19b391428da3b3a818cdbc8ce848a21eadd617d98a8bb20b36466bd64d2da08d94658e36e63b32b1d52fd14244bc56180d3bc2313fe876166347f29b9fcc9d3fd3bc731be8a1d0bd931d3d0a279589ddcc310a277ae2b8c2d283dcf659f395f17c2117582a63eb02b93a27f8c9f7e83cd179c2757c417f989d2b3c6accd3a55efaf83be7712c7dbe0de3ee7a121bf3fc7e4dc275dfed654a57177abd5a3ae7c0af9476c83f513c5016ce2d1f3d7d34b6cbc166008c11d7bf98f318f8a827a5a710e323b6b0dcf073c897227ba0f66a8ba35cafa725c741748f5c0fc1deb7f98ad76e7ff79c82eb63bb33ec3e72ed04cf895a0e782cf99e23fc2cc14fcdf15ad571720763f2426bb9f118e27c81ab7cc4be668f4142cf17f921747faac627bfeb1eb192d46e8503ec1eb76f673489f4a665292ee33e15f3de2ac20164fce3f7d58e7b48e9dffdc829bbe4f1cb3d96c0d595a5bda8f6f4c9b9b4c90a7165ac0ecdfb2fde056cfa103d889af717f8ced49b3b3e5f8db1fa9cfc0cda8719b3a8fd44e1b27df29603e0b22ec3ee834f7ff7476473e9fe4c9e218e3b62ae6ae6ad474e6b873e12f26d1547eff2e48efdab7be07372bf09744b8bf15860edcbb3bfa187d717ec63009e37f49ad01c9ac4b40ab4469cce81970f0acba96afccab6ea7b621eb62df97f1a9f7e343a46956bfd0765a7cd787da1bed9472dfc0edf2cd725f53e903bfa7de82b61ed2f3b64cf691feb3eeaf71edfb3aee697bd6f0f9a662f153d97ab35c5dca7099f3faa7b7b14bd25de73cae45b74a4778463dbb1f187d57a4eeeeb05a753e438bb979a390c6ef7984bcf0d5febd63542ad3d7e5f53d493685dd27be3fc6cb8966c1d3df8d5e139e53cad5da0b888381f76d30e61cf567f931c8fd98f3517fe66ec18bdaa8fb1f299c877c3fb303506d409f97cb47fd27e6cef8db6de95fd7df285f2ba9737f67eb2058a89df35c77ce85358fe05f8a12fd2f33b51be6ab45fd6fa3ccab7fe847a1930d96457f53326f8bd6dc61af2f70afa9afa7e441f70d06db4d579e419583fb1b3d0e730381a19cf2c9e47e7b9545ecdf2cdd43b006710b8012866ab2bdd22fb331a5bf48471dfdb04eb52f1a4476d2f73c402e3a7e77f446be033c5bc19ce78257135fc0b3577b674ae7cd23b7d5f3c06d02389faa68f2df8ab63ebefcdae4b315263837bfceed8762a00c686c74c7827aefde25b5c71b7f6cc77d57779eb39eaed0e5d5b620cd9c7a3f7216b26c93fc7bae5b8251acbc46db1c4c481c1875fadb75d78dd1be3f37bf798702f747decaf38cfedf7f0f094c8bb66fab8affc9a9fa11d0876e44b2c86a8e38abf31c3de8977d346eea5f4120c5edd027c32e68ed5df73df36c04fe15e0639b691dfdde3daf03adf7a4ee169915e88d26e46b6a53ae82d44f362d5f1e1078e2fe2ef888ecac9f60de1a7aeb07e47e90ed742d4e7b4cf9fcc79a03b096e47f99bccaf767106be03d1d2e8d2dfdbbbe053fc2adc5fd07db57e96cf2ff41ccb49de1c971a763b693e27efd9349fe7729ff89962a985f2d37ee9fb402dd6fa1c356c07ef513d2bc74bfa5969bfc6bcedabe721ff1bf64bce334a0d96c865ab7b3c0c9603d4dc077c6c58d72d0fd433209f08dc87f24bf85980b9a17190f3978057e804a340ceafed6da79875592b4beec91939a6e6af8e8bfc1d9886f9f0f5762f7575597627a506adb518cfd82bef1df27df059857902bd876deaa2ef3393da3dbdbbc51bf600e6540fc77b43d7a0f7d3e6bf3d79d8535233f293a6616fa1eed55ebc05a1fe87e4962aadcca9bcfa80cefd99ffbdac1dfe77be3ebe2ff5b96659d1f252310cf2fd05aca167c9bb2bcd8411b8ec531dbc43f2f3d6befa1dfec4d9f34f3bf0ef73ae93d6e6bb5f56bf8363cedb8e8bd90d7f4f6cdd193876fd0ee1af8c7dfb18730eeb988ee3ad6e71c05fe74ac0bbf599baca65939f14e6b2dfb1a7a83cc38dfca1a557263aea74ff622bc27df780cff57910fbd83d06d273eb48ceb814edb7608e4ac913e93981bc5598e361cc7bc6cae9dce5f9dcea778e3ed36af131fbc59c4aa27b65f56e1fe21c6a56cf8399c31f569f53f87787b12637eac7361ef825dae325f5e51af799669783f977bc1d1c3f05b7f63ba95f5979352b8e19cfddd8df58c7c4ae3369bf6843fbc97200ae7e3b6fd7ca9ed53e23f58e336a2abbd8319bc831e815b0722ae82330b906bd5728de91e4fe6393d359306710fdaef73bdc1f7263e813807655acaff62dcc85283d12f4ead8badf91fa34e30d980fcf0979290d4e82b1eaa3b9fb3ed05ce5b7faf91272fc66ae141f386eb17bb01ac0d85c8eebfab9005f77f6552c9fbf900329a17f816c76e9b41eaf4e9b5177929178af918186c7307ddad17b9d7db11e4a6157694eaf342e7ba5e9b9563839bd52793c0a4e4b6078baeec39ff8b797cbfc594d4d36d5c21be33b35befaebf52498fede51ae73ce6eaaf913b045060f25da7299273a57b6d73b66f17b5561c2c1215769d2778a1bc623e16ff55c9eb92ebace5bfea3d378e9157619f4be7de5d063769af40a2eff5e0f4e978aff11e7b83885f860b386f2a26d2258680f7cf81417dfd29d99e462baccb9b2433655ebeaed2d6d1060d7183bedf9013824335f883ba258a57a8c73636a6c4b53389355ccbbba858bab3451d7cbf89594ca6792dff565612abcc24b10eb0980cf1f54cfa20fafaf877e26a9693247fbba963bba15e09e4ddc22c7d99c01d8d7741c1cc3d6f1b1dc831c7d5e2f8ecfd6fa1256ad98f329469bd3d218e21c479cdb8273aa996c944782f925ab9a4f00b829eeb1d2eb89396d779cf7a1b19cf64d6ebf0d6c913e266b6a45ca5e8c72928b1ecd9d23f6ab5101b622ac9f8ee6a7b3ca0b47f778be87c5968f671c75906dc276296e8f8af4fe012f52a1e3e714e773df5f051a1b513e108a3f44379e7919c85e099f6d648ccbf13136e37853f7761bd1b1466d73504cf0a398534ce12ad92733ebf429c471e6859b85e619ee1b39fabeead161febf2b4c623b9243509f6da27338820be71e7e0b0306fd04c7e856622e31cf2ff8c66bdb01de55f1c3c67da3c6bfa8b6f2c020303f6bb5c4dce0a98aec878f145f2feaf33c9e2199bf0ab5dfee548fc52b6330691eb6c2314bd9b9196080aeecbbcdad0d6e995e1e98969f9fa26728b5493f5ae3e3675bce9c51f7c8f5a02a8f4bc68ccb177d1f6b67c039b045b6cf5a01a7809f71994d23ff646907613f427ef8cc7c06ddb8d69170960cd947a6df396fd2b134f3e41ac2197bca8c99978f6bd417b25d6e9df1f18563a584da720cd79ccfd2dcb674d652fd0cfa38b8ffead5a5b5dac77ce1be4585abde0a3ffd9139fd18e75aaa319ecd68aa35c1973edb420795e293cdb8845c26f07d0f21775e6a30011665e0cb388dcfb29efb17d7eda62d1ec2562171bc8cbe51f3f4011b83b9857b1f5fccbdbb728d5988f5cf45ec2be2f9aab5c671bf0a3788dc4061cbc7c4fa85fb67d47631dec7fd385d9e739f7037bba0f512f07ac373af34df9d47cf7d8dff477f482fc53caf9df1f2c8d7b07ec77d5ec64b70e23a7ccf11dc3ce74e5fc07d46ef80e224be06f08a477083dfd2c2c8857dbe913e8e33f7d5bf666d1d27ee7fa6fd642bfd782a5e634e1beefb43fd0a761536846be7118d66c156303fc068217822f84a9893ec3346f4da18337935c674beeb9eec12e3e71fe93930b7ceb1b905fff39b5e4f6f73dd1f68e96b961abb11c57f5ce7fa467f58f2a3ea1de41b780799f26bfecf7a2eb3a8cd1f7cd1160346fc3e778b5dc74dc48fa34f3aaabb1924ad8581b2355e7396884fd7ef29aa436ff4fca21ad78abbad9b776bb84645742a656db6dc14f32c37b576a7839e0deef38ad890b497a178e6cc3d4b14538d5c70189d66e8a35576dde1da308d85aeebc26e096e86fd851972d6d16bb996eea5a5efd906c76116ba9ea8b5822b49639e957f63eb21cea4dfa3d48ed8400b9ff6678d6c297ab3fae06201b695efe9e86b3f9afb644b1dd638452fe70ddeb9b0a72bc4e22562d2b847f8756771073a884702d5cf7ad0faab5de6d951f5ad5777df75b6111e3ca5551c62f344f7f4ac30ecd6e701c6ea47523cc3f12e34c862dcba15857b0fb1c38b9fa36057b5b90a4d6c55c2bdada33c8efe2cfe598859a798e9065758c01cd077ea5a1f760ca67a76b50f7c87932467c5e9c8cfb27dbd73fc97a9dfdec4c44778c830b67c4edd77ab753f15a707f0f3893126e35b759e3af4b5c1adc7eb57c5a79135fc9ef89d0ee37054ad03636a7894c19366735f817b28096bc5364cf5e0dce47680cfa3c626aa25121bcb4bdee045623890d3ed675e247e87ecc2c97e7fd75c01eaf9b876815ebe9bfa52d376387fec31b87acf610c9430ceaf5233bb7a379a03f7fad92c9c5ec1e200e39e2be88c0333901b60fd6aee45da9bc7ab3747f542e9fabfaf8ef9b79f4f6bd5dafb27edb55a7bd8aed5a15fea02fcc787aa1d4734b4194f327012b001ff0fddd3634c6355ee51735bc5eed1eac36d437bd0f67d02ad1f3d81fe719afb55ae798f395e802f73a2f5fd28f16449e1395bf98df096c7d66393fcb72bcdf5dbfe43c40f69b92a2fe14ade101c1a2a0f4f3e1de77ad538b0af7c471f507c8f48fe52c68afda23b363891133032c7b56eb460f7aabac739c6d794c0e7a8394523791f8bfbcf012e0bbe50b3d0786bbd0a47f90d7d4df8faadb02f9363fc197a702b25e8cf771e06dd9af07516b63efa10bdc0d9804fe59a973498406b807511e33e236b782a0d39d121dfd74bdecf11fc148d3f7c55dc96a9c789d6b58dd83d7fc63196f12dc4df7580f7e0de4dd888257ce359bbd5863612d90d9aabf1fe39d61351f94ce1919b86b632273ca40ab316ebbd09b1bfac2da9fcdc6bad41abb6dad9d91c43cd4162aefe14d76bd07c7f496bfa67a4ef37b491995aeec61a537bade8648a7e83f0cc76c25e6e7d0c6c40c83d676a96aaf7bb3dbaac7dbdf7a147199ce8f8b953cc3c89ee32ef2b7e956cca98e2ba491e3dd9d933b0421a53034d3865cb5f06aa76a46ca38fbe0d756fe01c78f2c27b150db1d5dfd1fd6deb58dfe043857b06fe26e7ad389f10c6d3f92df887de53f94cbd9bf9d96fd2dcec3e64fbd20328dcdc17cd37a2627deeab454d2118f53bb42ea03d0e4d9952dbe06dc7ac7785dad0747365cf627aecd037fdd21a05793750fcc9cd417752f82c3dafbde0618dfac3a0b9a5d86efac3ebbd3c543a9a636ccb3dc54368011626093d6b569eedaae7dbce6d05c1e462d52f7de6703cf0fae05e07e619e69c05730d4337a2d401c6a4a5789a1ccca37231ce95363b909dd1bd1f19e45d990fadb59dc5f71b9bef5963d7fae7e027e27fc5bf331fa7dfb8971e7a83a3f4db46611c667de8ace07e5a3b5aaf03e7dd774e14cf9d6a3456efe9c9664073fd7dde3f8e4bd39f2360e5e97eaabdc661fcba3ee1b331b4edb1275ed63417cae447f01c3dd2bc9d87bc696f97b1cb78d8ac078c336c008df3bbc2db0c51af4c196eb5a7be6830fd047f4cb5f7b6f9040ef2b2f1693d2c68deeff0f97b8ae28a5e7949fb4dfa7da5b532763bf88fc3eec353bfd77146979dfece933ccf0b7aba90fb7b8a3f5f99eced28fdfc546b426312f171dc1f3c26f88835cd7de3182ee9ebf73b47ac4afbf3a92c3953f4bb81b7f3526fe5cf929b9d09b7fa7242b6ea140c56a841d60c3f1163613076b8cfa607ade6cb1bf34c3d300e7dd89d705ffbe812f93c3dec36043b6c38c11ead77ef901d292b8d3c7069e6b3b54b3fcbbd3ba89db1e6888c0ff25c9163313ef1bc08c6a75813bfa114edbbbed2adefd23ba7fb46cf1ad953f86697417b00ec20efa914cbd9fc71ba8690bdcf47709aa3f7fd9adf0b3cc9410a5c04e138205f148e2f7392a626723ff9b7edb0f79cf59686a382e71bcf6765c724e7349dd5728bfbe7f03de61a608ecb857cb72dc75f9f9fdfdbdd7bdadafaacc3dc89d707f9a44ff053dacbce76d8159cef4dff8cb953b28869369fc5cef603dfe7eb752eb779f798afd6158dcf59407e4ab87eef7f47f6fdee69c318e576647edfbfc712748cd49a2e960f9f05d880ef9eab80bd80ae39dd4479070681c5512ebc9656ff12e31264cccdfda3ce0b6de58e70d94b5fbdef714ea5cffc89b43f15425cb6aa6f00bf0d9e34f6ed25ff65738724f2da05d2fb96cfc678fb98c397e68e8c799ec7806d2930cee497bc853e681ef7b730bfd377999b6feebad595ac5fdec39b8f3bba8f9ed804701db336fd03f9630bd44f227f7fa5ef923dfa54eb1fc74ee4b86c9f7b0c1ff4eff85e5a7de78c7d7cd40df6ea9cd921fac3ecf380d330aa6f31aba7f2d014207b1dc9333f5dd57457bb551c2faf31b5a20f6a7273cae7605ca953f94edf55f32997427d0ee08645f728cb35ea4a119ca11dcdc9b5ae9ff3bbbebb0be0c380eba27a3e75844fc3d9d1cf5df01c8e8bd9cb50e1f2bc65c167ad2b5cc73fa5288ed9f49701ad69e87295c97e36c89f78f0554f1eef81de123a4c6fbfe0c37519db79a2e7a3bd35e0de68dec3bcf9337d678039d61b74798fa0cf3e8eb55c395b2d14c89f867660c71fd27a658ed46ef609ff8e7a3570860d06ad5bfc2dc24ba4f7efe87a9a48ed59f8c2b986daed5abce1f0b9255f99156da24767e476b06f9aba05eadaa626cd5aef6e6370532704d80f8a9b3b8500b14e25fdb1b9be665493bbc9eb3630f781b894fc1fc61a8e594bd7b21d25e18cbc3ec62d491d9ff961b79352647e56ec3a88c2de6487ddb0ffd8f65dc7f3ec1bed496b5e0bb9e991fc76702ded685e817393d604f9b28abb94fca3077083c5ae37b4f95525dfaf31b379f2a9647df11ed263bcdab1aab5b85acfec0f8ee64a4fe05cd63e11f850e73a7f807cb419cfd7671d77ceeaaf1ff0edb3357fe16bff4ff62ae63db9542e16a76df1e1d78862e1bed2e91ab2de90f783fbc2f475acef56a37cb847f41009bfcaee30e880eb187cf9ac9f4bbeafda3ffc901b727c76d47bab716ebd8fd8a9083c9dfe3e6b67c216f9c8eba28f5db4736bbf10776b2e571a73ed17fa955496e619c5ebeaf8e87d711dfcc7f8bcfddbd77d5fb5a3d75c2a5fd9f7f498b15e28bf47df3c77b8a732174ce64f7a9f7adca3732a8f7e79d4af3b1bae8b9ecb0a07fae157448ff45adbabb90b7bb95ef39b5ed33d547bb5dda4e930cf2cb00a919a7dd1d63c517be9dced49dde6b4a9b7cd5e2bf5e76639e2b3cbfc8c8e81ecedcc57c3b56b68b1d11e7be03c4e3ee457c35c927e98c2decaddd05ebf205faa407e69c6b77a29c1578671a2f1b1aee122de081813243a9d8b08bf33c7a3cde35a9f8f6be55893bd97d998fce106e2921edf0b7004345f2836575cd2f67cb07949d533292ca3fb2fbe9793d2af3be21c317f993518e96fbbd046ace053647e5873ecc9f237992740303a8b5f6c6f56d11a26de8fd23f78028f26f61be4393f539324dd305d330a63e6b6ee3d37dfb57393beb2013fbc79de079f21f7e914c90e38e8692ba366fab3df7ddc802f8475467bc0b5cacfaa275530d2d853bb6ee43370da8df2e13dcb67a7e0330f5f7a1cfe5e785b8327692858e525ec13ed95cc2d2e186bbcdb8e67f655e0acb967397014de5bc7baf2f3ea057823c19b97681d535caa70d04f4dd66c99f2393e97d9c348f5c2619c191f5faa31bf90f2439f92b51625aee77aeb391fd3c4438e737a5583d5fd04cc2f1de5e4061f37eb77a136dce91a8c0bb42eb6b64fcc988ab4ec914dc664b056b9de2f4573c0dd393d55afb73e737bcd48bf90bd6e678cb5245f0b1823dac3a278ec26f432f2465b42afa17a6af1342ad0b56c7efdb9c35c49161f975e6b216f376b89408b173c8ac2af243a5518db05f3ff7b4b35f7e0f71435b69cb9300cbed33a069fb15ef9c8df668772ee1bdce39d08f778051c1292973dabfabae409848f4463aab82f4e72b40d9abb59e05f29067ffbc55caa9c4f64ecf396b9cd9bb045335a23f0f3c0a5c5ba74c104fa213cd6b6161dd785670a4717c8dc121dd7f125bb15ad51bbe7143e871e77772ffaac27d8ba9bf354fc921093d06e46fda1c87cebc5e6167349c9188a0d42adc415fcb7fd19f9de5773c88fcf9b67e685ae46ea018a9b3bb257c1bf0076dc05ff6956b8d4060ed7bc0bb340cda73eb4f5a041af7401e83d9dc0ab46b1d4d5fe806beb633663073dcf81c31c96adec01eff77da975d3addc7161eb0f8b1da36fa0622cf8ea099a37f13102663fc13e5bb6631462bd67bdb0a7e2862601f3ee288e5cdd6f520617cb055c9156bfb83a46e625d624cdeb0df7d470cf37c5e6ca3fad879a69c007b8bc5f15a0af7d238f50b48e975ae555cf3470fe318d84ac8adb919f36be59b56bfa67ede725ff64d61af636ecfba136c2b8d212f832fbcbaeae9bd25ed907f7943587b1c6283e0776e8302a9ef479d027ccb6a16ae5391907da74b22aef35a73d650b0e724fe7d972de2fd17a4f8895d21f56ac144caab1b9063d7a9a933b73afb9d0bf37cfd37c70b9e6d3147c9f15a7407309be0c6c13e737b41d537188e8955afe11d9c42d6b2be931e45cc549e221e0d7611f54ef84e547ec75ecc03a1daf2ee2fbb5f45b8a3fd6b2ff9e3bddf4314c8ea6141d8786c2d744ae037b2b5c5ec05ae73897865cb563cf67e4aa1e39f75d555a4e9fa968ae8afbd66fe49e7ef7dcc84d55ce18e7b2d6e7620e7673adf99a796c75acf14ebf558b269f6ee289afdfb0058326dbea9f1a2713c5a43c44ea3c63c6d22e808b46fef846ddf3e1c7c807d6e8b4413ed5a3717d0f982be78cf890f153dd00d73db026835cff009c16d910fad7dbd50260e5b3f077adf50dfb17be33c5e1a16b97e40f8abe4d37d5a6f5e1303ebadede28bdf3abde9cc43de9376acc49b9411e0fa96f210e91fa21f06c4adf6ccd3c3e328ee77a4772229c67b3b8b7faad13c52779601c0fd038ed77dc891dff4c0a2ac6055ed7b22da3b47ba539cb9a24f36c5c2f64cf382b6b2d7e35a5e728599beae114eac341d3003d5e85c758ed2baa1f29b991e5a7603b1fa44facb3303edf2bfac2245fcafa45fe29a6d5f410593b117d1889bd22dae6f477f615a43ff439a6bdb40bb4efd441ef34f7c7cd36fc4cbdab35aafb5fbfe328becd63ac39ca806f8ed77e4ac9b506f29b2456f6a10fa3fa37b00f09ae9cfe0efd308333afa30e63b86b248f8c9a01b8f55b8afb0cb87c27e44beb2caa39e1f94dba7ec8f9b0d0e7cb7a2bd498c37ba1b94c3626c3f7213f67557fbee32b3e747a2f83d9a8d409389fae6a5c986f9357d7f039f6d11fb25058bed6ee4a2783ff3e4fd2ebbbc6c44d942ea7ea79bba5a3a86a0accc3c0bd33ffb22699a95f159c49c98bea9269fb12da9659c2b512ed46549398f3d3cc63022dbe77e6d3cb50acc67efe218c87a2bca8f56b0d03bb4f08b8c02373311b1bb05e1beeddd5183df2fd56fb941fa5141625079edfb17e5f6253d31fd73d23e0c512feac085f671f316b2eac2fd4d30de7ddc46b967f9d93fc98c60ee167b15f2f57e32d5c161bf6e1c7c6875fc4e3c033300763a331082dc6c50fda0fd1e374c52bce6371661cfb5e9f7b609f7ba9e2ba16f81add8dad61098dca77cdb58cebf60caf2ebf7badf9544feb586273166c6338a6e09391ded85d929f6a384050fb64de7357d6188f815a935a0792b95644ffd1153fc6e05cccdab479a3d98ee631b6e50d7ac7c977f4a577cc63edcb912f1c2ada268c7cce69c357db46fabde6f7f5c59197e47ee988d6b7e0c6878c0577e2b9898dc9e7d398932fc0bcfc169f4ae41d8e7c1513d1bef399bead536ff56e2a2dc571b46f4d621fe9678bf3c483a79b9e7fb4127e18d5a37d6bde6b3d3bf3bc55e85c9cb3ab4aa22fa67260e079624db193f4442c3f64fdcd77e41bb27f74f55cf1d819d893ebbe2eabae20793eda7b1b9c7357ffa2ee4671b0c961e81866534fe59f14566bcfbe076c10eb73190d9ff3e455f3c2cbfef8a575734aa7b0f7ab67b80a36e51c7a390387eb64af9927fa1bf7a9b2de4fc29e30bec51196bc27b09dfb1b7b02d76ffec53dc1f40e8a6627f608ce831487bdd98839038be0ed0a63f6506794fbfdbfefd1b844f373ec5bbed6662a97f4a0f7891afb0319ab1e9357f9a6c1554f05f7df614fb6ee55f2251fa269269f87793adc7bd4a7fc176df046c7834f58f71edb52ce37b13e85ee730577deb029fa95a2a51b7e57e9761e4dae19b8ae8570e4bd27d47b63353de8f12cc82f53fbc9d1477d56f4b1a7512cec6b56e5cc833df78ebe824375739ee40c8ed3eea544de6373eb6faa7f9ee65bff47ed75bdab71df813c476319d07d6fc1f305ee0f9f719d79c408d0b27ca3bd029ac06bf205a1e7f0c63a43de8afdf17595be3f29843e1ebf5735ff750cadfbe4c1d19494eb89706bc47090963fcfb63bde3f75ad7117de8bca5f0bce936261cc859b9cb1c81ddbe76eed1cce7fe40c976f30c8cb3b66decabf7f1fa17f26767bc47dde6ade206f06fb33c867f7a3d2021a23bb7e6f565331197e0767ccb62db97ca93b2d718dd34758e7c339b2691c33927777181550777fc0f7c1dbf1d6ef3de3e7257aeb076d5367d98675964661407691b12f4de8925ef1009839d34d6b8ea8b83fa6fa01d24e14d352cc3a51bb06bc5a942f1b5c959a3b1df17ab48e57887dff45e5f06cff81f97cae7bd4f53ce6fcb3e747ce5beac4cfabb4bdc131a4f981c1ddd27ebaa153c1fc5ea15f113057f67b00ddcaab781f35db5768128d2f37d681d166087164ba1e3e604ceb152fde753f8de457547c203aba1fbc7e59f335ced73bd33c92ccedaafc22c617b0fd5b589c9e81d6fcd96b6cfb58747fd0d746fb37de11b0b1e473b14ff510c3777b53d49b39fe6d6ef91d437f2aa225d4237fa0c9e73c6beed1560a58a667bf92ca307f69a5753c2abd60da7be43cefa905fbc813da73943e26738227e9f3f0f8d31a9ad8dca28a5f23d4b9973973cb6624714ad78467762578c0eff76fd6793eebb137bea5cc0fee77140e6f15e7d1350b4e88f50eb2d24f389d25e64dae393d2526b09e1f5cf2c2533273ea3d5907563fa0ed030b6e3f5d33fa54b44f63deeca54751f84462fd4d782fbf46520797f7d6dbcd629a574e17b9263aa7fe6cecdbf523992f141fd1bfa27d4531d039c4b7b723f3a2cac7d3fc6db20fe0d6bb6473d0fbda647cf3e5b65d38b976ffb0ee43d1ba966aeddce6259edbb12f8d47d3eed77738cfa472708873957de17e09535f66bf1d7dc37e54c387f6ee733dfdf143bf43633f600b0ca75f74ceb26ec19279407edcb411ece7853622f6ac5776827c28cb4ec027c2f33b567f5f92768d5e2b121b489f0bd6fc023119ad75737de0279017c398685be040576c28b6e0201a0deaf910cfa73261eca4ee9962c6a751748c1cc35b1f89f7618b2c4d52f65d1f696f98c22fb5eda170d3dbfcefe44adbbe50cfcdf8366762dcde847d5bd7b1ae9ca746df6f30173bf754b532be37df684d5ba5c95afeeaa5e4d95907b293fdd27b19db01e1cfbe24cf53e37f320e8bd71ff4e7686d0ec406ffbc5ee79e59e7c9767c6ad636d773a0632b6b742fbeecacce7dcead875d2ddcaf66641f6dadac50b33dfd91a5f8f45c4d8d7f5ef5c2d21c1abd7eb357aa5e49d100641b7ca3afd3d8648a0f787d6cefaf0fa9cfbd5ff2d91b3d9c6b9d37428d6a3ccfc66d36dd9fb5c696856d051adc4bf8130b60ad9f22f5f970dde3efbfd01ff7beb8cee32beef68de1b6a0f5759b0b596b71b57fe07afcce570eb0ba2a06a3f1cdebfc9ac5bdda91fa35eed9d46bad3e4f9e8709fc0e3da9cd3156089847eec5b26237d1d6d37cbf2760d5529cc7052f6f498fdb634a71e35afbdc2c8297c5b3dbf7366c86da0a13f9be8e1f37ea9df17a4dec698bc6828e853dd03de98e5a8f91fbadb4b2fbf7646e16ce1be99c178eb3f72c9aefb2f71a9db4077e37c8ed5cc7e9e1fba1f852ebd73e7d134b45f3373ee7e5cc7ddb5ab4aa87d201a714d6b3e9df969e0660da259679ddfc1cdc89a1b43f245ae8524fa0f7cb9c32610cd828f3de0e5d70da2325b6fad8851ae4863b8af6eebccac96ec0ab73e1dfcfdbd3fbd2e66b51b5ac6e26537bcd92af46c7a0a727ed810be5acf9032adccfc4bdff1b3b36a398437a59cecc87b2b779f7193b43762f1aebb17e17d77ea12de3b561fb62b15fae1c6a0e3376c6f016a4d937c69c60ec7f7e63c5b73f275acf13f5b0d04eb937f003714d846ca86dfc78414e9f3962babbc0aafd618fdd583c127a9f9d81a78cc688394e181f6e8d11f77a761fb5cf12b1171cfff3dc935c0970903496eca709b79c837e13db1629eec3abf8388befa0cfe3cbd4fac45fee9e8dde26e254a3d10dede7ebfa48dc96bd4deabd7b79edd975ac87bc7acf8e310db75436da97293c6bcaa6235f4dcf8d7e0ec64af1775aa947a94d24dc97c2fadfec2d0eeb11aa472b1ac398b55c3f97e9bbb0ebca6632e684f542855f3e15406f1afa03864b9e3907d5de0b2d10e861d6291ed5c72bcef74cc8fdde109ef8e6c701bcb4d73d988cbd3b8c0b511e5ef014bfa7ed9a94d1e6d9dee2d2e73d3d8a15b7b5bb16d0291ae64e8ff5ce0e390370abeca08ba6f545aa21e7db0fadb7835ef0fedc5d9abd3977f46dcd36756c44e385759d684e7bfe03c59b6483640f22bb34cd8e2f2ed939d68eda0aae7191846bdcc4b54668ac357e6957b1741d0d97fe127a1fa87938961f253a72d0dee035857e9eb9bb8b6980b1e6fdb03b60ed96be8ffc7f193f4b7f5b3312533dd1f1dc4bec2d51f3ddedd03b3f29ecd2114e13ec07312ef711700f4aeb2182b590fc1c6bbad0dc6e6b7d02f4d4d6e66b708aefedf1ae77bd88469e68c1d87c9bdcf76e7374d2588da7ed6567d5e8cd8ef40cebdeea046db10dddbf5f5d6ec8b77f587bd018431dc07ff80b5a645fac2fb6f52b05d61ea3b1357beed6d6c2d1fe4995df8ff0062b8e38cdcd9e1d4acf87d62dddd79197661c833b1ba63ada3eeeab05c9578f5f9335a0461dcd059085a6d891fc11b50eca468f696c69494a0dfff4081f8ffd6c558f43ceddeb3d63dee526dd327a7cb087a580add67c0ea357f778a5ef46fed1a879da86b9e2e3af91d45b5df44c45f7bbc5cf496b97f079f06874aff1ac17d77d4fd718a7497ed8157f15d629e6f5a7d9e36a7ba3dd50186c06bd098d49cd5ca3031b443e1ab07ce483447206d0ccfbecec667f434b0abd605b6d0bc88ff8b3d6ca6edf57cffb3ef24b05ad21e76c2bc50f4befab7d5feb8baedd3ccbbe37a175a1397b3dc6cd0f16e6bd95da5cb7c3b9fbe054eaece6ec23b36fc6fde3cb72b0c0382a3d26a505016ec0d83974dd30d48b90dcb3d63168e8318df7a19b677798230f18dd1b3a0a6bd1e47c0cb53572465fee18d16a37f7b6a0f7963d56b85e8b79541808beff34a77158a16f7e24b8d7d9043e3cec1b345ae16bb88ee09e31268acfa362f352876b0378929db22f77de35e31b133530c9a66ec9d744dd0ff7c39ceef778ef593b6539bda3af31db612fbc73bf3a86d8824b8bf9035c5adbd0fa8d695f8d0d9ffe7dceb408076731aa6703fffb533415c817384579ee5f19bbbf794fda73c0875cd4363148e6a3bab157c7b16abfc9216c6c9bc28248ae45b0b2e66f4dcd817da7b61ff2ab3871acf0f4cef5f6c297a56b58da2f7ae47857739f6bcd1f5b83a75a2c6f273dcf7c7687a35ce705e3fe9dbd2798f703fc5a440724bc77e81c710efeab95cc7bce18eab4e8c426ea53183ef344bbacb0858b086fed58f06cec5fddd28d650cb37063d83c9267600268ae4b8fe0252f1c635aab32599f22e4c389f71a43bf5373b1b7b7e49fbdcd079cff637cc8ae068e0227fef969036cd1d8bfa37dc33561f4ab2db6cd73c801c7cfadb0c00a5bb0646c416137eeb1efdb0066603c9e67c7233fbbaa965e0c8680ecb13fea06ce5751e90330bf7581de277864f07e4ecb213090c018d0bcfb4dccc2af418fe66e97e2c6d4e2a7dd2f3a487f24f52ff03a48c835e3b94c4c15c32a733fc9276295e2c009f504163f048f827cd504f9805f5e40bed4799aec03da58a52ef9d3afc0062fe83b8ecffebcf0c96fde531db277e8c145ee0cf84ba35d8f3dc697f8cb33ba4196e6e8466bd2cbb36ffdeed9fb35a47f7bc00b3107b2e29f253b095fa2df75b2f7e266cd157367fdae043b467b4b6be3bca7cb239a57aa3e329bd4698ef6a1d9d0e9349bedb7c3a8f782de18d65385df596f7a143782c3927caf4eb9cc3c1be09a625ec158eec7a758e9066e97f170e0fa627c6af991f752d632013f51b0d7fa6b92b33136ecaa4761a8fbc9d9b7363c612a96e43c9b3dde7b1e6f3a27c69bc68b7b99b1be87115efad0972f8bdef5357e866c2ff81ed16b709b7fc1bc339d0304af196cdce673a9fdeb29f317d9e75363749b67e1360798c6da2abcc9b3d1201b686c2a74676dded45636eeef9b1a0cb8acd0c707ed8aabbd2faa132431e915be87df097cf87425d45ebc205e436f26d7e4bbc0c8928f13c691764e9af39be213bfd8eb98fd4ac569a47219a8371416362718e3d3d1af1ca9e1c4f7dbab5c76429e596329370975f407e6ff959cf5c3cd9c35d9228cc5938ec9ab25851d149e77f4facc07cdd902713ceb00f89ee0c1563b3efe7da5e2cde649e5913791fceb80e630c58ae75ef1c1173f40fcf828cedcd29b4f9767e37c617bb30ef11a9b6f2efa62e0f38df5f807a2f9126aa0a256c1fd2d9c17546b91bf77bd4f833373dc9cd25eabb9ea98c7c5fece1557206ae7117eaea67ddd9373fdaeaff4a24ccc45f344690d689cb9e6e60ef8b9145fb7e1b7515c8d764f5456715ab03fa9e690d60bcb564b135ac32f65e676bbc73dcc35cfecefd6d3d334e78ecdeea3f257ae358d69cd3eddf5590d6ecbd43d995babaff9a84b35601bf7614e15797e7e9617d1de5e600e9be71ea85a1dcfa7dbb97bc60fbcff06fe8f791c62cffbc1cff8f1e3ba7e4b36525fbf2bc77e8b39d4782cdecf1ef7a80fa03f7b80dee8e62d3fdfc233d8fc79b9d006a11781f3083da5c5caf57693f7653ceb98f6a149b771a077a3b946b2376b5c12171dc1834d73de255f93ee7db01933f725f36a641536fed631b7cfddcc63ee687d2ae19a29717f96fe4cf55b37788f163e2dab971073a419e1155c877d1be0313c85fe6e241f6caf47da63bbee51af2fa54b667a299803326fd90be15e67cd8f08de3ac219e9f875ecf34be7cf7a2e0bdebe5d7d7e52ff1e0fb019a316c53cafaab722fade4c0d06bd1655b577d3b87a9dbc5b8be58775cf4194a3d2cfdb1c95a616d2759d9f986323e6387c88ea1de4a40f9af56c9af953446ba198b7b57290cfba33ce9935eb6ed3da66bc09cd5365d794a69be1ece2e7b1f8c6c1a9048c4c12a7978f7a22e762056b8973830f479f734ddf59720ea3d8990d8c5dd0b674c63c1fe8eb611e8a4e4df73b82a762566f16c0ed885ad801b9c7a4de9f918b5ef160f2eecf0ef56e39a81476418f6304d456f2e49fe7ed1ed83478980c7644bd47ad6300ade9d139aab95051eb486bb7496da55157dc10bfcbf7ae353122fc1316f7107a2196f03558dbe0d5fdf925bdf318c7b9e21c8bf478da7dabf56659695f0629d447985fded76b9539be0e511e15f0da79866f5edfcf48f5625673b36d1d580d8b4b0e35c37e9a7bc7c049b650f72435aff30eb6cfee819f4d7a8d83c62fd5cf33a527d0df5bcfbce6dea76296fb1a25c618388863fb57dc1919e6cee0e364cd15c9270066e582dc1dfc02d694663ede0fd4db651f101c748433836c912bda142a6e13ec3e63eb687e722fdb44fedd4d9afcefaf3ef2e08cc7cfabbec181a38ee79f997b92c70e7c5cdc8bfa8b7bcb579c6359315e1b1ae3b947a9359dc3fb1be4688ff2d53be05cd4b52fa6e7ded5dec5dc4ec95cae37b9ca237c9fb7fc09337718dbd352d7b774ad050765d7c1e7161e45619eec78e99b3de287b24bdff4e8c217f1bee7f93c9f7ee29dc227c6bf9514d7b457834ea0d7ed96df177a22d5fbe29a363423f3aad7bbc4beb5704de64ec2b9397f610cb9c5cb79436f9cf373662fd37c5056ee0ef81db9c7f9b3ba97f5f14bb08d3ef9960fd25f16f2d58cfdb8bd081c8c058feb328bbe345a7f9357a53fd9e4d838a7b4117a3bc7e49bf4bbcc716de79ca82fc1bd989a5b52f943b4ffd3182dcc981bbff8c3f299b97749e122e133710caf7e0ff7eab00f52703313708b766b57fc3cb1e70d1230ebcc9d229a24893d4dc06d01b799e6be5fd3c73fdd54d4da4ee47a0a50d7b5399e1614ff2bfb7489f312a087bf5cc3df6c2ea98a85b3fd449d18738ded2e3032fcaf3ecf59b0efe0437d6cd33da9efab39b732b665a3e6eace9e13b13e7ad6e7119e9ed8fbbbe2728efbdadfc64666eeb4d53b784f4fd643e971fedbeff1fa5ca1cff6553a3d0cecfe4e959f862607ed475be12658fbb2a6677a4dfb868bb2c0bcb77e6ccddfe8c957717eb32cebbf6db81eb8eec4f583e5236b6dda76726cf7b615321adb153b3f30ed3a1792114c0c73866781117a7a4f9caf33d1a48ad40ac7716e148b532ff3632475d9007dfcef29fafd6cf82edaa3f36ccfb8d1e8583d0d614b538c1d8d3d37ed47e00668b9aa47dddbdec05a5f71a2c09e316f21cfc5582f8570ccffbce2236a698e09e13a6d8773255e6350fef4cce627da87fc308b6d2be4abd9d318cb9ab7fc248ad5503b102eadf9668f1eb108c783330b98a397e64ec8ef72525c9b6bc3f3728bdbc1ccd3906b197d4e88c5a111b83531346c418eb9868033616eb941ac2f5ef5df0b0738bdd7687c11e5154c7e3fb0c5c0e24431bf56ee9afd44e620237b6338d4840fe7503f6b9bf480fb345c25766e3091a741f6bab00fd6e2dc9e303e36c3d78a62decd38a06779e4a998cdf3c1ef883ddad2854ada4768eef5956f21df593f4a7faedde39d61ec7df23e74b238fac0f1cc7bd7c8d37cd5c00da36fd3ddfada06f17538a7a48eb53862f4b1dac6a9630fdd7359e9437fd07ce84c38f651380c1e7fceb5f29ee008470bfd3de49be5cfc0d5caf90ce00953e1f711ef7c291c94599378f7b44e90db46ed4effcb58288373fa98a22e8bbcb2a7ed67ee05f7391f9e5f16fde549ffacb48e1b8709b8a24517d91de7f4e75c4f9a46f4928bf419c5875c33543f73cd1df3428ec3bca498e565ce5882547baa7193742f6e7fe96e26b917d48316f0f9bce22ea0b818b5a58bfcdc397a45e9e3fa2c35f04e5d2fc67f72c3062d4741426f2fc76e21f759721febb3ee2f507524a3abb30f7b134ced787db3be9c9af1185d73150431fd5b70f2589ad43ee7a5223ad415c9c36c6e682173afe50d4e8478cd408d83ae8de318ce9ddd3847c2bdd29ce7b84bfa9799333fd425becd99777d1ec6c5695e2c8ac13bfb6fce63bdbb67ee2b48e656c9dabac3d072fbab025cf2caba7fd4a699e76dfd17d9ffbdc99173cd64f6789debd1b658f0c04a073959bf52e57384a3ec843e4cf8dbe8298af750014ba6f3e711ed9c9abf40fc70cb9f0b429c0acd0b15b381937d94a2b813bd0b26fe8c702c739d093dc0936eb0601f244d7b206b8d4edc21ea27985bf82cb7fd552d4a5e6458a4bd05f159b7f198809104070672368bcfce6436ec7dfcaa5c446f0cc757948617d7f554bda4debeaa05727fb9e40336ad96f3906d066ff596af3ee3fd3bac05d5db1fea7ce1bdd4bb63f9cc9d60cf394c2e6e8a79af52057af61363e2eb9d3c8e5198210f3f539c39ce429385b942d2ea1ccc7d895a5c2d7ba7e75974e92339eda93f525c83c2af8a7c5db4674ae2a102ae316b616ec638c4181301de524bfb3ac241427e17ad53c4440bd6a859b6511b5a58bfafbde5e634a1fb07e729f25d376b886913dfa7b84f79b969c8f74e8ffcbbff11a963920f0b8da325f68a18c637aa61d47c58487c203c17e557f771c25862ee63fdabddeb04e31573b3a539c7627d3661bce5242bbd43b3f09e5a7c3f744e3a0feefbb8fe9f7ffce38fcdf0d7e76af7c73fffaf3fea8be91ffffc833e7a1b2e3ff1d37ffdd73ffe98fabbd97ef41fe3f5f2c7fcf8cbdf7d8ec9ebf871fc1c05ebe93ff1affaf97fcc76cbe0c77cab7efd8ff916a7f4575f6bfc3bf9dc0dfd803f5ac9c9c3c3fef1c7d6bfd0476eda4d39fff863b99ed02f0feac7ffdcf97c78ca493dfd0fd7f91f6eb6e5fefce7439a0ef88fb4937e48a71dc719d039fced7f4efc5f7ffcf36b186c3fe99467bed8ebe7e18f7f3e3d3aa9877ffce1ade8565cd77d709fb2f48c81bf5ad0effff8a3c6174ca7dd4ce61f7fb4fdc91fffa44b17d5bfbdfffccfcd70e2f0cf8d09ce463f34edfb7d091672ff0f4ef609bfaec70bba763af58f3f9ee9e67117cdcf311dfe339b7a48653219fac3db169f64523f338f999f8f8f34ccb5fb87fe7cca66dc9f4ee6890ecdfdfea174f7fbd57efb49cff2bf9c7fd07ffffbbff04e679fbf7870305e7ffcf8b55eef7ed050ef83cf84f749434b8b61fd6b571fee6691e9c17f7b5d8fe5c3d6f0d7f473273f37e88cf2536db81bd3b756fb20a061db0d834ff386f8b7c6e770bb5ec9b1c575c10f3eb7fa68b9aaf9f5f573637e6e7d6e77b1a3f151ec1b357e249ed872ebf7a632dfab4f77b2fbb5a79bbb1e1abe413a657cc4a6ebffa09ff8af9dcf5f5b9f9fc6fd0f37cdab67c357d64beb9b1b50e37a7f51d14927c3dd908eab9ed77fe5fce7bfbcc22480c6cce0b89e56a6f4bfff3cf5722fafa3f4cba3d25a99d6e6cfa7f7e6cbeb90ec28e3f18b33f2915eea6587fcc2e563407e4cc5cbadf1ffb445b66900ed388aa3278c35f7e0f7045ebe008c36d99a971ab89ca1e354cdbd7407b4bf70bffcabb7afe5182b1178b9b239067db77c9fa5e03069be50bcb498921d24dfb5033d52f633c8e7466e6c4afed87c003d51c697306e063a20b407b5a713ae15e2f876788fcd07bee72ef97ee43bcf3e9b2f873e6b5480d392fc731a03af081c7a2372dfe00cc03df17de578bcd69352e3f8ee670e93f4240ddf409e153caae3c3485faf573bb4f2c16ba3fdd86a349d7375fe6cc6add37ddcb2cf0d1dab5e07b98785572cd3f5d9cfd8f4b97f887cff62214df7447e506145c704037aa6d1f905fd656970de22174ee37a211fcb99a4c8f7f8c03b7d417f91e9f3a0ef30c7d6d88f8fc70bf9a757c782737f46fe9effd1a94d9b9d9aefe5cb2f6d2768559ff9dcbd66a7e1b5dc4e9be6d24b3b28bfb48246b941c7b65c6ffae164df1bf9a0dd6c67dfdbe7977ac37fa1bf2fa64d27786fe48ed34197ee197cf145d467813fae4d47696f2a75c980ae2fefa84dbef9f57b009e489e619c7e09fae41392cf8031820f3543ac33106da43dd708c02b4ef1ce38fdc6fd4c1e8e9367a079427150b1a0facb6e8dcf83795f8d25bf833dcd19ecf517de8757b52d5d03f116c652690c539c55dcf0bba1988bfcfcf2f320e5d21c3ba2debf33f3a8a4d6e1f9f1aff1859e977c860978fd9a2f7f51fc31d35ab938b67a5c57cab959982786f60e783e4a8b2816a9f982b533f54497fa020d8d01c5a2f5a6f4e7d11e8eb92b39048a1f9903f0d5a1cf508f7c4b0fbad0dd18ef46c5ecaa0a7ec192f06fd9df630c512a8b5c02f75db5d05b912aac06cde7ecd579ccdf5ccc318ccf5e71b840cbab82b5243e7e7986987e084e18ba1fee17c9a34e07cea7c871c00cd0f33c2f380e4f351e95bda0fbcb1cbc02cf175a77051778b9013fb7eb736c8c3801b60678e714f2592f8861c8f77ab90c8129f58f53f085aa39178c8bd939d90f8a7927b037e751cad99aebc83c94be8f2e6a4f2f33d6a7a37583f56cc6e7d91aef62e1028e9b31c6e9f5d97e4fea5a183fcf97b17e3e54cf2f2dbcbbc1aa7c183563e7c57a5ed13b57f68df1399c1b807d1e902d712fea39d43db6f75e81e7d7963fef726c1a84cf837ea5376839628d9ce97be8fb50e325365fcdb115ec85ae0b791a6b4af6896cd08c62f029f043648f57236085ba1f7beb39adf1a2672d028bf516d8730bbeef7815e45177fde4716a1f6baff2feabe70c6c02adad2cdbf73edeb370eae05dacfbbdda94e280806cd79cde27fded819ef58d9e2d70ca6463faa9d981c609fc2f2b601de9df2530769171457ed1be9f9073086b68d947ddc79e8f25d8cdce6cd4567c9a3477cbc891a4f5da7615879d8b9884e233273aefadf18baca348ee4f78e4bc927c8fff57eb5b9d13f7a67e76cb723d60318f66edc8774c2cfb53b830d753e9913d9ae7a477fe145fc38a53733796780178103937be970ed0e7f4f0ee633d7a735a57bed80e198f6aefed11580870bf9b7ed59cb9d7cbbbfd0ce79711301d644b77bde6f39357d84dbed4fdeb7f31073aa919def105fd0714d36ecd3bc0fbc7dc2bbdad39afc5f33ce031c6bdd378068c2d448ffc0af3a1b02fa769be2e692e319e1635938f708df3dce9487f833af7c89fd0fca33db204ec6030efcb7ec9f39ed78abe17da0bfadd8715ad533f5c839d856d9bd11f375a9ab560619f16d34fb101fc7f589360dbb7b7f819d6decacc3333a6640fa714e34df55836e5fd699ce514716268675eb2efd1f93e1b2fdfd609f35873deedcc31fe02faa11b6851da78f2f8fd8bdd762ffc9c79ce35ec357667d81d3c5612d7c0d4b7e624f85c958d67df0bfb08301414abe2de66744f63b5479931bb90dd470ee06addca5a08fb86f1ac34766ee4efc560e9e56666ff442d54e152656c5364afc916e99c4b9b31256d195bf8d7c1dba19fda05b40eebed7ce7ddeccbb9f0fff01c6f9cbb1cf5de56d1f5eac8dcd7cf1eb335c051d17e456315ad2f46edc45b40f3762e3a7cc8374ff55e9e8dd921ac99969943342691ba9c9a4be373d2bb7d73c7c0feac3e7ed30ec59f83f905d69eee010f760a479ecf96cf9309e699ee9d42eddd1ac785ae3185f7778cdcf7906d94fc2df11dc4d688d6abadc006ce4f9a2301b836f333cf7be64298fa8a4fd40f6d1a7ade59eb731d5e373277b377c7826de4ccac659bb7aa9fee9c0543496b78ee4c2976fa618f3dd9603ff485f85aec1fa12e3e484db686d3275736b63d1c37cfb6a3262fefbd3e80e356cd3fc7f659ce8c17947153fe5892fd2f635d60cfd0b588647f55ee7733f09fd77a5d78ccbfa87825d03b58326b4ae53edf805f84bf88e37af44c2db55ef72df7a5d0f2a373558db73ec785df73a23d523cb6349ed0dd818623fa713cf20ff977da376378297b2c0c378b075e87577adfb1f7afc67213b5bbb3c3c4a57759923a8cf637ac7cdf82359aa3e3a5fc4af4549d78cd02f398b43e06ac818d7873108c4a14b344ea3727f4886dabb0ab69995f57e3a68ed11ac4fa3b5e6e3bf5e674acfffc0b7ee204f8c5a80d8bd865b135bc3ef07cfc4cd089c4f7f85cbef8e443da0750abb2c7031c5e95f38b7e86b0ef3bf74cf38bae4f3e06ed91e4e34c02f8b7dd331f2f35c895e87380bf03fddf927f9ea9ebb36fbff1720ef90205e01037e0ba241f605a6b3d1f69fed3799efd5e53f6f9df3e2fad3bbaaf9d1ddbd0ef07ef354f3eedf3869f3770fcabf161eda5b65e539949c99b2a7ea76c396dedf764abb0cfd1b917d52e7f6705bc2d1dff67cc0e6c91bf0e75cba772dee207fc9e2d780a467367f5d61cc3ce398357e04914778bbcab4de49d16755cf86834bd2b72cf9ba84f11c6300acba47d24f2bf068895d6b4872f289e08df398d15f913648bac58a5f9021d8760c431cfcb0cf5ceb1bd768a05d4f1781f1fd0da1ba7dab69d3af67b9dcba0ad7ae9fceb18151c5ae47bec51179808ee36c9aff97fb9ad52cf09bb417395e6a4fcdef4785f90316afbd0e1b0e7dbd7c74d5fe0c39cef7b3b74b48e55735fc672f07a15b3f0bce29c43a0c67b7ee577e9f3457d19b996f57dee5ddf51bcb416fb031f2c0f4cf47690637b30959fb15e9fb50d5cb0c625fb7d0fb28702df25b645d983c659f581f3baa7f3eadf7def15b6ce93b5eb2ffcebb1547e85ccc99cf299814f79408f15af2960e4529315d96dc52d37f587d01d4c15ce23ad055852e397bbe6d3f396c105fbeb609981ed9be9f95f89c54a624b0a642fcbced8d16b3661fe435777d5b8189ba1e213e4443ef4df62fea0fe4e6c1ecce899ce93d25b9d9e9bf7c241d73d4e4a8ba8ff97fbd0981e757c7c3f5a6cf57d27f96cd13de459ddcbc75efb32de6b667af559b84f3d79dcd380f922d7201beec236c9bb46ee84f339c018237f34e2b9a5f425c78c09fd58ab9ef93cf75c9bf34097f62df80d9bafe79b3c3f7c73de7765ce0affeb2c20bb7818c0a7a3efe8e7e1be13b6f9588b727ca5e81ec64bb6ad9cb3b58fa7cfb07fb29d55fbebe6ea18c591c17f5fd53691f578e3bdc6fc646890877b54eaf140b66239ecea7e05e498dd05c72aa80d181f51c6ad7c79c854538dcd64fecdbe13fa4c919875849c4d3bc8df8a59e09b0f7af9585c64300dbeb91fec2b4d87c7a662e278b20fe1cf18ef5d640e612f4bc851883f873ec1ce0cb9ba31dba68ecec9a9f78df7fb32d3fbfd84f353eda9eca90587deff1a3814f83403c11601f3b1087376c8bd64a6c3de47dc763707b427f47bbf65bb791ff3984fe79173e688df11e36a9f916206a797e6bce59f74aff48e1badabf1547e068e6da73a277e17f1ef27c4b6d6bec03e8ae41dec3c3763d7c37ec055501ee4667c6cb3fda16dba8ebdb67a0fa0b577aac4f6ba61b73f557e8a15df603f7e3e26c6862aef72cbc6cb78eb778c1c8e9c5b7433c82f0d73332aa74e9f37c10dcbefc6af5ce71bf4df7ef7fe789ee85c4dbdc41cdd49be62d2f5d95714dfef217a2f119f51c5e6fe63ac36c0cf6ae5f72d8da0f0393637f74775ef3a56f682935b7b7d59bc9dad7d78a5c6b690b5ede356e620f2cc7fc3175ff2bcfd92faca625a6dbe708f95f9fdfc7c22fb7c9438a23c09f742e76a4f6ca40ac7b8ad917998901bb98a75e2be45985f1cb016ea0bedf9ded5fad4f8d28a9adf521358485e2a17d5cf49dc33d9ff78fe4573c9ffff7a8c74b5068b93d958fb35f39343ef4978a92fce2adc6b708ccb739af71bda1beabe17999f037aafff676324dc43e2bc3275b1f8bc8ad9ea58de73eaa31ec2358bf30b30a358a3266769d534c0eb17cd8feab845d7da2239cbd975afa1d5f35e4ecd9c49e9f989f6b915f99fab49bac63fdb7dba95dc84ebe3e3cb9a62c031f93b93f4d81c873536d1f719cb799a98e730223f1d7334b6870593a45889f1aaf43ff42ae87d5772e55e9bfc277e97dd8f24fb06ecd4f9b32d1a701887379ac7eff1f3629fcde7658ca27530da77dfb87ea0fdefebfc2e7c00edc7337e9d8fa3584df2d1bd686e449e4372fc713e6a2bcf6fe68af4bede3df6bae6dab439ada7a1efd335cf06fb93705fbc3f59fb3be705afdf839d97eabdad30ae611ebffc865c0efa22e8fde8ba64c2bb91fff17d5a8f52a3c33cd5f5d3bbc7778e34afb87e417391e6d2f4ca166aff0fb1238f6152feff4e9e5ee12415c670b219ac1692bb8fe4f994ad6abe281e14a927180d90db63a7d7057c18eca595b6937daf6abd8854b0fc6ccec2bae742d76ddba15e1fb022e0f7bb9ef3593b0f99640faacbec7e74ab0ec9359c20391e937d897356dceb4ff722e3c4b599bdda1fafbecb3e594973559b3d41ea9de2fbf88c29629c25c5ecfecd71bbb28dcd2ef3d1407f8dcf1bcd89ddb11df22cd76b20b41bc05cde195bf91f7180a967a17ed82d735f40424d9de27de4c9670a7f52d8ab38c1aa6f039b704af77bc03e090e39f93d446d54988fe13155b5bfb2552b7cc97ce5128fe19c36f98c3b8a2f7cce652bdf436ac6aca53df5962abf40fbfd57e9a87d7ee14d55efcfe4e7aee34ac9a159b53ac41d5e37fa0c562c22e7e55c1b72f3fc3dd8ab9bc7d0bd9da57ef872d30e701c38df7efb3ec7e9c9061a33b48ea3f3b4946c37facc63fe8c1e1af2853dff7d9a349ff4b1595aef5970b7c1c7663d09709d291b9f7cef729f87fbb996f85c37f11bd6ea31798e2b7f48eca7e5bb2adb8ab910e254929f87eba2db5beb0dfa3e536fa5f277b42ffdadb109bf476b62c07d2f92ff0b63d37bf7f66d6df8ea7ec10350f875c31ecc99ab21074c41195a574f77de5592ffa6703ba8ed65cfef2a37a3e6f24eef97d13a8b8a01646eff3ff1aca1fd98af6f9f2b5653d6b18b554bbe37af59c76a78f9666d85b1c8df9b43017ad11b3c2ef639eeaf9ddb1835f5beec98f2deb385782ff32e65adc1867abef7f3f7e7d0ec77aff95be38918e837de698939d06eece97ff79a931edb97e037aeabf35aff6dd7263b72140c2a7cf4bb6b9763ff76e478954ffbf7d793ae77dc9dc3b178e2bfebda3a07fffdb51dbdd7eb9c80fefdbfe13dd875f6bbf3007e5227822d516b8ffd95fbe3c13eb8ae390743ce61915df493f36bd7b6141c391ab322ba8b1ef7b67a26efd84f75369c67bb3f26b4ee85bbf2f38eed4e78de88666c85f90da6f76d969d67fd17e7c7203539abfec0bb7344d59b56826753e3d19d00b3ce9ce1f7f6298d45261f63372ea187edeef8095713b88f546c28f9cf06fc5fc6b8d49b13abbe15de43b53b035e8bfc6cc9e38c13739ff618e9e343ee3575ad35d7da8b53c603a9fa1e8dd578df5f2d8065343f53bccf3f837788e2b4cd7ff7f5d479237e3bf9093f60570682e9c39eb1f0b87717759fc90ab822c4d5c0aa98ba0fd735ca2ad7ea7d739f26bf65ee53bf8ffbf391f35da26952aac127e16718a4111bbd5ca47687fb9b906f43b6f6cc38d09f149b3ec237a2d8d6a135ef1baccaeb5a8e5f35686c0ac0b0f0f39b31c79aedb9937b734fea0298b7a85b21065b7cbf2e8be6feb2e1cf612f6837bda3187e81f11c8dd214cbf590533ec20f7147ab8f272f72bd105724cf9e95fe885ce6ea5d7d759cbbfe9e9d1b8dff5fce699e3d7dbfd75839790fcf37639af81a844ee8df5b7ff7702ab7ec6e369d9c97bdaa73d8b66a8739a372bd0be47a75ad413de31e7fd731aa5a2fa3d132bb1f741caef3dbc7559affe7ee536108654c8a1f7f637dcf943690ec7d82377b661e9c86701dad6b3edb1f7d9cc677dd9d43b7d6379eef9bbd66f36fc7843c3e77fdb0a47ae5efcc57fe9eae498e30ae9cc73c66efd73493ff476ee7a37b126c8fe91f79f107dd01d7e269be47f2b4dfcd9dfbb9886bffe1bbf3457a4dd45ecc79ded5edfce9b7fec2559df6f69ab76a8456bd5fe10c9b36d686fb9f04d327f5bddfb40d06d3a6fcb06001fbaab10095e833abfabdc94f99f77f7f5d5fe1e6f0bc8b6f9e7737e0fa96abb9de56f560cbfb6eab5b7086b45699872d378d6148ecfb95da5abc2ee6e5aeeb94a616753fe7233deb06b371d32ed97562f54ec67fda3907bebfa58dfd3436ebe7776b365ec394730d36e4f72fc6ac07dd8146c146e7ee7e2feffb6f3fa7beee8af688f8bc5c0ebb9ded007a3225701f070f7fe3997fdfc7fff7eedb5e5f7c0ccf59f0b9d0d87de6dc3d34f1ca9747bdb799fdf0debdff77af89b0a690349f4c0d836bc8bf35a7c516e9f7136265bf1f37fd1d7bbffd76ee46ecc69d7e82ab1cea32084645f0a444fd02ae2727d6a9ad77d895b511f689469e43635ea2380e5d9336393af21918bf96801d4db4918bcdbf1c4f86b8bea43514c3f8ed824fb2c7caf7f9edfddbf6d9cc9afc1ddb10addd7cfbdeeef9d1b2668ddd8ac569bf85c19131c9710cca7d60b67fcacfc87646b06849f5463d6edfec5fd9bfb52f9867baebebc6fa3cf8d9740d02f32f0913fe2fd521557efbdfa9ffeb9c7c423d3b5ef3eba412eaa5aa7f26a6896df5cf44fa46cfdee6f78ee5fd6c4f71fee2b76af651dc9c8d0731b562c49d23f4bc5ed56ce9b912ecd4753e7cd2820d1f715ef378e5bf32bf826056a3b809ccd3a57b49b2a1f16b0c7afdffd37887dfc43a409bf51bbc0338e30bf0c33aa8d9aec3beeb63ac477991881b8cf4302bec30fa9e2bece3971f1b45e8352dc0bd71aee69e2ff0e96ab9689f500ceb6a9ecde4c64a8a77e9fc7819963a0eeb22190ccf373da5d63a235bc5b8d3284740fc38991be1b59d0af7254effe7b79c404220f399cc2013a78bf93dca13f3fb3d3a99d86514b5ccfffae33ffef8dfbfcf2df3bf34f1d0748daffdff8064e6bffe6faf8ca3a0f6300500`)))
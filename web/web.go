@@ -29,8 +29,22 @@ import (
 	"net/http"
 )
 
-func Setup(prefix string, rtr *mux.Router, d *dispatcher.Dispatcher) {
+// Setup serves the panel for d under prefix, with its websocket at
+// prefix/socket and Prometheus metrics at prefix/metrics. The options
+// configure the websocket handler.
+func Setup(prefix string, rtr *mux.Router, d *dispatcher.Dispatcher, opts ...HandlerOption) {
+	mount(prefix, rtr, NewWeblogHandler(d, opts...), NewMetricsHandler(d))
+}
+
+// SetupRegistry is like Setup, but serves every stream of a registry from the
+// one panel
+func SetupRegistry(prefix string, rtr *mux.Router, g *dispatcher.Registry, opts ...HandlerOption) {
+	mount(prefix, rtr, NewRegistryHandler(g, opts...), NewRegistryMetricsHandler(g))
+}
+
+func mount(prefix string, rtr *mux.Router, socket func(http.ResponseWriter, *http.Request), metrics func(http.ResponseWriter, *http.Request)) {
 	rtr = rtr.PathPrefix(prefix).Subrouter()
-	rtr.HandleFunc("/socket", NewWeblogHandler(d)).Name("weblog_socket")
+	rtr.HandleFunc("/socket", socket).Name("weblog_socket")
+	rtr.HandleFunc("/metrics", metrics).Name("weblog_metrics")
 	rtr.PathPrefix("/").Handler(http.StripPrefix(prefix, http.FileServer(pkger.Dir("/web/weblog-html")))).Name("weblog_panel")
 }
\ No newline at end of file
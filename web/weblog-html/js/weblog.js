@@ -21,20 +21,138 @@
  */
 
 $(document).ready(function() {
-   console.log("Setting up websocket connection");
-   var socket = new WebSocket(location.href.replace('http://', 'ws://').replace('https://', 'wss://') + 'socket');
+   var socket = null;
    var output =  $("#weblog-log-output");
    var disconnectedMessage = $("#weblog-message-disconnected");
    var basetime = new Date();
    var body = $("body");
+   // The sequence number of the newest entry shown, and the epoch it's from,
+   // for resuming after a reconnect
+   var lastSeq = 0;
+   var epoch = "";
+   // Set from a reconnect until the server confirms the resume. Until then the
+   // messages are for the connection's default selector, and are ignored.
+   var resuming = false;
+   var retryDelay = 500;
+   // Set once a selector has been chosen, so it's chosen again after reconnecting
+   var selected = false;
+   var streamInput = $("#weblog-input-stream");
+   var selectorMessage = function(type) {
+       var stream = streamInput.val();
+       return {type: type, selector: $("#weblog-input-query").val(), syntax: $("#weblog-input-syntax").val(), streams: stream ? [stream] : []};
+   };
+   // When several streams are shown their sequence numbers can't be compared,
+   // so entries aren't deduplicated and the stream can't be resumed
+   var spanning = function() {
+       return body.hasClass("weblog-streams") && streamInput.val() === "";
+   };
    var search = $("#weblog-button-search").click(function () {
-       var msg = "{\"type\": \"selector\", \"selector\": \""+$("#weblog-input-query").val()+"\"}";
+       var msg = JSON.stringify(selectorMessage("selector"));
        console.log(msg);
-       socket.send(msg);
+       resuming = false;
+       selected = true;
+       if (socket !== null && socket.readyState === WebSocket.OPEN) {
+           socket.send(msg);
+       }
    });
-   socket.onopen = function(ev) {
-       console.log("WS opened");
-       body.removeClass("weblog-connecting").removeClass("weblog-disconnected").addClass("weblog-connected");
+   streamInput.change(function() {
+       search.click();
+   });
+   var showStreams = function(streams) {
+       var current = streamInput.val();
+       streamInput.find("option[value!='']").remove();
+       $.each(streams, function(i, stream) {
+           streamInput.append($("<option></option>").attr("value", stream).text(stream));
+       });
+       streamInput.val(streams.indexOf(current) >= 0 ? current : "");
+       body.toggleClass("weblog-streams", streams.length > 0);
+   };
+   var rateInput = $("#weblog-input-rate").change(function() {
+       if (socket !== null && socket.readyState === WebSocket.OPEN) {
+           socket.send(JSON.stringify({type: "ratelimit", rate: parseFloat(rateInput.val()) || 0}));
+       }
+   });
+   var showSuppressed = function(data) {
+       var levels = [];
+       $.each(data.levels, function(level, count) {
+           levels.push(level + ": " + count);
+       });
+       var text = data.count + " entries suppressed (" + levels.join(", ") + ") between [" + Math.round((new Date(data.from) - basetime) / 1000) + "] and [" + Math.round((new Date(data.to) - basetime) / 1000) + "]";
+       var line = $("<tr class='weblog-suppressed-line'></tr>");
+       line.append($("<td colspan='5'></td>").text(text));
+       output.prepend(line);
+   };
+   // Set while the server holds entries back, until the panel unpauses
+   var paused = false;
+   var pauseButton = $("#weblog-button-pause").click(function() {
+       if (socket !== null && socket.readyState === WebSocket.OPEN) {
+           socket.send(JSON.stringify({type: paused ? "unpause" : "pause"}));
+       }
+   });
+   var showPaused = function(data) {
+       paused = data.paused;
+       var text = "Pause";
+       if (paused) {
+           text = "Resume (" + data.held + " held" + (data.dropped > 0 ? ", " + data.dropped + " dropped" : "") + ")";
+       }
+       pauseButton.text(text).toggleClass("is-warning", paused).toggleClass("is-info", !paused);
+   };
+   var alertsOutput = $("#weblog-alerts");
+   var showAlerts = function(alerts) {
+       alertsOutput.empty();
+       $.each(alerts, function(i, alert) {
+           var text = (alert.stream ? alert.stream + ": " : "") + alert.rule + " firing since [" + Math.round((new Date(alert.since) - basetime) / 1000) + "], " + alert.count + " matches of " + alert.expression + " (more than " + alert.threshold + " in " + alert.window + "s)";
+           alertsOutput.append($("<div class='notification is-danger weblog-alert'></div>").text(text));
+       });
+   };
+   var lineID = function(stream, seq) {
+       return "weblog-seq-" + (stream ? stream + "-" : "") + seq;
+   };
+   // showRepeat updates the count of a line whose repeats have been folded
+   // into it
+   var showRepeat = function(data) {
+       if (!spanning() && data.last_seq > lastSeq) {
+           lastSeq = data.last_seq;
+       }
+       var line = $(document.getElementById(lineID(data.stream, data.seq)));
+       if (line.length === 0) {
+           return;
+       }
+       var repeat = line.find(".weblog-log-line-repeat");
+       if (repeat.length === 0) {
+           repeat = $("<span class='weblog-log-line-repeat'></span>");
+           line.find(".weblog-log-line-message").append(repeat);
+       }
+       repeat.text("\u00d7" + data.repeat_count + ", last at [" + Math.round((new Date(data.last_time) - basetime) / 1000) + "]");
+   };
+   var showGap = function(data) {
+       var text = data.dropped + " entries dropped";
+       if (typeof(data.from) !== "undefined") {
+           text += " between [" + Math.round((new Date(data.from) - basetime) / 1000) + "] and [" + Math.round((new Date(data.to) - basetime) / 1000) + "]";
+       }
+       var gap = $("<tr class='weblog-gap-line'></tr>");
+       gap.append($("<td colspan='5'></td>").text(text));
+       output.prepend(gap);
+   };
+   var connect = function() {
+       console.log("Setting up websocket connection");
+       socket = new WebSocket(location.href.replace('http://', 'ws://').replace('https://', 'wss://') + 'socket');
+       socket.onopen = function(ev) {
+           console.log("WS opened");
+           retryDelay = 500;
+           // A new connection isn't paused
+           showPaused({paused: false});
+           body.removeClass("weblog-connecting").removeClass("weblog-disconnected").addClass("weblog-connected");
+           if (lastSeq > 0) {
+               var msg = selectorMessage("resume");
+               msg.after = lastSeq;
+               msg.epoch = epoch;
+               resuming = true;
+               socket.send(JSON.stringify(msg));
+           } else if (selected) {
+               socket.send(JSON.stringify(selectorMessage("selector")));
+           }
+       };
        socket.onmessage = function(msg){
            var data = $.parseJSON(msg.data);
            if (typeof(data.type) === "undefined") {
@@ -42,42 +160,125 @@ $(document).ready(function() {
                console.log(data);
                return
            }
+           // Messages about the connection, rather than the selector, always apply
+           var connection = data.type === "streams" || data.type === "ratelimit" || data.type === "alerts" || data.type === "paused";
+           if (resuming && !connection && data.type !== "resumed" && data.type !== "error") {
+               return;
+           }
            switch (data.type) {
                case "clear":
                    console.log("clearing");
                    output.empty();
+                   lastSeq = 0;
+                   epoch = data.epoch || "";
+                   break;
+               case "resumed":
+                   console.log("resumed after " + lastSeq);
+                   resuming = false;
+                   break;
+               case "error":
+                   console.log("Server error: " + data.message + ": " + data.error);
+                   resuming = false;
+                   break;
+               case "streams":
+                   showStreams(data.streams);
                    break;
                case "basetime":
                    console.log("Setting basetime");
                    basetime = new Date(data.basetime);
+                   epoch = data.epoch || "";
                    console.log(basetime);
                    break;
+               case "gap":
+                   showGap(data);
+                   break;
+               case "update":
+                   showRepeat(data);
+                   break;
+               case "suppressed":
+                   showSuppressed(data);
+                   break;
+               case "paused":
+                   showPaused(data);
+                   break;
+               case "alerts":
+                   showAlerts(data.alerts);
+                   break;
+               case "ratelimit":
+                   rateInput.val(data.rate);
+                   if (data.ceiling > 0) {
+                       rateInput.attr("max", data.ceiling);
+                   } else {
+                       rateInput.removeAttr("max");
+                   }
+                   break;
+               case "aggregate":
+                   var agg = data.aggregate;
+                   output.empty();
+                   var header = $("<tr class='weblog-aggregate-header'></tr>");
+                   header.append($("<th></th>").text(agg.by ? agg.by : agg.stage));
+                   header.append($("<th></th>").text(agg.stage === "rate" ? "per second (" + agg.interval + "s)" : "count"));
+                   output.append(header);
+                   var groups = agg.groups ? agg.groups : [{key: "total", count: agg.total, rate: agg.rate}];
+                   if (agg.other) {
+                       groups = groups.concat([{key: "[other]", count: agg.other, rate: agg.other / agg.interval}]);
+                   }
+                   $.each(groups, function(i, group) {
+                       var row = $("<tr class='weblog-aggregate-line'></tr>");
+                       row.append($("<td class='weblog-aggregate-key'></td>").text(group.key === "" ? "[none]" : group.key));
+                       row.append($("<td class='weblog-aggregate-value'></td>").text(agg.stage === "rate" ? (group.rate || 0).toFixed(2) : group.count));
+                       output.append(row);
+                   });
+                   break;
                case "log":
+                   if (!spanning()) {
+                       if (data.log.seq <= lastSeq) {
+                           // Already shown before reconnecting
+                           return;
+                       }
+                       lastSeq = data.log.seq;
+                   }
                    var line = $("<tr class='weblog-log-line weblog-level-" + data.log.level + "'></tr>");
+                   line.attr("id", lineID(data.log.stream, data.log.seq));
                    line.append("<td class='weblog-log-line-date-offset'>[" + Math.round(((new Date(data.log.time)) - basetime ) / 1000) + "]</td>");
                    line.append("<td class='weblog-log-line-level'>" + data.log.level + "</td>");
                    if (typeof(data.log.fields.prefix) !== "undefined") {
                        line.append("<td class='weblog-log-line-prefix'>" + data.log.fields.prefix + "</td>");
                    } else {
-                       ling.append("<td class='weblog-log-line-prefix weblog-log-line-prefix-none'>[none]</td>")
+                       line.append("<td class='weblog-log-line-prefix weblog-log-line-prefix-none'>[none]</td>")
                    }
                    line.append("<td class='weblog-log-line-message'>" + data.log.message + "</td>");
+                   var fields = $("<td class='weblog-log-line-fields'></td>");
+                   if (data.log.stream) {
+                       fields.append($("<span class='weblog-log-line-field weblog-log-line-stream'></span>").text("stream=" + JSON.stringify(data.log.stream)));
+                   }
+                   $.each(data.log.fields, function(key, value) {
+                       if (key === "prefix") {
+                           return;
+                       }
+                       fields.append($("<span class='weblog-log-line-field'></span>").text(key + "=" + JSON.stringify(value)));
+                   });
+                   line.append(fields);
                    $("#weblog-log-output").prepend(line);
 
            }
        };
-    };
-    socket.onerror = function(ev) {
-       console.log("WS error");
-       console.log(ev);
-       body.removeClass("weblog-connecting").removeClass("weblog-connected").addClass("weblog-disconnected");
-    };
-    socket.onclose = function(ev) {
-        console.log("WS closed");
-        console.log(ev);
-        body.removeClass("weblog-connecting").removeClass("weblog-connected").addClass("weblog-disconnected");
-    };
-    window.shutdownSocket = function() {
-        socket.close();
-    }
-});
\ No newline at end of file
+       socket.onerror = function(ev) {
+           console.log("WS error");
+           console.log(ev);
+           body.removeClass("weblog-connecting").removeClass("weblog-connected").addClass("weblog-disconnected");
+       };
+       socket.onclose = function(ev) {
+           console.log("WS closed, reconnecting in " + retryDelay + "ms");
+           console.log(ev);
+           body.removeClass("weblog-connecting").removeClass("weblog-connected").addClass("weblog-disconnected");
+           setTimeout(connect, retryDelay);
+           retryDelay = Math.min(retryDelay * 2, 30000);
+       };
+   };
+   connect();
+   window.shutdownSocket = function() {
+       socket.onclose = null;
+       socket.close();
+   }
+});